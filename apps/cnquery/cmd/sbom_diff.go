@@ -0,0 +1,85 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	sbom "go.mondoo.com/cnquery/v11/sbom"
+)
+
+func init() {
+	sbomCmd.AddCommand(sbomDiffCmd)
+	sbomDiffCmd.Flags().StringP("output", "o", sbom.FormatList, "Set output format: "+sbom.AllDiffFormats())
+	sbomDiffCmd.Flags().String("output-target", "", "Set output target to which the diff will be written.")
+}
+
+var sbomDiffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "Experimental: Compare the packages of two software bills of materials (SBOMs)",
+	Long: `Compare the packages of two software bills of materials (SBOMs) and report
+added, removed and version-changed packages per package type.
+
+Both SBOMs may use any of the following formats:
+- cnquery-json
+- cyclonedx-json
+- cyclonedx-xml
+- spdx-json
+- spdx-tag-value
+
+Note this command is experimental and may change in the future.
+`,
+	Args: cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlag("output", cmd.Flags().Lookup("output"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to bind output flag")
+		}
+
+		err = viper.BindPFlag("output-target", cmd.Flags().Lookup("output-target"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to bind output-target flag")
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		oldBom, err := sbom.ParseFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("path", args[0]).Msg("failed to read SBOM")
+		}
+		newBom, err := sbom.ParseFile(args[1])
+		if err != nil {
+			log.Fatal().Err(err).Str("path", args[1]).Msg("failed to read SBOM")
+		}
+
+		// fall back to the file names if the SBOMs do not name their asset
+		diff := sbom.NewDiff(oldBom, newBom)
+		if diff.Old == "" {
+			diff.Old = args[0]
+		}
+		if diff.New == "" {
+			diff.New = args[1]
+		}
+
+		output := bytes.Buffer{}
+		exporter := sbom.NewDiffExporter(viper.GetString("output"))
+		if err := exporter.Render(&output, diff); err != nil {
+			log.Fatal().Err(err).Msg("failed to render SBOM diff")
+		}
+
+		outputTarget := viper.GetString("output-target")
+		if outputTarget != "" {
+			err := os.WriteFile(outputTarget, output.Bytes(), 0o600)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to write SBOM diff to file")
+			}
+		} else {
+			fmt.Print(output.String())
+		}
+	},
+}
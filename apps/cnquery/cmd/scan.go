@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -157,7 +158,10 @@ var scanCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pl
 		log.Fatal().Err(err).Msg("failed to run scan")
 	}
 
-	printReports(report, conf, cmd)
+	// streaming formats are written while the scan is running
+	if !conf.isStreaming() {
+		printReports(report, conf, cmd)
+	}
 
 	if report != nil && len(report.Errors) > 0 {
 		os.Exit(1)
@@ -218,12 +222,24 @@ func (c *scanConfig) loadBundles() error {
 	return nil
 }
 
+// isStreaming returns true if the output format writes results per asset
+// while the scan is running
+func (c *scanConfig) isStreaming() bool {
+	return reporter.Formats[strings.ToLower(c.Output)] == reporter.NDJSON
+}
+
 func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 	opts := []scan.ScannerOption{}
 	if config.runtime.UpstreamConfig != nil {
 		opts = append(opts, scan.WithUpstream(config.runtime.UpstreamConfig))
 	}
 	opts = append(opts, scan.WithRecording(config.runtime.Recording()))
+	if config.isStreaming() {
+		// the progress bar would interfere with results written to stdout
+		opts = append(opts,
+			scan.WithReporter(reporter.NewNdjsonReporter(os.Stdout)),
+			scan.DisableProgressBar())
+	}
 
 	scanner := scan.NewLocalScanner(opts...)
 	ctx := cnquery.SetFeatures(context.Background(), config.Features)
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/viper"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/encryptedfile"
 )
 
 func init() {
@@ -32,6 +33,23 @@ func init() {
 	vaultAddSecretCmd.MarkFlagRequired("inventory-file")
 	VaultCmd.AddCommand(vaultAddSecretCmd)
 
+	vaultGetSecretCmd.Flags().String("inventory-file", "", "Set the path to the inventory file.")
+	vaultGetSecretCmd.MarkFlagRequired("inventory-file")
+	VaultCmd.AddCommand(vaultGetSecretCmd)
+
+	vaultListSecretsCmd.Flags().String("inventory-file", "", "Set the path to the inventory file.")
+	vaultListSecretsCmd.MarkFlagRequired("inventory-file")
+	VaultCmd.AddCommand(vaultListSecretsCmd)
+
+	vaultRotateKeyCmd.Flags().String("inventory-file", "", "Set the path to the inventory file.")
+	vaultRotateKeyCmd.MarkFlagRequired("inventory-file")
+	vaultRotateKeyCmd.Flags().String("new-password", "", "Re-encrypt the vault with this password.")
+	vaultRotateKeyCmd.Flags().String("new-key-file", "", "Re-encrypt the vault with the key in this file.")
+	vaultRotateKeyCmd.MarkFlagsMutuallyExclusive("new-password", "new-key-file")
+	VaultCmd.AddCommand(vaultRotateKeyCmd)
+
+	VaultCmd.AddCommand(vaultGenerateKeyCmd)
+
 	rootCmd.AddCommand(VaultCmd)
 }
 
@@ -63,6 +81,11 @@ var vaultConfigureCmd = &cobra.Command{
 
 cnquery vault configure mondoo-client-vault --type linux-kernel-keyring
 
+Vaults of type sops decrypt the file with the sops binary, which must be
+installed on the system that runs the scan:
+
+cnquery vault configure mondoo-sops-vault --type sops --option path=secrets.enc.yaml
+
 `,
 	Args: cobra.ExactArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
@@ -150,18 +173,9 @@ var vaultAddSecretCmd = &cobra.Command{
 		viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
 	},
 	Run: func(cmd *cobra.Command, args []string) {
-		log.Info().Msg("load vault configuration from inventory")
-		inventory, err := inventory.InventoryFromFile(viper.GetString("inventory-file"))
-		if err != nil {
-			log.Fatal().Err(err).Msg("could not load inventory")
-		}
+		v := vaultFromInventory(viper.GetString("inventory-file"))
 
-		v, err := inventory.GetVault()
-		if err != nil {
-			log.Fatal().Err(err).Msg("could not load vault configuration from inventory")
-		}
-
-		_, err = v.Set(context.Background(), &vault.Secret{
+		_, err := v.Set(context.Background(), &vault.Secret{
 			Key:  args[0],
 			Data: []byte(args[1]),
 		})
@@ -171,3 +185,116 @@ var vaultAddSecretCmd = &cobra.Command{
 		log.Info().Msg("stored secret successfully")
 	},
 }
+
+var vaultGetSecretCmd = &cobra.Command{
+	Use:   "get-secret SECRETID",
+	Short: "Print a secret from a vault",
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		v := vaultFromInventory(viper.GetString("inventory-file"))
+
+		secret, err := v.Get(context.Background(), &vault.SecretID{Key: args[0]})
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not retrieve secret")
+		}
+		fmt.Println(string(secret.Data))
+	},
+}
+
+var vaultListSecretsCmd = &cobra.Command{
+	Use:   "list-secrets",
+	Short: "List the ids of all secrets in a vault",
+	Args:  cobra.ExactArgs(0),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		v := vaultFromInventory(viper.GetString("inventory-file"))
+
+		lister, ok := v.(vault.Lister)
+		if !ok {
+			log.Fatal().Msg("the configured vault does not support listing secrets")
+		}
+		ids, err := lister.List(context.Background())
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not list secrets")
+		}
+		for i := range ids {
+			fmt.Println(ids[i].Key)
+		}
+	},
+}
+
+var vaultRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Re-encrypt an encrypted-file vault with a new password or key file",
+	Long: `
+
+cnquery vault rotate-key --inventory-file inventory.yml --new-key-file ~/.mondoo/vault.key
+
+After the rotation, update the vault options in the inventory file.
+`,
+	Args: cobra.ExactArgs(0),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
+		viper.BindPFlag("new-password", cmd.Flags().Lookup("new-password"))
+		viper.BindPFlag("new-key-file", cmd.Flags().Lookup("new-key-file"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		v := vaultFromInventory(viper.GetString("inventory-file"))
+
+		fileVault, ok := v.(*encryptedfile.Vault)
+		if !ok {
+			log.Fatal().Msg("keys can only be rotated for vaults of type encrypted-file")
+		}
+
+		var opt encryptedfile.Option
+		if keyFile := viper.GetString("new-key-file"); keyFile != "" {
+			opt = encryptedfile.WithKeyFile(keyFile)
+		} else if password := viper.GetString("new-password"); password != "" {
+			opt = encryptedfile.WithPassphrase(password)
+		} else {
+			log.Fatal().Msg("provide either --new-password or --new-key-file")
+		}
+
+		if err := fileVault.Rotate(context.Background(), opt); err != nil {
+			log.Fatal().Err(err).Msg("could not rotate vault key")
+		}
+		log.Info().Msg("rotated vault key successfully")
+	},
+}
+
+var vaultGenerateKeyCmd = &cobra.Command{
+	Use:   "generate-key PATH",
+	Short: "Generate a key file for an encrypted-file vault",
+	Long: `
+
+cnquery vault generate-key ~/.mondoo/vault.key
+cnquery vault configure mondoo-vault --type encrypted-file --option path=~/.mondoo/ --option key-file=~/.mondoo/vault.key
+
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := encryptedfile.GenerateKeyFile(args[0]); err != nil {
+			log.Fatal().Err(err).Msg("could not generate key file")
+		}
+		log.Info().Str("path", args[0]).Msg("generated key file successfully")
+	},
+}
+
+func vaultFromInventory(inventoryFile string) vault.Vault {
+	log.Info().Msg("load vault configuration from inventory")
+	inventory, err := inventory.InventoryFromFile(inventoryFile)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load inventory")
+	}
+
+	v, err := inventory.GetVault()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load vault configuration from inventory")
+	}
+	return v
+}
@@ -697,6 +697,9 @@ func (print *Printer) Data(typ types.Type, data interface{}, codeID string, bund
 	case types.Semver:
 		return print.Secondary(data.(string))
 
+	case types.IP, types.VersionLike:
+		return print.Secondary(data.(string))
+
 	case types.ArrayLike:
 		if data == nil {
 			return print.Secondary("null")
@@ -108,14 +108,14 @@ func detectConnectorName(args []string, rootCmd *cobra.Command, commands []*Comm
 		return "", autoUpdate
 	}
 
-	commandFound := false
+	var command *Command
 	for j := range commands {
 		if commands[j].Command.Use == parsedArgs[1] {
-			commandFound = true
+			command = commands[j]
 			break
 		}
 	}
-	if !commandFound {
+	if command == nil {
 		return "", autoUpdate
 	}
 
@@ -127,6 +127,13 @@ func detectConnectorName(args []string, rootCmd *cobra.Command, commands []*Comm
 
 	connector := parsedArgs[2]
 
+	// regular subcommands (eg: sbom diff) don't need a provider
+	for _, sub := range command.Command.Commands() {
+		if sub.Name() == connector || sub.HasAlias(connector) {
+			return "", autoUpdate
+		}
+	}
+
 	return connector, autoUpdate
 }
 
@@ -0,0 +1,177 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/cli/printer"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/mrn"
+)
+
+type junitTestsuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestsuite `xml:"testsuite"`
+}
+
+type junitTestsuite struct {
+	Name       string          `xml:"name,attr"`
+	ID         string          `xml:"id,attr,omitempty"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Testcases  []junitTestcase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestcase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message  string `xml:"message,attr,omitempty"`
+	Type     string `xml:"type,attr,omitempty"`
+	Contents string `xml:",chardata"`
+}
+
+// ConvertToJunit writes the given report collection as JUnit XML, with
+// one testsuite per asset and one testcase per executed query
+func ConvertToJunit(data *explorer.ReportCollection, out io.Writer) error {
+	res := junitTestsuites{Name: "cnquery"}
+
+	if data != nil {
+		assetMrns := make([]string, 0, len(data.Assets))
+		for k := range data.Assets {
+			assetMrns = append(assetMrns, k)
+		}
+		sort.Strings(assetMrns)
+
+		for _, assetMrn := range assetMrns {
+			suite := junitAssetSuite(data, data.Assets[assetMrn])
+			res.Tests += suite.Tests
+			res.Failures += suite.Failures
+			res.Errors += suite.Errors
+			res.Suites = append(res.Suites, suite)
+		}
+	}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(out)
+	enc.Indent("", "  ")
+	if err := enc.Encode(res); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
+
+func junitAssetSuite(data *explorer.ReportCollection, asset *explorer.Asset) junitTestsuite {
+	suite := junitTestsuite{
+		Name: asset.Name,
+		ID:   asset.Mrn,
+		Properties: []junitProperty{
+			{Name: "asset.mrn", Value: asset.Mrn},
+		},
+	}
+
+	// assets that could not be scanned get a single testcase that carries the reason
+	if errStatus, ok := data.Errors[asset.Mrn]; ok {
+		tc := junitTestcase{Name: "scan", Classname: asset.Name}
+		if errStatus.ErrorCode().Category() == explorer.ErrorCategoryError {
+			tc.Error = &junitMessage{Message: errStatus.Message, Type: "error"}
+			suite.Errors++
+		} else {
+			tc.Skipped = &junitMessage{Message: errStatus.Message}
+			suite.Skipped++
+		}
+		suite.Tests++
+		suite.Testcases = append(suite.Testcases, tc)
+		return suite
+	}
+
+	report, ok := data.Reports[asset.Mrn]
+	if !ok {
+		return suite
+	}
+	resolved, ok := data.Resolved[asset.Mrn]
+	if !ok || resolved.ExecutionJob == nil || data.Bundle == nil {
+		return suite
+	}
+
+	results := report.RawResults()
+	for i := range data.Bundle.Packs {
+		pack := data.Bundle.Packs[i]
+		classname := pack.Name
+		if classname == "" {
+			classname = pack.Mrn
+		}
+
+		queries := packQueries(pack)
+		for j := range queries {
+			query := queries[j]
+			equery, ok := resolved.ExecutionJob.Queries[query.CodeId]
+			if !ok {
+				continue
+			}
+
+			tc := junitTestcase{
+				Name:      junitQueryName(query),
+				Classname: classname,
+			}
+
+			queryRes := queryResults(equery.Code, results)
+			printed := printer.PlainNoColorPrinter.Results(equery.Code, queryRes)
+			if queryOutcome(equery.Code, queryRes) == queryFailed {
+				tc.Failure = &junitMessage{
+					Message:  "query failed: " + junitQueryName(query),
+					Type:     "failure",
+					Contents: printed,
+				}
+				suite.Failures++
+			} else {
+				tc.SystemOut = printed
+			}
+
+			suite.Tests++
+			suite.Testcases = append(suite.Testcases, tc)
+		}
+	}
+
+	return suite
+}
+
+func junitQueryName(query *explorer.Mquery) string {
+	if query.Title != "" {
+		return query.Title
+	}
+	if name, err := mrn.GetResource(query.Mrn, "queries"); err == nil && name != "" {
+		return name
+	}
+	if query.Mql != "" {
+		return strings.TrimSpace(query.Mql)
+	}
+	return "query " + strconv.Quote(query.CodeId)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"sigs.k8s.io/yaml"
+)
+
+func TestJunitExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	err = ConvertToJunit(report, &buf)
+	require.NoError(t, err)
+
+	var suites junitTestsuites
+	err = xml.Unmarshal(buf.Bytes(), &suites)
+	require.NoError(t, err)
+
+	require.Len(t, suites.Suites, len(report.Assets))
+	assert.Equal(t, 0, suites.Failures)
+
+	suitesByMrn := map[string]junitTestsuite{}
+	for _, suite := range suites.Suites {
+		suitesByMrn[suite.ID] = suite
+	}
+
+	// asset without matching packs is skipped
+	skipped := suitesByMrn["//explorer.api.mondoo.com/assets/2LgMkMIFzzNEh02hTBxOpc5mkdD"]
+	require.Len(t, skipped.Testcases, 1)
+	assert.NotNil(t, skipped.Testcases[0].Skipped)
+
+	scanned := suitesByMrn["//explorer.api.mondoo.com/assets/2LgMkOR8vP9j7GgBbPj9hjYqjO2"]
+	require.NotEmpty(t, scanned.Testcases)
+	assert.Equal(t, len(scanned.Testcases), scanned.Tests)
+	names := []string{}
+	for _, tc := range scanned.Testcases {
+		names = append(names, tc.Name)
+		assert.Nil(t, tc.Failure)
+	}
+	assert.Contains(t, names, "Gather Role Bindings with cluster-admin Permissions")
+}
@@ -0,0 +1,181 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/explorer/scan"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v11/shared"
+)
+
+// NdjsonReporter writes one line of JSON per asset as soon as the asset's
+// scan is finished. It can be passed to the scanner via scan.WithReporter.
+type NdjsonReporter struct {
+	out       io.Writer
+	lock      sync.Mutex
+	queryMrns map[string]string
+}
+
+var _ scan.Reporter = &NdjsonReporter{}
+
+func NewNdjsonReporter(out io.Writer) *NdjsonReporter {
+	return &NdjsonReporter{
+		out:       out,
+		queryMrns: map[string]string{},
+	}
+}
+
+func (r *NdjsonReporter) AddBundle(bundle *explorer.Bundle) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	addQueryMrns(r.queryMrns, bundle)
+}
+
+func (r *NdjsonReporter) AddReport(asset *inventory.Asset, results *scan.AssetReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	line, err := ndjsonAssetLine(
+		&explorer.Asset{Mrn: asset.Mrn, Name: asset.Name, TraceId: asset.TraceId},
+		results.Report, results.Resolved, r.queryMrns, "")
+	if err == nil {
+		_, err = r.out.Write(line)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("asset", asset.Name).Msg("failed to write asset report")
+	}
+}
+
+func (r *NdjsonReporter) AddScanError(asset *inventory.Asset, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	line, lineErr := ndjsonAssetLine(
+		&explorer.Asset{Mrn: asset.Mrn, Name: asset.Name},
+		nil, nil, r.queryMrns, explorer.NewErrorStatus(err).Message)
+	if lineErr == nil {
+		_, lineErr = r.out.Write(line)
+	}
+	if lineErr != nil {
+		log.Error().Err(lineErr).Str("asset", asset.Name).Msg("failed to write asset error")
+	}
+}
+
+// ConvertToNdjson writes the given report collection with one line of
+// JSON per asset
+func ConvertToNdjson(data *explorer.ReportCollection, out io.Writer) error {
+	if data == nil {
+		return nil
+	}
+
+	queryMrns := map[string]string{}
+	addQueryMrns(queryMrns, data.Bundle)
+
+	assetMrns := make([]string, 0, len(data.Assets))
+	for k := range data.Assets {
+		assetMrns = append(assetMrns, k)
+	}
+	sort.Strings(assetMrns)
+
+	for _, assetMrn := range assetMrns {
+		var errMsg string
+		if errStatus, ok := data.Errors[assetMrn]; ok {
+			errMsg = errStatus.Message
+		}
+
+		line, err := ndjsonAssetLine(data.Assets[assetMrn],
+			data.Reports[assetMrn], data.Resolved[assetMrn], queryMrns, errMsg)
+		if err != nil {
+			return err
+		}
+		if _, err = out.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addQueryMrns(queryMrns map[string]string, bundle *explorer.Bundle) {
+	if bundle == nil {
+		return
+	}
+	for i := range bundle.Packs {
+		queries := packQueries(bundle.Packs[i])
+		for j := range queries {
+			queryMrns[queries[j].CodeId] = queries[j].Mrn
+		}
+	}
+}
+
+// ndjsonAssetLine renders a single asset as one line of JSON, including
+// the trailing newline
+func ndjsonAssetLine(asset *explorer.Asset, report *explorer.Report, resolved *explorer.ResolvedPack, queryMrns map[string]string, errMsg string) ([]byte, error) {
+	buf := bytes.Buffer{}
+	out := &shared.IOWriter{Writer: &buf}
+
+	assetJSON, err := json.Marshal(asset)
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString("{\"asset\":")
+	out.Write(assetJSON)
+
+	if report != nil && resolved != nil && resolved.ExecutionJob != nil {
+		results := report.RawResults()
+
+		qids := make([]string, 0, len(resolved.ExecutionJob.Queries))
+		for qid := range resolved.ExecutionJob.Queries {
+			qids = append(qids, qid)
+		}
+		sort.Strings(qids)
+
+		out.WriteString(",\"data\":{")
+		for i, qid := range qids {
+			printID := queryMrns[qid]
+			if printID == "" {
+				printID = qid
+			}
+			if i != 0 {
+				out.WriteString(",")
+			}
+			key, err := json.Marshal(printID)
+			if err != nil {
+				return nil, err
+			}
+			out.Write(key)
+			out.WriteString(":")
+
+			if err := CodeBundleToJSON(resolved.ExecutionJob.Queries[qid].Code, results, out); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteString("}")
+	}
+
+	if errMsg != "" {
+		msg, err := json.Marshal(errMsg)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(",\"error\":")
+		out.Write(msg)
+	}
+	out.WriteString("}")
+
+	// make sure the entire asset ends up on one line
+	line := bytes.Buffer{}
+	if err := json.Compact(&line, buf.Bytes()); err != nil {
+		return nil, err
+	}
+	line.WriteByte('\n')
+	return line.Bytes(), nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+	"sigs.k8s.io/yaml"
+)
+
+func TestNdjsonExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	err = ConvertToNdjson(report, &buf)
+	require.NoError(t, err)
+
+	lines := map[string]map[string]interface{}{}
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 10*1024*1024)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		asset := line["asset"].(map[string]interface{})
+		lines[asset["mrn"].(string)] = line
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, lines, len(report.Assets))
+
+	assert.Equal(t, "asset does not match any of the activated query packs",
+		lines["//explorer.api.mondoo.com/assets/2LgMkMIFzzNEh02hTBxOpc5mkdD"]["error"])
+
+	scanned := lines["//explorer.api.mondoo.com/assets/2LgMkOR8vP9j7GgBbPj9hjYqjO2"]["data"].(map[string]interface{})
+	assert.Equal(t,
+		map[string]interface{}{"k8s.rolebindings.where": []interface{}{}},
+		scanned["//local.cnquery.io/run/local-execution/queries/role-bindings-with-cluster-admin-permissions"])
+}
+
+func TestNdjsonReporter(t *testing.T) {
+	buf := bytes.Buffer{}
+	r := NewNdjsonReporter(&buf)
+
+	r.AddScanError(&inventory.Asset{Mrn: "//asset/1", Name: "one"}, errors.New("failed\nto connect"))
+	r.AddScanError(&inventory.Asset{Mrn: "//asset/2", Name: "two"}, errors.New("timeout"))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(lines[0], &line))
+	assert.Equal(t, "failed\nto connect", line["error"])
+	assert.Equal(t, "one", line["asset"].(map[string]interface{})["name"])
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/llx"
+)
+
+// packQueries returns all queries of a pack, including those in its groups
+func packQueries(pack *explorer.QueryPack) []*explorer.Mquery {
+	res := []*explorer.Mquery{}
+	seen := map[string]struct{}{}
+	add := func(query *explorer.Mquery) {
+		if _, ok := seen[query.CodeId]; ok {
+			return
+		}
+		seen[query.CodeId] = struct{}{}
+		res = append(res, query)
+	}
+
+	for i := range pack.Queries {
+		add(pack.Queries[i])
+	}
+	for i := range pack.Groups {
+		group := pack.Groups[i]
+		for j := range group.Queries {
+			add(group.Queries[j])
+		}
+	}
+	return res
+}
+
+// queryResults picks all results from the report that belong to the given code
+func queryResults(code *llx.CodeBundle, results map[string]*llx.RawResult) map[string]*llx.RawResult {
+	res := map[string]*llx.RawResult{}
+	sums := append(code.EntrypointChecksums(), code.DatapointChecksums()...)
+	for i := range sums {
+		if r, ok := results[sums[i]]; ok {
+			res[sums[i]] = r
+		}
+	}
+	return res
+}
+
+type outcome byte
+
+const (
+	// queryInformational is used for queries that only collect data
+	queryInformational outcome = iota
+	queryPassed
+	queryFailed
+)
+
+// queryOutcome determines if a query failed, either because one of its
+// results has an error or because its assertion failed
+func queryOutcome(code *llx.CodeBundle, results map[string]*llx.RawResult) outcome {
+	for _, r := range results {
+		if r != nil && r.Data != nil && r.Data.Error != nil {
+			return queryFailed
+		}
+	}
+
+	assessment := llx.Results2Assessment(code, results)
+	if assessment == nil || !assessment.IsAssertion {
+		return queryInformational
+	}
+	if assessment.Success {
+		return queryPassed
+	}
+	return queryFailed
+}
@@ -32,6 +32,8 @@ const (
 	JUnit
 	CSV
 	JSONv2
+	SARIF
+	NDJSON
 )
 
 // Formats that are supported by the reporter
@@ -46,6 +48,9 @@ var Formats = map[string]Format{
 	"json-v2": JSONv2,
 	"json":    JSONv2,
 	"csv":     CSV,
+	"junit":   JUnit,
+	"ndjson":  NDJSON,
+	"sarif":   SARIF,
 }
 
 func AllFormats() string {
@@ -129,6 +134,12 @@ func (r *Reporter) Print(data *explorer.ReportCollection, out io.Writer) error {
 	case CSV:
 		w := shared.IOWriter{Writer: out}
 		return ConvertToCSV(data, &w)
+	case JUnit:
+		return ConvertToJunit(data, out)
+	case NDJSON:
+		return ConvertToNdjson(data, out)
+	case SARIF:
+		return ConvertToSarif(data, out)
 	case YAML:
 		raw := bytes.Buffer{}
 		writer := shared.IOWriter{Writer: &raw}
@@ -0,0 +1,552 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11"
+	"go.mondoo.com/cnquery/v11/cli/printer"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/mrn"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// The following structs cover the subset of SARIF 2.1.0 that we produce, see:
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool              sarifTool               `json:"tool"`
+	AutomationDetails *sarifAutomationDetails `json:"automationDetails,omitempty"`
+	Results           []sarifResult           `json:"results"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name,omitempty"`
+	ShortDescription     *sarifMessage          `json:"shortDescription,omitempty"`
+	FullDescription      *sarifMessage          `json:"fullDescription,omitempty"`
+	DefaultConfiguration *sarifConfiguration    `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Kind      string          `json:"kind"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// ConvertToSarif writes the given report collection as SARIF log, with
+// one run per query pack and one rule per query
+func ConvertToSarif(data *explorer.ReportCollection, out io.Writer) error {
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{},
+	}
+
+	if data != nil && data.Bundle != nil {
+		assetMrns := make([]string, 0, len(data.Assets))
+		for k := range data.Assets {
+			assetMrns = append(assetMrns, k)
+		}
+		sort.Strings(assetMrns)
+
+		for i := range data.Bundle.Packs {
+			log.Runs = append(log.Runs, sarifPackRun(data, data.Bundle.Packs[i], assetMrns))
+		}
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func sarifPackRun(data *explorer.ReportCollection, pack *explorer.QueryPack, assetMrns []string) sarifRun {
+	queries := packQueries(pack)
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "cnquery",
+			Version:        cnquery.GetVersion(),
+			InformationURI: "https://github.com/mondoohq/cnquery",
+			Rules:          make([]sarifRule, len(queries)),
+		}},
+		Results: []sarifResult{},
+	}
+	if pack.Mrn != "" {
+		run.AutomationDetails = &sarifAutomationDetails{ID: pack.Mrn + "/"}
+	}
+
+	for i := range queries {
+		run.Tool.Driver.Rules[i] = sarifQueryRule(queries[i])
+	}
+
+	for _, assetMrn := range assetMrns {
+		asset := data.Assets[assetMrn]
+		report, ok := data.Reports[assetMrn]
+		if !ok {
+			continue
+		}
+		resolved, ok := data.Resolved[assetMrn]
+		if !ok || resolved.ExecutionJob == nil {
+			continue
+		}
+
+		results := report.RawResults()
+		for i := range queries {
+			query := queries[i]
+			equery, ok := resolved.ExecutionJob.Queries[query.CodeId]
+			if !ok {
+				continue
+			}
+
+			res := sarifQueryResult(query, equery.Code, queryResults(equery.Code, results))
+			res.RuleID = run.Tool.Driver.Rules[i].ID
+			res.RuleIndex = i
+			res.Locations = append(res.Locations, sarifLocation{
+				LogicalLocations: []sarifLogicalLocation{{
+					Name:               asset.Name,
+					FullyQualifiedName: asset.Mrn,
+					Kind:               "asset",
+				}},
+			})
+			run.Results = append(run.Results, res)
+		}
+	}
+
+	return run
+}
+
+func sarifQueryRule(query *explorer.Mquery) sarifRule {
+	id := query.Mrn
+	if id == "" {
+		id = query.CodeId
+	}
+
+	name, _ := mrn.GetResource(query.Mrn, "queries")
+	rule := sarifRule{
+		ID:   id,
+		Name: name,
+		DefaultConfiguration: &sarifConfiguration{
+			Level: sarifLevel(query.Impact),
+		},
+		Properties: map[string]interface{}{},
+	}
+
+	if query.Title != "" {
+		rule.ShortDescription = &sarifMessage{Text: query.Title}
+	}
+	if query.Desc != "" {
+		rule.FullDescription = &sarifMessage{Text: query.Desc}
+	} else if query.Docs != nil && query.Docs.Desc != "" {
+		rule.FullDescription = &sarifMessage{Text: query.Docs.Desc}
+	}
+
+	if impact := query.Impact.GetValue(); impact != nil {
+		// used by code-scanning UIs to sort and filter findings
+		rule.Properties["security-severity"] = strconv.FormatFloat(float64(impact.Value)/10, 'f', 1, 64)
+	}
+	if len(query.Tags) != 0 {
+		tags := make([]string, 0, len(query.Tags))
+		for k, v := range query.Tags {
+			if v == "" {
+				tags = append(tags, k)
+			} else {
+				tags = append(tags, k+"="+v)
+			}
+		}
+		sort.Strings(tags)
+		rule.Properties["tags"] = tags
+	}
+	if len(rule.Properties) == 0 {
+		rule.Properties = nil
+	}
+
+	return rule
+}
+
+// sarifLevel maps the impact of a query to a SARIF level
+func sarifLevel(impact *explorer.Impact) string {
+	value := impact.GetValue()
+	if value == nil {
+		return "warning"
+	}
+	switch {
+	case value.Value >= 70:
+		return "error"
+	case value.Value >= 40:
+		return "warning"
+	case value.Value > 0:
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+func sarifQueryResult(query *explorer.Mquery, code *llx.CodeBundle, results map[string]*llx.RawResult) sarifResult {
+	res := sarifResult{
+		Kind:  "informational",
+		Level: "none",
+	}
+
+	switch queryOutcome(code, results) {
+	case queryPassed:
+		res.Kind = "pass"
+	case queryFailed:
+		res.Kind = "fail"
+		res.Level = sarifLevel(query.Impact)
+		if res.Level == "none" {
+			res.Level = "note"
+		}
+	}
+
+	res.Message.Text = strings.TrimSpace(printer.PlainNoColorPrinter.Results(code, results))
+	if res.Message.Text == "" {
+		res.Message.Text = query.Title
+	}
+	if res.Message.Text == "" {
+		res.Message.Text = query.Mql
+	}
+
+	res.Locations = sarifResultLocations(code, results)
+	return res
+}
+
+// sarifResultLocations collects the positions found in the results of a query
+func sarifResultLocations(code *llx.CodeBundle, results map[string]*llx.RawResult) []sarifLocation {
+	// entrypoints and datapoints may contain the same positions, so we
+	// only keep the first of each
+	type locationKey struct {
+		uri     string
+		region  sarifRegion
+		logical string
+	}
+	// results are keyed by checksum, iterate them in a stable order so the
+	// locations do not change between runs
+	checksums := make([]string, 0, len(results))
+	for checksum := range results {
+		checksums = append(checksums, checksum)
+	}
+	sort.Strings(checksums)
+
+	var res []sarifLocation
+	seen := map[locationKey]struct{}{}
+	for _, checksum := range checksums {
+		r := results[checksum]
+		if r == nil || r.Data == nil {
+			continue
+		}
+		for _, loc := range sarifPositions(code, r.Data) {
+			key := locationKey{}
+			if loc.PhysicalLocation != nil {
+				key.uri = loc.PhysicalLocation.ArtifactLocation.URI
+				if loc.PhysicalLocation.Region != nil {
+					key.region = *loc.PhysicalLocation.Region
+				}
+			}
+			for _, logical := range loc.LogicalLocations {
+				key.logical = logical.FullyQualifiedName
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			res = append(res, loc)
+		}
+	}
+
+	return res
+}
+
+// sarifPosition is a file position found in query results
+type sarifPosition struct {
+	path   string
+	line   int
+	column int
+}
+
+// sarifPositions looks for file positions in the results, so that findings
+// can point to the files they come from. Positions are read from resources
+// that have them, like the terraform.blocks that fail an assertion:
+//
+//	terraform.blocks.all(arguments.encrypted == true)
+//
+// They are also detected on blocks that select a path and line:
+//
+//	terraform.blocks { start { path line column } end { line column } }
+func sarifPositions(code *llx.CodeBundle, data *llx.RawData) []sarifLocation {
+	if data == nil || data.Value == nil {
+		return nil
+	}
+
+	if data.Type.IsResource() {
+		resource, ok := data.Value.(llx.Resource)
+		if !ok {
+			return nil
+		}
+		if loc := sarifResourceLocation(resource.MqlName(), resource.MqlID()); loc != nil {
+			return []sarifLocation{*loc}
+		}
+		return nil
+	}
+
+	switch data.Type.Underlying() {
+	case types.ArrayLike:
+		arr, ok := data.Value.([]interface{})
+		if !ok {
+			return nil
+		}
+		var res []sarifLocation
+		for i := range arr {
+			if v, ok := arr[i].(*llx.RawData); ok {
+				res = append(res, sarifPositions(code, v)...)
+			} else {
+				res = append(res, sarifPositions(code, &llx.RawData{Type: data.Type.Child(), Value: arr[i]})...)
+			}
+		}
+		return res
+
+	case types.Block:
+		m, ok := data.Value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		return sarifBlockPositions(code, m)
+	}
+
+	return nil
+}
+
+func sarifBlockPositions(code *llx.CodeBundle, block map[string]interface{}) []sarifLocation {
+	// positions are grouped by their prefix, e.g. `start.line` and `start { line }`
+	// both land in the "start" group, while a plain `line` lands in ""
+	fields := map[string]*sarifPosition{}
+	var res []sarifLocation
+	var nested []*llx.RawData
+
+	for checksum, v := range block {
+		rd, ok := v.(*llx.RawData)
+		if !ok || rd == nil {
+			continue
+		}
+		label := code.Labels.GetLabels()[checksum]
+		if label == "" {
+			continue
+		}
+
+		group, field := "", label
+		if idx := strings.LastIndexByte(label, '.'); idx != -1 {
+			group, field = label[:idx], label[idx+1:]
+		}
+
+		switch {
+		case field == "path" || field == "line" || field == "column":
+			pos, ok := fields[group]
+			if !ok {
+				pos = &sarifPosition{}
+				fields[group] = pos
+			}
+			switch x := rd.Value.(type) {
+			case string:
+				pos.path = x
+			case int64:
+				if field == "line" {
+					pos.line = int(x)
+				} else if field == "column" {
+					pos.column = int(x)
+				}
+			}
+
+		case rd.Type == types.Block && (label == "start" || label == "end"):
+			m, ok := rd.Value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			inner := sarifBlockFields(code, m)
+			if inner != nil {
+				fields[label] = inner
+			}
+
+		default:
+			nested = append(nested, rd)
+		}
+	}
+
+	start := fields["start"]
+	if start == nil {
+		start = fields[""]
+	}
+	if start != nil && start.path != "" {
+		loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: start.path}}
+		if start.line > 0 {
+			loc.Region = &sarifRegion{StartLine: start.line, StartColumn: start.column}
+			if end := fields["end"]; end != nil && end.line >= start.line {
+				loc.Region.EndLine = end.line
+				loc.Region.EndColumn = end.column
+			}
+		}
+		res = append(res, sarifLocation{PhysicalLocation: &loc})
+	}
+
+	for i := range nested {
+		res = append(res, sarifPositions(code, nested[i])...)
+	}
+	return res
+}
+
+// sarifResourceLocation returns the location of a resource, which results
+// only reference by name and ID. Terraform blocks and file positions encode
+// their position in the ID, files are identified by their path, and
+// Kubernetes objects by their kind, namespace and name.
+func sarifResourceLocation(name string, id string) *sarifLocation {
+	switch {
+	case name == "terraform.block":
+		return sarifPositionLocation(strings.TrimPrefix(id, "terraform.block/"))
+	case name == "terraform.fileposition":
+		return sarifPositionLocation(strings.TrimPrefix(id, "file.position/"))
+	case name == "file":
+		if id == "" {
+			return nil
+		}
+		return &sarifLocation{PhysicalLocation: &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: id},
+		}}
+	case strings.HasPrefix(name, "k8s.") && strings.Contains(id, ":"):
+		// e.g. pod:default:nginx
+		objectName := id[strings.LastIndexByte(id, ':')+1:]
+		return &sarifLocation{LogicalLocations: []sarifLogicalLocation{{
+			Name:               objectName,
+			FullyQualifiedName: id,
+			Kind:               name,
+		}}}
+	}
+	return nil
+}
+
+// sarifPositionLocation parses positions like main.tf/12/3 into a location
+func sarifPositionLocation(position string) *sarifLocation {
+	rest, column, ok := cutLast(position, "/")
+	if !ok {
+		return nil
+	}
+	path, line, ok := cutLast(rest, "/")
+	if !ok || path == "" {
+		return nil
+	}
+	lineNr, err := strconv.Atoi(line)
+	if err != nil {
+		return nil
+	}
+	columnNr, _ := strconv.Atoi(column)
+
+	loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: path}}
+	if lineNr > 0 {
+		loc.Region = &sarifRegion{StartLine: lineNr, StartColumn: columnNr}
+	}
+	return &sarifLocation{PhysicalLocation: &loc}
+}
+
+func cutLast(s string, sep string) (string, string, bool) {
+	idx := strings.LastIndex(s, sep)
+	if idx == -1 {
+		return s, "", false
+	}
+	return s[:idx], s[idx+len(sep):], true
+}
+
+// sarifBlockFields collects the position fields of a nested block
+func sarifBlockFields(code *llx.CodeBundle, block map[string]interface{}) *sarifPosition {
+	var res *sarifPosition
+	for checksum, v := range block {
+		rd, ok := v.(*llx.RawData)
+		if !ok || rd == nil {
+			continue
+		}
+
+		if res == nil {
+			res = &sarifPosition{}
+		}
+		switch code.Labels.GetLabels()[checksum] {
+		case "path":
+			res.path, _ = rd.Value.(string)
+		case "line":
+			line, _ := rd.Value.(int64)
+			res.line = int(line)
+		case "column":
+			column, _ := rd.Value.(int64)
+			res.column = int(column)
+		}
+	}
+	return res
+}
@@ -0,0 +1,139 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/explorer"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/types"
+	"sigs.k8s.io/yaml"
+)
+
+func TestSarifExport(t *testing.T) {
+	data, err := os.ReadFile("testdata/kubernetes_report.yaml")
+	require.NoError(t, err)
+
+	var report *explorer.ReportCollection
+	err = yaml.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	err = ConvertToSarif(report, &buf)
+	require.NoError(t, err)
+
+	var log sarifLog
+	err = json.Unmarshal(buf.Bytes(), &log)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, len(report.Bundle.Packs))
+
+	var run *sarifRun
+	for i := range log.Runs {
+		if log.Runs[i].AutomationDetails.ID == "//local.cnquery.io/run/local-execution/querypack/mondoo-kubernetes-cluster-incident-response/" {
+			run = &log.Runs[i]
+		}
+	}
+	require.NotNil(t, run)
+	assert.Equal(t, "cnquery", run.Tool.Driver.Name)
+
+	ruleIDs := []string{}
+	for _, rule := range run.Tool.Driver.Rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+	assert.Contains(t, ruleIDs, "//local.cnquery.io/run/local-execution/queries/role-bindings-with-cluster-admin-permissions")
+
+	require.NotEmpty(t, run.Results)
+	for _, res := range run.Results {
+		assert.Equal(t, ruleIDs[res.RuleIndex], res.RuleID)
+		assert.NotEmpty(t, res.Message.Text)
+		assert.Equal(t, "informational", res.Kind)
+	}
+}
+
+func TestSarifPositions(t *testing.T) {
+	t.Run("resources", func(t *testing.T) {
+		// e.g. the blocks that fail terraform.blocks.all(...)
+		data := llx.ArrayData([]interface{}{
+			&llx.MockResource{Name: "terraform.block", ID: "terraform.block/modules/s3/main.tf/12/3"},
+			&llx.MockResource{Name: "terraform.fileposition", ID: "file.position/main.tf/4/1"},
+			&llx.MockResource{Name: "file", ID: "/etc/ssh/sshd_config"},
+			&llx.MockResource{Name: "k8s.pod", ID: "pod:default:nginx"},
+			&llx.MockResource{Name: "package", ID: "openssl"},
+		}, types.Resource("terraform.block"))
+
+		locations := sarifPositions(&llx.CodeBundle{}, data)
+		assert.Equal(t, []sarifLocation{
+			{PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "modules/s3/main.tf"},
+				Region:           &sarifRegion{StartLine: 12, StartColumn: 3},
+			}},
+			{PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "main.tf"},
+				Region:           &sarifRegion{StartLine: 4, StartColumn: 1},
+			}},
+			{PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "/etc/ssh/sshd_config"},
+			}},
+			{LogicalLocations: []sarifLogicalLocation{{
+				Name:               "nginx",
+				FullyQualifiedName: "pod:default:nginx",
+				Kind:               "k8s.pod",
+			}}},
+		}, locations)
+	})
+
+	t.Run("selected fields", func(t *testing.T) {
+		code := &llx.CodeBundle{Labels: &llx.Labels{Labels: map[string]string{
+			"path":   "start.path",
+			"line":   "start.line",
+			"column": "start.column",
+			"end":    "end.line",
+		}}}
+		data := &llx.RawData{Type: types.Block, Value: map[string]interface{}{
+			"path":   llx.StringData("main.tf"),
+			"line":   llx.IntData(20),
+			"column": llx.IntData(1),
+			"end":    llx.IntData(24),
+		}}
+
+		locations := sarifPositions(code, data)
+		assert.Equal(t, []sarifLocation{
+			{PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "main.tf"},
+				Region:           &sarifRegion{StartLine: 20, StartColumn: 1, EndLine: 24},
+			}},
+		}, locations)
+	})
+}
+
+func TestSarifResultLocations(t *testing.T) {
+	file := func(path string) *llx.RawResult {
+		return &llx.RawResult{Data: llx.ResourceData(&llx.MockResource{Name: "file", ID: path}, "file")}
+	}
+	results := map[string]*llx.RawResult{
+		"c": file("/etc/hosts"),
+		"a": file("/etc/passwd"),
+		"d": file("/etc/passwd"),
+		"b": file("/etc/group"),
+		"e": nil,
+	}
+
+	expected := []sarifLocation{
+		{PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: "/etc/passwd"}}},
+		{PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: "/etc/group"}}},
+		{PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: "/etc/hosts"}}},
+	}
+	// map iteration order is random, the locations must not depend on it
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, sarifResultLocations(&llx.CodeBundle{}, results))
+	}
+}
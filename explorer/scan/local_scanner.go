@@ -43,6 +43,7 @@ type LocalScanner struct {
 	upstream           *upstream.UpstreamConfig
 	recording          llx.Recording
 	disableProgressBar bool
+	reporter           Reporter
 }
 
 type ScannerOption func(*LocalScanner)
@@ -59,6 +60,15 @@ func WithRecording(r llx.Recording) func(s *LocalScanner) {
 	}
 }
 
+// WithReporter streams the results of every asset to the given reporter as
+// soon as its scan is finished. Asset reports are then no longer collected
+// in the returned report collection, which only lists assets and errors.
+func WithReporter(r Reporter) ScannerOption {
+	return func(s *LocalScanner) {
+		s.reporter = r
+	}
+}
+
 func DisableProgressBar() ScannerOption {
 	return func(s *LocalScanner) {
 		s.disableProgressBar = true
@@ -226,7 +236,14 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 	}()
 
 	// plan scan jobs
-	reporter := NewAggregateReporter()
+	aggregateReporter := NewAggregateReporter()
+	var reporter Reporter = aggregateReporter
+	if s.reporter != nil {
+		reporter = newStreamReporter(aggregateReporter, s.reporter)
+		if job.Bundle != nil {
+			s.reporter.AddBundle(job.Bundle)
+		}
+	}
 	if job.Bundle == nil && upstream != nil && upstream.Creds != nil {
 		client, err := upstream.InitClient(ctx)
 		if err != nil {
@@ -252,7 +269,7 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 	}
 
 	if len(discoveredAssets.Assets) == 0 {
-		return reporter.Reports(), nil
+		return aggregateReporter.Reports(), nil
 	}
 
 	multiprogress, err := CreateProgressBar(discoveredAssets, s.disableProgressBar)
@@ -395,7 +412,7 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		wg.Wait()
 	}
 	scanGroups.Wait()
-	return reporter.Reports(), nil
+	return aggregateReporter.Reports(), nil
 }
 
 func HandleDelayedDiscovery(ctx context.Context, asset *inventory.Asset, runtime *providers.Runtime, services *explorer.Services, spaceMrn string) (*inventory.Asset, error) {
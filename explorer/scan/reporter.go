@@ -79,3 +79,37 @@ func (r *AggregateReporter) Error() error {
 	}
 	return err.Deduplicate()
 }
+
+// streamReporter forwards all asset reports to another reporter. It only
+// keeps track of assets, errors and the bundle, so that reports of large
+// scans are not held in memory.
+type streamReporter struct {
+	*AggregateReporter
+	stream Reporter
+}
+
+func newStreamReporter(aggregate *AggregateReporter, stream Reporter) *streamReporter {
+	return &streamReporter{
+		AggregateReporter: aggregate,
+		stream:            stream,
+	}
+}
+
+func (r *streamReporter) AddReport(asset *inventory.Asset, results *AssetReport) {
+	r.assets[asset.Mrn] = &explorer.Asset{
+		Name:    asset.Name,
+		Mrn:     asset.Mrn,
+		TraceId: asset.TraceId,
+	}
+	r.stream.AddReport(asset, results)
+}
+
+func (r *streamReporter) AddBundle(bundle *explorer.Bundle) {
+	r.AggregateReporter.AddBundle(bundle)
+	r.stream.AddBundle(bundle)
+}
+
+func (r *streamReporter) AddScanError(asset *inventory.Asset, err error) {
+	r.AggregateReporter.AddScanError(asset, err)
+	r.stream.AddScanError(asset, err)
+}
@@ -325,15 +325,25 @@ func init() {
 			string("contains" + types.Array(types.Int)):    {f: stringContainsArrayIntV2, Label: "contains"},
 			string("contains" + types.Regex):               {f: stringContainsRegex, Label: "contains"},
 			string("contains" + types.Array(types.Regex)):  {f: stringContainsArrayRegex, Label: "contains"},
-			string("in"):        {f: stringInArray, Label: "in"},
-			string("find"):      {f: stringFindV2, Label: "find"},
-			string("camelcase"): {f: stringCamelcaseV2, Label: "camelcase"},
-			string("downcase"):  {f: stringDowncaseV2, Label: "downcase"},
-			string("upcase"):    {f: stringUpcaseV2, Label: "upcase"},
-			string("length"):    {f: stringLengthV2, Label: "length"},
-			string("lines"):     {f: stringLinesV2, Label: "lines"},
-			string("split"):     {f: stringSplitV2, Label: "split"},
-			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			string("in"):                     {f: stringInArray, Label: "in"},
+			string("find"):                   {f: stringFindV2, Label: "find"},
+			string("camelcase"):              {f: stringCamelcaseV2, Label: "camelcase"},
+			string("downcase"):               {f: stringDowncaseV2, Label: "downcase"},
+			string("upcase"):                 {f: stringUpcaseV2, Label: "upcase"},
+			string("length"):                 {f: stringLengthV2, Label: "length"},
+			string("lines"):                  {f: stringLinesV2, Label: "lines"},
+			string("split"):                  {f: stringSplitV2, Label: "split"},
+			string("trim"):                   {f: stringTrimV2, Label: "trim"},
+			string("replace" + types.String): {f: stringReplaceStringV2, Label: "replace"},
+			string("replace" + types.Regex):  {f: stringReplaceRegexV2, Label: "replace"},
+			string("startsWith"):             {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):               {f: stringEndsWithV2, Label: "endsWith"},
+			string("substr"):                 {f: stringSubstrV2, Label: "substr"},
+			string("padLeft"):                {f: stringPadLeftV2, Label: "padLeft"},
+			string("padRight"):               {f: stringPadRightV2, Label: "padRight"},
+			string("sha256"):                 {f: stringSha256V2, Label: "sha256"},
+			string("sha1"):                   {f: stringSha1V2, Label: "sha1"},
+			string("md5"):                    {f: stringMd5V2, Label: "md5"},
 		},
 		types.StringSlice: {
 			// TODO: implement the remaining calls for this type
@@ -534,6 +544,16 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			string("replace" + types.String):  {f: dictReplaceStringV2, Label: "replace"},
+			string("replace" + types.Regex):   {f: dictReplaceRegexV2, Label: "replace"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"substr":                          {f: dictSubstrV2, Label: "substr"},
+			"padLeft":                         {f: dictPadLeftV2, Label: "padLeft"},
+			"padRight":                        {f: dictPadRightV2, Label: "padRight"},
+			"sha256":                          {f: dictSha256V2, Label: "sha256"},
+			"sha1":                            {f: dictSha1V2, Label: "sha1"},
+			"md5":                             {f: dictMd5V2, Label: "md5"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhere, Label: "where"},
@@ -545,6 +565,16 @@ func init() {
 			"$one":                            {f: dictOneV2},
 			"map":                             {f: dictMapV2},
 			"flat":                            {f: dictFlat},
+			"sort":                            {f: dictSort},
+			"sortBy":                          {f: dictSortBy},
+			"groupBy":                         {f: dictGroupBy},
+			"reverse":                         {f: dictReverse},
+			"take":                            {f: dictTake},
+			"skip":                            {f: dictSkip},
+			"sum":                             {f: dictSum},
+			"min":                             {f: dictMin},
+			"max":                             {f: dictMax},
+			"avg":                             {f: dictAvg},
 			"difference":                      {f: dictDifferenceV2},
 			"containsAll":                     {f: dictContainsAll},
 			"containsNone":                    {f: dictContainsNone},
@@ -578,6 +608,41 @@ func init() {
 			string("<=" + types.String): {f: semverLTEsemver, Label: "<="},
 			string(">=" + types.String): {f: semverGTEsemver, Label: ">="},
 		},
+		types.IP: {
+			string("==" + types.Nil):    {f: stringCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):    {f: stringNotNilV2, Label: "!="},
+			string("==" + types.Empty):  {f: stringCmpEmptyV2, Label: "=="},
+			string("!=" + types.Empty):  {f: stringNotEmptyV2, Label: "!="},
+			string("==" + types.IP):     {f: ipCmpIP, Label: "=="},
+			string("!=" + types.IP):     {f: ipNotIP, Label: "!="},
+			string("==" + types.String): {f: ipCmpIP, Label: "=="},
+			string("!=" + types.String): {f: ipNotIP, Label: "!="},
+			"inRange":                   {f: ipInRange},
+			"overlaps":                  {f: ipOverlaps},
+			"isPrivate":                 {f: ipIsPrivate},
+			"isLoopback":                {f: ipIsLoopback},
+			"version":                   {f: ipVersion},
+			"prefix":                    {f: ipPrefix},
+			"subnet":                    {f: ipSubnet},
+		},
+		types.VersionLike: {
+			string("==" + types.Nil):         {f: stringCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):         {f: stringNotNilV2, Label: "!="},
+			string("==" + types.Empty):       {f: stringCmpEmptyV2, Label: "=="},
+			string("!=" + types.Empty):       {f: stringNotEmptyV2, Label: "!="},
+			string("==" + types.VersionLike): {f: versionCmpVersion, Label: "=="},
+			string("!=" + types.VersionLike): {f: versionNotVersion, Label: "!="},
+			string("<" + types.VersionLike):  {f: versionLTversion, Label: "<"},
+			string(">" + types.VersionLike):  {f: versionGTversion, Label: ">"},
+			string("<=" + types.VersionLike): {f: versionLTEversion, Label: "<="},
+			string(">=" + types.VersionLike): {f: versionGTEversion, Label: ">="},
+			string("==" + types.String):      {f: versionCmpVersion, Label: "=="},
+			string("!=" + types.String):      {f: versionNotVersion, Label: "!="},
+			string("<" + types.String):       {f: versionLTversion, Label: "<"},
+			string(">" + types.String):       {f: versionGTversion, Label: ">"},
+			string("<=" + types.String):      {f: versionLTEversion, Label: "<="},
+			string(">=" + types.String):      {f: versionGTEversion, Label: ">="},
+		},
 		types.ArrayLike: {
 			"[]":                       {f: arrayGetIndexV2},
 			"first":                    {f: arrayGetFirstIndexV2},
@@ -593,6 +658,16 @@ func init() {
 			"$one":                     {f: arrayOneV2},
 			"map":                      {f: arrayMapV2},
 			"flat":                     {f: arrayFlat},
+			"sort":                     {f: arraySort},
+			"sortBy":                   {f: arraySortBy},
+			"groupBy":                  {f: arrayGroupBy},
+			"reverse":                  {f: arrayReverse},
+			"take":                     {f: arrayTake},
+			"skip":                     {f: arraySkip},
+			"sum":                      {f: arraySum},
+			"min":                      {f: arrayMin},
+			"max":                      {f: arrayMax},
+			"avg":                      {f: arrayAvg},
 			"duplicates":               {f: arrayDuplicatesV2},
 			"fieldDuplicates":          {f: arrayFieldDuplicatesV2},
 			"unique":                   {f: arrayUniqueV2},
@@ -731,6 +806,10 @@ func init() {
 			// TODO: [#32] unique builtin fields that need a long-term support in LR
 			string(types.Resource("parse") + ".date"):     {f: resourceDateV2},
 			string(types.Resource("parse") + ".duration"): {f: resourceDuration},
+			string(types.Resource("parse") + ".base64"):   {f: resourceBase64},
+			string(types.Resource("parse") + ".hex"):      {f: resourceHex},
+			string(types.Resource("parse") + ".jwt"):      {f: resourceJwt},
+			string(types.Resource("parse") + ".urlQuery"): {f: resourceUrlQuery},
 		},
 	}
 
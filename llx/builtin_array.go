@@ -5,7 +5,10 @@ package llx
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/v11/types"
 	"go.mondoo.com/cnquery/v11/utils/multierr"
@@ -471,6 +474,417 @@ func arrayFlat(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawD
 	return &RawData{Type: types.Array(typ), Value: res}, 0, nil
 }
 
+// sortRank groups dict values by their kind, so that values of different
+// kinds can be sorted in a stable order
+func sortRank(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case int64, float64:
+		return 2
+	case string:
+		return 3
+	case *time.Time:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// dictLess compares two dict values. Numbers are compared by value, no matter
+// if they are ints or floats. Values of different kinds are sorted by kind.
+func dictLess(left interface{}, right interface{}) bool {
+	lr, rr := sortRank(left), sortRank(right)
+	if lr != rr {
+		return lr < rr
+	}
+
+	switch l := left.(type) {
+	case bool:
+		return types.Less[types.Bool](l, right)
+	case int64:
+		if r, ok := right.(int64); ok {
+			return l < r
+		}
+		return float64(l) < right.(float64)
+	case float64:
+		if r, ok := right.(int64); ok {
+			return l < float64(r)
+		}
+		return l < right.(float64)
+	case string:
+		return l < right.(string)
+	case *time.Time:
+		return types.Less[types.Time](l, right)
+	default:
+		return false
+	}
+}
+
+// lessFunc returns a function to compare two values of the given type.
+// Null values are always sorted first.
+func lessFunc(typ types.Type) (func(interface{}, interface{}) bool, error) {
+	if typ == types.Dict || typ == types.Any {
+		return dictLess, nil
+	}
+
+	less, ok := types.Less[typ]
+	if !ok {
+		return nil, errors.New("don't know how to compare values of type " + typ.Label())
+	}
+
+	return func(left interface{}, right interface{}) bool {
+		if left == nil || right == nil {
+			return left == nil && right != nil
+		}
+		return less(left, right)
+	}, nil
+}
+
+func arraySort(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	less, err := lessFunc(bind.Type.Child())
+	if err != nil {
+		return nil, 0, errors.New("cannot sort array: " + err.Error())
+	}
+
+	res := make([]interface{}, len(list))
+	copy(res, list)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arrayReverse(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	res := make([]interface{}, len(list))
+	for i := range list {
+		res[len(list)-1-i] = list[i]
+	}
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func _arraySlice(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, skip bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	argRef := chunk.Function.Args[0]
+	arg, rref, err := e.resolveValue(argRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	n, ok := arg.Value.(int64)
+	if !ok {
+		return nil, 0, errors.New("called '" + chunk.Id + "' with a non-integer argument")
+	}
+	if n < 0 {
+		return nil, 0, errors.New("called '" + chunk.Id + "' with a negative number (" + strconv.FormatInt(n, 10) + ")")
+	}
+	if n > int64(len(list)) {
+		n = int64(len(list))
+	}
+
+	if skip {
+		return &RawData{Type: bind.Type, Value: list[n:]}, 0, nil
+	}
+	return &RawData{Type: bind.Type, Value: list[:n]}, 0, nil
+}
+
+func arrayTake(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arraySlice(e, bind, chunk, ref, false)
+}
+
+func arraySkip(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arraySlice(e, bind, chunk, ref, true)
+}
+
+func _arrayMinMax(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, max bool) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	less, err := lessFunc(bind.Type.Child())
+	if err != nil {
+		return nil, 0, errors.New("cannot call '" + chunk.Id + "': " + err.Error())
+	}
+
+	var res interface{}
+	for i := range list {
+		cur := list[i]
+		if cur == nil {
+			continue
+		}
+		if res == nil || (max && less(res, cur)) || (!max && less(cur, res)) {
+			res = cur
+		}
+	}
+
+	return &RawData{Type: typ, Value: res}, 0, nil
+}
+
+func arrayMin(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayMinMax(e, bind, chunk, ref, false)
+}
+
+func arrayMax(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayMinMax(e, bind, chunk, ref, true)
+}
+
+// sumNumbers adds up all numbers in the list and counts them. Null values are
+// ignored. The int sum is only valid if the list contains no floats.
+func sumNumbers(list []interface{}) (int64, float64, int, bool, error) {
+	var isum int64
+	var fsum float64
+	var cnt int
+	onlyInts := true
+	for i := range list {
+		switch x := list[i].(type) {
+		case nil:
+			continue
+		case int64:
+			isum += x
+			fsum += float64(x)
+		case float64:
+			fsum += x
+			onlyInts = false
+		default:
+			return 0, 0, 0, false, errors.New("cannot add up non-numeric value")
+		}
+		cnt++
+	}
+	return isum, fsum, cnt, onlyInts, nil
+}
+
+func arraySum(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	isum, fsum, _, onlyInts, err := sumNumbers(list)
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+
+	switch typ {
+	case types.Int:
+		return IntData(isum), 0, nil
+	case types.Float:
+		return FloatData(fsum), 0, nil
+	}
+	if onlyInts {
+		return &RawData{Type: typ, Value: isum}, 0, nil
+	}
+	return &RawData{Type: typ, Value: fsum}, 0, nil
+}
+
+func arrayAvg(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	_, fsum, cnt, _, err := sumNumbers(list)
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	if cnt == 0 {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	return &RawData{Type: typ, Value: fsum / float64(cnt)}, 0, nil
+}
+
+// runFieldBlocks runs the function block of the given chunk on every entry
+// of the bound list and hands the list with its computed field values to
+// the callback, which produces the result of the chunk.
+func runFieldBlocks(e *blockExecutor, chunk *Chunk, ref uint64, f func(list []interface{}, fields []*RawData) *RawData) (*RawData, uint64, error) {
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return &RawData{Type: types.Type(chunk.Function.Type), Error: items.Error}, 0, nil
+	}
+
+	list, ok := items.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to call '" + chunk.Id + "' on a non-list value")
+	}
+
+	if len(list) == 0 {
+		return f(list, nil), 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("failed to retrieve function reference of '" + chunk.Id + "' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		block := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[block.Entrypoints[0]]
+
+		fields := make([]*RawData, len(results))
+		for i, res := range results {
+			epVal, ok := res.entrypoints[epChecksum].(*RawData)
+			if !ok {
+				epVal = &RawData{Type: types.Nil}
+			}
+			fields[i] = epVal
+		}
+
+		data := f(list, fields)
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+func arraySortBy(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	return runFieldBlocks(e, chunk, ref, func(list []interface{}, fields []*RawData) *RawData {
+		idx := make([]int, len(list))
+		for i := range idx {
+			idx[i] = i
+		}
+
+		var less func(interface{}, interface{}) bool
+		for i := range fields {
+			if fields[i].Error != nil {
+				return &RawData{Type: typ, Error: fields[i].Error}
+			}
+			if less != nil || fields[i].Value == nil {
+				continue
+			}
+			var err error
+			less, err = lessFunc(fields[i].Type)
+			if err != nil {
+				return &RawData{Type: typ, Error: errors.New("cannot sort by field: " + err.Error())}
+			}
+		}
+		if less == nil {
+			less = dictLess
+		}
+
+		sort.SliceStable(idx, func(i, j int) bool {
+			return less(fields[idx[i]].Value, fields[idx[j]].Value)
+		})
+
+		res := make([]interface{}, len(list))
+		for i := range idx {
+			res[i] = list[idx[i]]
+		}
+		return &RawData{Type: typ, Value: res}
+	})
+}
+
+// groupKey turns a field value into the key of the group it belongs to
+func groupKey(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return x
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	case *time.Time:
+		return x.Format(time.RFC3339)
+	default:
+		return fmt.Sprintf("%v", x)
+	}
+}
+
+func arrayGroupBy(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	return runFieldBlocks(e, chunk, ref, func(list []interface{}, fields []*RawData) *RawData {
+		res := map[string]interface{}{}
+		for i := range fields {
+			if fields[i].Error != nil {
+				return &RawData{Type: typ, Error: fields[i].Error}
+			}
+			key := groupKey(fields[i].Value)
+			group, _ := res[key].([]interface{})
+			res[key] = append(group, list[i])
+		}
+		return &RawData{Type: typ, Value: res}
+	})
+}
+
 // Take an array and separate it into a list of unique entries and another
 // list of only duplicates. The latter list only has every entry appear only
 // once.
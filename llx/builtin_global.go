@@ -5,9 +5,12 @@ package llx
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/v11/types"
+	"go.mondoo.com/cnquery/v11/utils/versions/generic"
 )
 
 // handleGlobal takes a global function and returns a handler if found.
@@ -34,17 +37,20 @@ func init() {
 		"switch":         switchCallV2,
 		"score":          scoreCallV2,
 		"typeof":         typeofCallV2,
+		"format":         formatCall,
 		"{}":             blockV2,
 		"return":         returnCallV2,
 		"createResource": globalCreateResource,
 		// type-conversions
-		"string": stringCall,
-		"$regex": regexCall, // TODO: support both the regex resource and the internal typemap!
-		"float":  floatCall,
-		"int":    intCall,
-		"bool":   boolCall,
-		"dict":   dictCall,
-		"semver": semverCall,
+		"string":  stringCall,
+		"$regex":  regexCall, // TODO: support both the regex resource and the internal typemap!
+		"float":   floatCall,
+		"int":     intCall,
+		"bool":    boolCall,
+		"dict":    dictCall,
+		"semver":  semverCall,
+		"ip":      ipCall,
+		"version": versionCall,
 	}
 }
 
@@ -193,6 +199,39 @@ func typeofCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64,
 	return StringData(res.Type.Label()), 0, nil
 }
 
+func formatCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) < 1 {
+		return nil, 0, errors.New("Called `format` without arguments, expected at least one")
+	}
+
+	args := make([]any, len(f.Args))
+	for i := range f.Args {
+		res, dref, err := e.resolveValue(f.Args[i], ref)
+		if err != nil || dref != 0 || res == nil {
+			return res, dref, err
+		}
+
+		switch v := res.Value.(type) {
+		case *time.Time:
+			if v != nil {
+				args[i] = *v
+			}
+		default:
+			args[i] = v
+		}
+	}
+
+	format, ok := args[0].(string)
+	if !ok {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to format string, the format must be a string"),
+		}, 0, nil
+	}
+
+	return StringData(fmt.Sprintf(format, args[1:]...)), 0, nil
+}
+
 func semverCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
 	if len(f.Args) != 1 {
 		return nil, 0, errors.New("Called `semver` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one")
@@ -206,6 +245,81 @@ func semverCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, er
 	return &RawData{Type: types.Semver, Value: res.Value}, 0, nil
 }
 
+func ipCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 {
+		return nil, 0, errors.New("Called `ip` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Value == nil {
+		return &RawData{Type: types.IP}, 0, nil
+	}
+
+	s, ok := res.Value.(string)
+	if !ok {
+		return &RawData{
+			Type:  types.IP,
+			Error: errors.New("cannot convert " + res.Type.Label() + " to ip"),
+		}, 0, nil
+	}
+
+	ip, err := normalizeIP(s)
+	if err != nil {
+		return &RawData{Type: types.IP, Error: err}, 0, nil
+	}
+	return IPData(ip), 0, nil
+}
+
+func versionCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 && len(f.Args) != 2 {
+		return nil, 0, errors.New("Called `version` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one or two")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+
+	scheme := defaultVersionScheme
+	if len(f.Args) == 2 {
+		schemeArg, dref, err := e.resolveValue(f.Args[1], ref)
+		if err != nil || dref != 0 || schemeArg == nil {
+			return schemeArg, dref, err
+		}
+		s, ok := schemeArg.Value.(string)
+		if !ok || s == "" {
+			return &RawData{
+				Type:  types.Version(""),
+				Error: errors.New("the versioning scheme for `version` must be a non-empty string"),
+			}, 0, nil
+		}
+		scheme = s
+	}
+
+	typ := types.Version(scheme)
+	if res.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	v, ok := res.Value.(string)
+	if !ok {
+		return &RawData{
+			Type:  typ,
+			Error: errors.New("cannot convert " + res.Type.Label() + " to version"),
+		}, 0, nil
+	}
+
+	// comparing the version to itself validates both the version and the scheme
+	if _, err := generic.Compare(scheme, v, v); err != nil {
+		return &RawData{Type: typ, Error: errors.New("invalid " + scheme + " version '" + v + "': " + err.Error())}, 0, nil
+	}
+
+	return &RawData{Type: typ, Value: v}, 0, nil
+}
+
 func stringCall(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
 	if len(f.Args) != 1 {
 		return nil, 0, errors.New("Called `string` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one")
@@ -0,0 +1,133 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+// parseIP turns an IP address or CIDR into a prefix. Plain addresses are
+// treated as host prefixes, i.e. /32 for IPv4 and /128 for IPv6. IPv4-mapped
+// IPv6 addresses are turned into IPv4.
+func parseIP(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, errors.New("failed to parse CIDR '" + s + "'")
+		}
+		// IPv4-mapped prefixes are treated as their IPv4 equivalent, as long as
+		// they don't cover more than the mapped address space
+		if addr := prefix.Addr(); addr.Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+		}
+		return prefix, nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, errors.New("failed to parse IP address '" + s + "'")
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// normalizeIP parses the given IP or CIDR and returns its canonical form
+func normalizeIP(s string) (string, error) {
+	prefix, err := parseIP(s)
+	if err != nil {
+		return "", err
+	}
+	if prefix.IsSingleIP() && !strings.Contains(s, "/") {
+		return prefix.Addr().String(), nil
+	}
+	return prefix.String(), nil
+}
+
+func ipOp(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(netip.Prefix, netip.Prefix) bool) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		l, err := parseIP(left.(string))
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		r, err := parseIP(right.(string))
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		return BoolData(f(l, r))
+	})
+}
+
+func ipCmpIP(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOp(e, bind, chunk, ref, func(left netip.Prefix, right netip.Prefix) bool {
+		return left == right
+	})
+}
+
+func ipNotIP(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOp(e, bind, chunk, ref, func(left netip.Prefix, right netip.Prefix) bool {
+		return left != right
+	})
+}
+
+func ipInRange(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOp(e, bind, chunk, ref, func(ip netip.Prefix, cidr netip.Prefix) bool {
+		return cidr.Bits() <= ip.Bits() && cidr.Masked().Contains(ip.Addr())
+	})
+}
+
+func ipOverlaps(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOp(e, bind, chunk, ref, func(left netip.Prefix, right netip.Prefix) bool {
+		return left.Masked().Overlaps(right.Masked())
+	})
+}
+
+func ipProperty(bind *RawData, typ types.Type, f func(netip.Prefix) interface{}) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	prefix, err := parseIP(bind.Value.(string))
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	return &RawData{Type: typ, Value: f(prefix)}, 0, nil
+}
+
+func ipIsPrivate(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipProperty(bind, types.Bool, func(prefix netip.Prefix) interface{} {
+		return prefix.Addr().IsPrivate()
+	})
+}
+
+func ipIsLoopback(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipProperty(bind, types.Bool, func(prefix netip.Prefix) interface{} {
+		return prefix.Addr().IsLoopback()
+	})
+}
+
+func ipVersion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipProperty(bind, types.Int, func(prefix netip.Prefix) interface{} {
+		if prefix.Addr().Is4() {
+			return int64(4)
+		}
+		return int64(6)
+	})
+}
+
+func ipPrefix(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipProperty(bind, types.Int, func(prefix netip.Prefix) interface{} {
+		return int64(prefix.Bits())
+	})
+}
+
+func ipSubnet(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipProperty(bind, types.IP, func(prefix netip.Prefix) interface{} {
+		return prefix.Masked().String()
+	})
+}
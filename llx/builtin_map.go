@@ -583,6 +583,96 @@ func dictTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return stringTrimV2(e, bind, chunk, ref)
 }
 
+func dictReplaceStringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replace`")
+	}
+
+	return stringReplaceStringV2(e, bind, chunk, ref)
+}
+
+func dictReplaceRegexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replace`")
+	}
+
+	return stringReplaceRegexV2(e, bind, chunk, ref)
+}
+
+func dictStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `startsWith`")
+	}
+
+	return stringStartsWithV2(e, bind, chunk, ref)
+}
+
+func dictEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `endsWith`")
+	}
+
+	return stringEndsWithV2(e, bind, chunk, ref)
+}
+
+func dictSubstrV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `substr`")
+	}
+
+	return stringSubstrV2(e, bind, chunk, ref)
+}
+
+func dictPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padLeft`")
+	}
+
+	return stringPadLeftV2(e, bind, chunk, ref)
+}
+
+func dictPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padRight`")
+	}
+
+	return stringPadRightV2(e, bind, chunk, ref)
+}
+
+func dictSha256V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `sha256`")
+	}
+
+	return stringSha256V2(e, bind, chunk, ref)
+}
+
+func dictSha1V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `sha1`")
+	}
+
+	return stringSha1V2(e, bind, chunk, ref)
+}
+
+func dictMd5V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `md5`")
+	}
+
+	return stringMd5V2(e, bind, chunk, ref)
+}
+
 func dictKeysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
 		return &RawData{
@@ -3149,3 +3239,67 @@ func timeTimesDictV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64)
 		}
 	})
 }
+
+func dictSort(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `sort`")
+	}
+	return arraySort(e, bind, chunk, ref)
+}
+
+func dictSortBy(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arraySortBy(e, bind, chunk, ref)
+}
+
+func dictGroupBy(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayGroupBy(e, bind, chunk, ref)
+}
+
+func dictReverse(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `reverse`")
+	}
+	return arrayReverse(e, bind, chunk, ref)
+}
+
+func dictTake(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `take`")
+	}
+	return arrayTake(e, bind, chunk, ref)
+}
+
+func dictSkip(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `skip`")
+	}
+	return arraySkip(e, bind, chunk, ref)
+}
+
+func dictSum(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `sum`")
+	}
+	return arraySum(e, bind, chunk, ref)
+}
+
+func dictMin(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `min`")
+	}
+	return arrayMin(e, bind, chunk, ref)
+}
+
+func dictMax(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `max`")
+	}
+	return arrayMax(e, bind, chunk, ref)
+}
+
+func dictAvg(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if _, ok := bind.Value.([]interface{}); !ok && bind.Value != nil {
+		return nil, 0, errors.New("dict value does not support `avg`")
+	}
+	return arrayAvg(e, bind, chunk, ref)
+}
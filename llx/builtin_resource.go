@@ -4,7 +4,11 @@
 package llx
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -332,3 +336,147 @@ func resourceDuration(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64)
 
 	return TimeData(t), 0, nil
 }
+
+func resourceParseString(e *blockExecutor, chunk *Chunk, ref uint64, name string) (string, uint64, error) {
+	args, rref, err := primitive2array(e, ref, chunk.Function.Args)
+	if err != nil || rref != 0 {
+		return "", rref, err
+	}
+
+	if len(args) == 0 {
+		return "", 0, errors.New("failed to parse " + name + ", no value provided")
+	}
+
+	value, ok := args[0].(string)
+	if !ok {
+		return "", 0, errors.New("failed to parse " + name + ", value needs to be a string")
+	}
+	return value, 0, nil
+}
+
+var base64Encodings = []*base64.Encoding{
+	base64.StdEncoding,
+	base64.RawStdEncoding,
+	base64.URLEncoding,
+	base64.RawURLEncoding,
+}
+
+func decodeBase64(value string) ([]byte, error) {
+	value = strings.Join(strings.Fields(value), "")
+
+	var err error
+	var res []byte
+	for _, enc := range base64Encodings {
+		res, err = enc.DecodeString(value)
+		if err == nil {
+			return res, nil
+		}
+	}
+	return nil, err
+}
+
+func resourceBase64(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	value, rref, err := resourceParseString(e, chunk, ref, "base64")
+	if err != nil || rref != 0 {
+		return nil, rref, err
+	}
+
+	res, err := decodeBase64(value)
+	if err != nil {
+		return nil, 0, errors.New("failed to parse base64: " + err.Error())
+	}
+	return StringData(string(res)), 0, nil
+}
+
+func resourceHex(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	value, rref, err := resourceParseString(e, chunk, ref, "hex")
+	if err != nil || rref != 0 {
+		return nil, rref, err
+	}
+
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X")
+	res, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, 0, errors.New("failed to parse hex: " + err.Error())
+	}
+	return StringData(string(res)), 0, nil
+}
+
+// resourceJwt decodes the header and claims of a JSON Web Token. Note that
+// the signature is not verified.
+func resourceJwt(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	value, rref, err := resourceParseString(e, chunk, ref, "jwt")
+	if err != nil || rref != 0 {
+		return nil, rref, err
+	}
+
+	parts := strings.Split(strings.TrimSpace(value), ".")
+	if len(parts) != 3 {
+		return nil, 0, errors.New("failed to parse jwt, expected 3 parts but got " + strconv.Itoa(len(parts)))
+	}
+
+	decodePart := func(name string, part string) (map[string]interface{}, error) {
+		raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+		if err != nil {
+			return nil, errors.New("failed to parse jwt " + name + ": " + err.Error())
+		}
+		res := map[string]interface{}{}
+		if err := json.Unmarshal(raw, &res); err != nil {
+			return nil, errors.New("failed to parse jwt " + name + ": " + err.Error())
+		}
+		return res, nil
+	}
+
+	header, err := decodePart("header", parts[0])
+	if err != nil {
+		return nil, 0, err
+	}
+	claims, err := decodePart("claims", parts[1])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return DictData(map[string]interface{}{
+		"header":    header,
+		"claims":    claims,
+		"signature": parts[2],
+	}), 0, nil
+}
+
+// resourceUrlQuery parses URL query parameters. It accepts a full URL or
+// only its query. Parameters that are set once are returned as strings,
+// parameters that are set multiple times are returned as lists of strings.
+func resourceUrlQuery(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	value, rref, err := resourceParseString(e, chunk, ref, "urlQuery")
+	if err != nil || rref != 0 {
+		return nil, rref, err
+	}
+
+	if idx := strings.IndexByte(value, '?'); idx >= 0 {
+		value = value[idx+1:]
+	}
+	if idx := strings.IndexByte(value, '#'); idx >= 0 {
+		value = value[:idx]
+	}
+
+	query, err := url.ParseQuery(value)
+	if err != nil {
+		return nil, 0, errors.New("failed to parse url query: " + err.Error())
+	}
+
+	res := make(map[string]interface{}, len(query))
+	for key, values := range query {
+		if len(values) == 1 {
+			res[key] = values[0]
+			continue
+		}
+		list := make([]interface{}, len(values))
+		for i := range values {
+			list[i] = values[i]
+		}
+		res[key] = list
+	}
+
+	return DictData(res), 0, nil
+}
@@ -4,7 +4,12 @@
 package llx
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"hash"
 	"io"
 	"regexp"
 	"strconv"
@@ -2409,6 +2414,214 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+func _stringReplace(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, replace func(string, string, string) (string, error)) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	search, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	replacement, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if search.Value == nil || replacement.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to replace string, search and replacement cannot be null"),
+		}, 0, nil
+	}
+
+	res, err := replace(bind.Value.(string), search.Value.(string), replacement.Value.(string))
+	if err != nil {
+		return nil, 0, err
+	}
+	return StringData(res), 0, nil
+}
+
+func stringReplaceStringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringReplace(e, bind, chunk, ref, func(s string, search string, replacement string) (string, error) {
+		return strings.ReplaceAll(s, search, replacement), nil
+	})
+}
+
+func stringReplaceRegexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringReplace(e, bind, chunk, ref, func(s string, search string, replacement string) (string, error) {
+		re, err := regexp.Compile(search)
+		if err != nil {
+			return "", errors.New("Failed to compile regular expression: " + search)
+		}
+		return re.ReplaceAllString(s, replacement), nil
+	})
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if arg.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasPrefix(bind.Value.(string), arg.Value.(string))), 0, nil
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if arg.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasSuffix(bind.Value.(string), arg.Value.(string))), 0, nil
+}
+
+// substr returns the part of the string that starts at the given character
+// index and has the given length. A negative start is counted from the end
+// of the string. If no length is provided, it returns the rest of the string.
+func stringSubstrV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	startArg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if startArg.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to get substring, start was null"),
+		}, 0, nil
+	}
+
+	runes := []rune(bind.Value.(string))
+	start := int(startArg.Value.(int64))
+	if start < 0 {
+		start += len(runes)
+		if start < 0 {
+			start = 0
+		}
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+
+	end := len(runes)
+	if len(chunk.Function.Args) > 1 {
+		lenArg, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		if lenArg.Value != nil {
+			length := int(lenArg.Value.(int64))
+			if length < 0 {
+				return &RawData{
+					Type:  types.String,
+					Error: errors.New("failed to get substring, length cannot be negative"),
+				}, 0, nil
+			}
+			if start+length < end {
+				end = start + length
+			}
+		}
+	}
+
+	return StringData(string(runes[start:end])), 0, nil
+}
+
+func _stringPad(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, left bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	widthArg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if widthArg.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to pad string, width was null"),
+		}, 0, nil
+	}
+
+	pad := " "
+	if len(chunk.Function.Args) > 1 {
+		padArg, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		if padArg.Value != nil {
+			pad = padArg.Value.(string)
+		}
+	}
+	if pad == "" {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to pad string, padding cannot be empty"),
+		}, 0, nil
+	}
+
+	s := bind.Value.(string)
+	missing := int(widthArg.Value.(int64)) - len([]rune(s))
+	if missing <= 0 {
+		return StringData(s), 0, nil
+	}
+
+	padding := []rune(strings.Repeat(pad, missing/len([]rune(pad))+1))[:missing]
+	if left {
+		return StringData(string(padding) + s), 0, nil
+	}
+	return StringData(s + string(padding)), 0, nil
+}
+
+func stringPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringPad(e, bind, chunk, ref, true)
+}
+
+func stringPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringPad(e, bind, chunk, ref, false)
+}
+
+func _stringHash(bind *RawData, h hash.Hash) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	h.Write([]byte(bind.Value.(string)))
+	return StringData(hex.EncodeToString(h.Sum(nil))), 0, nil
+}
+
+func stringSha256V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringHash(bind, sha256.New())
+}
+
+func stringSha1V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringHash(bind, sha1.New())
+}
+
+func stringMd5V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _stringHash(bind, md5.New())
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
@@ -0,0 +1,53 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"errors"
+
+	"go.mondoo.com/cnquery/v11/types"
+	"go.mondoo.com/cnquery/v11/utils/versions/generic"
+)
+
+// defaultVersionScheme is used for versions that don't specify a scheme
+const defaultVersionScheme = "semver"
+
+func versionOp(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(int) bool) (*RawData, uint64, error) {
+	scheme := bind.Type.VersionScheme()
+	if scheme == "" {
+		scheme = defaultVersionScheme
+	}
+
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		cmp, err := generic.Compare(scheme, left.(string), right.(string))
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: errors.New("failed to compare versions: " + err.Error())}
+		}
+		return BoolData(f(cmp))
+	})
+}
+
+func versionCmpVersion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp == 0 })
+}
+
+func versionNotVersion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp != 0 })
+}
+
+func versionLTversion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp < 0 })
+}
+
+func versionGTversion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp > 0 })
+}
+
+func versionLTEversion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp <= 0 })
+}
+
+func versionGTEversion(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOp(e, bind, chunk, ref, func(cmp int) bool { return cmp >= 0 })
+}
@@ -40,6 +40,8 @@ func init() {
 		types.Empty:        empty2result,
 		types.Block:        block2result,
 		types.Semver:       string2result,
+		types.IP:           string2result,
+		types.VersionLike:  string2result,
 		types.ArrayLike:    array2result,
 		types.MapLike:      map2result,
 		types.ResourceLike: resource2result,
@@ -60,6 +62,8 @@ func init() {
 		types.Empty:        pempty2raw,
 		types.Block:        pblock2rawV2,
 		types.Semver:       pscore2raw,
+		types.IP:           pip2raw,
+		types.VersionLike:  pversion2raw,
 		types.ArrayLike:    parray2raw,
 		types.MapLike:      pmap2raw,
 		types.ResourceLike: presource2raw,
@@ -530,6 +534,14 @@ func pstring2raw(p *Primitive) *RawData {
 	return StringData(string(p.Value))
 }
 
+func pip2raw(p *Primitive) *RawData {
+	return IPData(string(p.Value))
+}
+
+func pversion2raw(p *Primitive) *RawData {
+	return &RawData{Type: types.Type(p.Type), Value: string(p.Value)}
+}
+
 func pregex2raw(p *Primitive) *RawData {
 	return RegexData(string(p.Value))
 }
@@ -73,6 +73,14 @@ func RegexPrimitive(r string) *Primitive {
 	}
 }
 
+// IPPrimitive creates a primitive from an IP address or CIDR in string shape
+func IPPrimitive(ip string) *Primitive {
+	return &Primitive{
+		Type:  string(types.IP),
+		Value: []byte(ip),
+	}
+}
+
 // TimePrimitive creates a primitive from a time value
 func TimePrimitive(t *time.Time) *Primitive {
 	if t == nil {
@@ -559,6 +567,9 @@ func (p *Primitive) LabelV2(code *CodeV2) string {
 	case types.Range:
 		return RangeData(p.Value).String()
 
+	case types.IP, types.VersionLike:
+		return string(p.Value)
+
 	default:
 		return ""
 	}
@@ -139,6 +139,8 @@ func rawDataString(typ types.Type, value interface{}) string {
 		return "\"" + value.(string) + "\""
 	case types.Regex:
 		return "/" + value.(string) + "/"
+	case types.IP, types.VersionLike:
+		return value.(string)
 	case types.Time:
 		return value.(*time.Time).String()
 	case types.Dict:
@@ -253,6 +255,9 @@ func isTruthy(data interface{}, typ types.Type) (bool, bool) {
 	case types.Regex:
 		return data.(string) != "", true
 
+	case types.IP, types.VersionLike:
+		return data.(string) != "", true
+
 	case types.Time:
 		dt := data.(*time.Time)
 
@@ -492,6 +497,14 @@ func RegexData(r string) *RawData {
 	}
 }
 
+// IPData creates a rawdata struct from an IP address or CIDR in string shape
+func IPData(ip string) *RawData {
+	return &RawData{
+		Type:  types.IP,
+		Value: ip,
+	}
+}
+
 // TimeData creates a rawdata struct from a go time
 func TimeData(t time.Time) *RawData {
 	return TimeDataPtr(&t)
@@ -19,6 +19,7 @@ type compileHandler struct {
 }
 
 var (
+	sameType        = func(t types.Type) types.Type { return t }
 	childType       = func(t types.Type) types.Type { return t.Child() }
 	arrayBlockType  = func(t types.Type) types.Type { return types.Array(types.Map(types.Int, types.Block)) }
 	boolType        = func(t types.Type) types.Type { return types.Bool }
@@ -41,16 +42,25 @@ func init() {
 			"inRange": {typ: boolType, compile: compileNumberInRange},
 		},
 		types.String: {
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"in":        {typ: boolType, compile: compileStringIn},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":   {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"in":         {typ: boolType, compile: compileStringIn},
+			"find":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {compile: compileStringReplace, typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Any, types.String}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"substr":     {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"padLeft":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"padRight":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"sha256":     {typ: stringType, signature: FunctionSignature{}},
+			"sha1":       {typ: stringType, signature: FunctionSignature{}},
+			"md5":        {typ: stringType, signature: FunctionSignature{}},
 		},
 		types.Time: {
 			"seconds": {typ: intType, signature: FunctionSignature{}},
@@ -60,20 +70,38 @@ func init() {
 			"unix":    {typ: intType, signature: FunctionSignature{}},
 			"inRange": {typ: boolType, compile: compileTimeInRange},
 		},
+		types.IP: {
+			"inRange":    {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
+			"overlaps":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
+			"isPrivate":  {typ: boolType, signature: FunctionSignature{}},
+			"isLoopback": {typ: boolType, signature: FunctionSignature{}},
+			"version":    {typ: intType, signature: FunctionSignature{}},
+			"prefix":     {typ: intType, signature: FunctionSignature{}},
+			"subnet":     {typ: sameType, signature: FunctionSignature{}},
+		},
 		types.Dict: {
 			"[]": {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}": {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// number-ish
 			"inRange": {typ: boolType, compile: compileNumberInRange},
 			// string-ish
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"find":       {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {compile: compileStringReplace, typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Any, types.String}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"substr":     {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"padLeft":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"padRight":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"sha256":     {typ: stringType, signature: FunctionSignature{}},
+			"sha1":       {typ: stringType, signature: FunctionSignature{}},
+			"md5":        {typ: stringType, signature: FunctionSignature{}},
 			// array- or map-ish
 			"first":        {typ: dictType, signature: FunctionSignature{}},
 			"last":         {typ: dictType, signature: FunctionSignature{}},
@@ -90,6 +118,16 @@ func init() {
 			"none":         {compile: compileDictNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileDictFlat, signature: FunctionSignature{}},
+			"sort":         {typ: dictType, signature: FunctionSignature{}},
+			"sortBy":       {compile: compileDictSortBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileDictGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"reverse":      {typ: dictType, signature: FunctionSignature{}},
+			"take":         {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"sum":          {typ: dictType, signature: FunctionSignature{}},
+			"min":          {typ: dictType, signature: FunctionSignature{}},
+			"max":          {typ: dictType, signature: FunctionSignature{}},
+			"avg":          {typ: dictType, signature: FunctionSignature{}},
 			// map-ish
 			"keys":   {typ: stringArrayType, signature: FunctionSignature{}},
 			"values": {typ: dictArrayType, signature: FunctionSignature{}},
@@ -114,6 +152,16 @@ func init() {
 			"none":         {compile: compileArrayNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{}},
+			"sortBy":       {compile: compileArraySortBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"reverse":      {typ: sameType, signature: FunctionSignature{}},
+			"take":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"sum":          {compile: compileArraySum, signature: FunctionSignature{}},
+			"min":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"avg":          {compile: compileArrayAvg, signature: FunctionSignature{}},
 		},
 		types.MapLike: {
 			"[]":       {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
@@ -142,6 +190,10 @@ func init() {
 		types.Resource("parse"): {
 			"date":     {compile: compileResourceParseDate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String}}},
 			"duration": {compile: compileResourceParseDuration, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"base64":   {compile: compileResourceParseBase64, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"hex":      {compile: compileResourceParseHex, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"jwt":      {compile: compileResourceParseJwt, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"urlQuery": {compile: compileResourceParseUrlQuery, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
 		},
 	}
 }
@@ -526,3 +526,130 @@ func compileArrayFlat(c *compiler, typ types.Type, ref uint64, id string, call *
 	})
 	return typ, nil
 }
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "', use 'sortBy' to sort by a field")
+	}
+
+	ct := typ.Child()
+	if _, ok := types.Less[ct]; !ok && ct != types.Dict {
+		return typ, errors.New("cannot sort array of " + ct.Label() + ", don't know how to compare entries. Try using 'sortBy'.")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
+// compileArrayFieldCall compiles functions that take one block argument,
+// which is evaluated for every entry of the list and then used by the
+// function to process the list (e.g. sortBy or groupBy)
+func compileArrayFieldCall(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call, resType types.Type) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("function '" + id + "' needs one argument (field or expression)")
+	}
+
+	arg := call.Function[0]
+	bindingName := "_"
+	if arg.Name != "" {
+		bindingName = arg.Name
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref, bindingName)
+	if err != nil {
+		return types.Nil, err
+	}
+	if refs.block == 0 {
+		return types.Nil, errors.New("called '" + id + "' without a function block")
+	}
+	ref = refs.binding
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return types.Nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(ref),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
+func compileArraySortBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayFieldCall(c, typ, ref, id, call, typ)
+}
+
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayFieldCall(c, typ, ref, id, call, types.Map(types.String, typ))
+}
+
+func compileArrayAggregate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call, resType types.Type) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "'")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+		},
+	})
+	return resType, nil
+}
+
+func isNumberLike(typ types.Type) bool {
+	return typ == types.Int || typ == types.Float || typ == types.Dict
+}
+
+func compileArraySum(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	ct := typ.Child()
+	if !isNumberLike(ct) {
+		return types.Nil, errors.New("cannot call '" + id + "' on an array of " + ct.Label() + ", only numbers are supported")
+	}
+	return compileArrayAggregate(c, typ, ref, id, call, ct)
+}
+
+func compileArrayAvg(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	ct := typ.Child()
+	if !isNumberLike(ct) {
+		return types.Nil, errors.New("cannot call '" + id + "' on an array of " + ct.Label() + ", only numbers are supported")
+	}
+	if ct == types.Int {
+		ct = types.Float
+	}
+	return compileArrayAggregate(c, typ, ref, id, call, ct)
+}
+
+func compileArrayMinMax(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	ct := typ.Child()
+	if _, ok := types.Less[ct]; !ok && ct != types.Dict {
+		return types.Nil, errors.New("cannot call '" + id + "' on an array of " + ct.Label() + ", don't know how to compare entries")
+	}
+	return compileArrayAggregate(c, typ, ref, id, call, ct)
+}
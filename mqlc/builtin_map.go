@@ -658,3 +658,11 @@ func compileMapNone(c *compiler, typ types.Type, ref uint64, id string, call *pa
 
 	return types.Bool, nil
 }
+
+func compileDictSortBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayFieldCall(c, typ, ref, id, call, types.Dict)
+}
+
+func compileDictGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayFieldCall(c, typ, ref, id, call, types.Dict)
+}
@@ -544,3 +544,59 @@ func compileResourceParseDuration(c *compiler, typ types.Type, ref uint64, id st
 	})
 	return types.Time, nil
 }
+
+// compileResourceParseValue compiles builtin parse functions that take one
+// string value and return the given type
+func compileResourceParseValue(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call, resType types.Type) (types.Type, error) {
+	if call == nil {
+		return types.Nil, errors.New("missing arguments to parse " + id)
+	}
+
+	functionID := string(typ) + "." + id
+
+	init := &resources.Init{
+		Args: []*resources.TypedArg{
+			{Name: "value", Type: string(types.String)},
+		},
+	}
+	args, err := c.unnamedArgs("parse."+id, init, call.Function)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	rawArgs := make([]*llx.Primitive, len(call.Function))
+	for i := range call.Function {
+		rawArgs[i] = args[i*2+1]
+	}
+
+	if len(rawArgs) == 0 {
+		return types.Nil, errors.New("missing arguments to parse " + id)
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   functionID,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+			Args:    rawArgs,
+		},
+	})
+	return resType, nil
+}
+
+func compileResourceParseBase64(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileResourceParseValue(c, typ, ref, id, call, types.String)
+}
+
+func compileResourceParseHex(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileResourceParseValue(c, typ, ref, id, call, types.String)
+}
+
+func compileResourceParseJwt(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileResourceParseValue(c, typ, ref, id, call, types.Dict)
+}
+
+func compileResourceParseUrlQuery(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileResourceParseValue(c, typ, ref, id, call, types.Dict)
+}
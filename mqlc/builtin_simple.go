@@ -137,6 +137,38 @@ func compileStringContains(c *compiler, typ types.Type, ref uint64, id string, c
 	}
 }
 
+func compileStringReplace(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 2 {
+		return types.Nil, errors.New("function " + id + " needs two arguments (search and replacement)")
+	}
+
+	search, err := callArgTypeIs(c, call, id, "search", 0, types.String, types.Regex)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	replacement, err := callArgTypeIs(c, call, id, "replacement", 1, types.String)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	searchType, err := c.dereferenceType(search)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id + string(searchType),
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: ref,
+			Args:    []*llx.Primitive{search, replacement},
+		},
+	})
+	return types.String, nil
+}
+
 func compileStringIn(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	if call == nil || len(call.Function) != 1 {
 		return types.Nil, errors.New("function " + id + " needs one argument")
@@ -40,6 +40,7 @@ func init() {
 		"expect": compileExpect,
 		"score":  compileScore,
 		"typeof": compileTypeof,
+		"format": compileFormat,
 		"switch": compileSwitch,
 		"Never":  compileNever,
 		"empty":  compileEmpty,
@@ -496,6 +497,45 @@ func compileTypeof(c *compiler, id string, call *parser.Call) (types.Type, error
 	return types.String, nil
 }
 
+func compileFormat(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errors.New("missing parameter for '" + id + "', it requires at least 1")
+	}
+
+	args := make([]*llx.Primitive, len(call.Function))
+	for i := range call.Function {
+		arg := call.Function[i]
+		if arg.Name != "" {
+			return types.Nil, errors.New("called '" + id + "' with a named argument, which is not supported")
+		}
+
+		argValue, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args[i] = argValue
+	}
+
+	formatType, err := c.dereferenceType(args[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if formatType != types.String && formatType != types.Dict {
+		return types.Nil, errors.New("called '" + id + "' with a format of type " + formatType.Label() + ", it must be a string")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type: string(types.String),
+			Args: args,
+		},
+	})
+
+	return types.String, nil
+}
+
 func compileSwitch(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	var ref *llx.Primitive
 
@@ -22,6 +22,10 @@ func init() {
 		"regex":  compileTypeConversion("$regex", types.Regex),
 		"dict":   compileTypeConversion("dict", types.Dict),
 		"semver": compileTypeConversion("semver", types.Semver),
+		// ip is typed, so that its functions can be called on the conversion
+		"ip": compileIPConversion,
+		// version is special, since it takes the versioning scheme as an optional argument
+		"version": compileVersionConversion,
 	}
 }
 
@@ -55,3 +59,55 @@ func compileTypeConversion(llxID string, typ types.Type) fieldCompiler {
 		return types.String, nil
 	}
 }
+
+func compileIPConversion(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if _, err := compileTypeConversion("ip", types.IP)(c, id, call); err != nil {
+		return types.Nil, err
+	}
+	return types.IP, nil
+}
+
+// compileVersionConversion compiles `version(value, scheme)`. If the scheme
+// is a static string it is recorded in the type, otherwise it is only known
+// once the scheme is resolved at runtime.
+func compileVersionConversion(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errNotConversion
+	}
+	if len(call.Function) > 2 {
+		return types.Nil, errors.New("too many arguments for '" + id + "' (expected a version and an optional scheme)")
+	}
+
+	args := make([]*llx.Primitive, len(call.Function))
+	for i := range call.Function {
+		arg := call.Function[i]
+		if arg == nil || arg.Value == nil || arg.Value.Operand == nil || arg.Value.Operand.Value == nil {
+			return types.Nil, errors.New("failed to get parameter for '" + id + "'")
+		}
+
+		argValue, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args[i] = argValue
+	}
+
+	var scheme string
+	if len(args) == 2 && types.Type(args[1].Type) == types.String {
+		scheme = string(args[1].Value)
+	} else if len(args) == 1 {
+		scheme = "semver"
+	}
+	typ := types.Version(scheme)
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   "version",
+		Function: &llx.Function{
+			Type: string(typ),
+			Args: args,
+		},
+	})
+
+	return typ, nil
+}
@@ -3,7 +3,12 @@
 
 package mvd
 
-import "go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+import (
+	"math"
+
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd/cvss"
+)
 
 //go:generate protoc --proto_path=../../../../:../:. --go_out=. --go_opt=paths=source_relative --rangerrpc_out=. mvd.proto
 
@@ -41,3 +46,89 @@ func NewMvdPlatform(pf *inventory.Platform) *Platform {
 		Labels:  pf.Labels,
 	}
 }
+
+// NewReportStats counts the advisories, CVEs, and affected packages of a report
+func NewReportStats(packages []*Package, advisories []*Advisory) *ReportStats {
+	stats := &ReportStats{
+		Advisories: &ReportStatsAdvisories{},
+		Cves:       &ReportStatsCves{},
+		Packages:   &ReportStatsPackages{Total: int32(len(packages))},
+		Exploits:   &ReportStatsExploits{},
+		Affected:   len(advisories) > 0,
+	}
+
+	scored := false
+	for _, advisory := range advisories {
+		if advisory.Score > stats.Score {
+			stats.Score = advisory.Score
+		}
+		scored = scored || !advisory.Unscored
+
+		stats.Advisories.Total++
+		switch severity(advisory.Score, advisory.Unscored) {
+		case cvss.Critical:
+			stats.Advisories.Critical++
+		case cvss.High:
+			stats.Advisories.High++
+		case cvss.Medium:
+			stats.Advisories.Medium++
+		case cvss.Low:
+			stats.Advisories.Low++
+		case cvss.None:
+			stats.Advisories.None++
+		default:
+			stats.Advisories.Unknown++
+		}
+	}
+	stats.Unscored = stats.Affected && !scored
+
+	cves := (&VulnReport{Advisories: advisories}).Cves()
+	for _, cve := range cves {
+		stats.Cves.Total++
+		switch severity(int32(math.Round(float64(cve.Score)*10)), cve.Unscored) {
+		case cvss.Critical:
+			stats.Cves.Critical++
+		case cvss.High:
+			stats.Cves.High++
+		case cvss.Medium:
+			stats.Cves.Medium++
+		case cvss.Low:
+			stats.Cves.Low++
+		case cvss.None:
+			stats.Cves.None++
+		default:
+			stats.Cves.Unknown++
+		}
+	}
+
+	for _, pkg := range packages {
+		if !pkg.Affected {
+			continue
+		}
+		stats.Packages.Affected++
+		switch severity(pkg.Score, pkg.Score == 0) {
+		case cvss.Critical:
+			stats.Packages.Critical++
+		case cvss.High:
+			stats.Packages.High++
+		case cvss.Medium:
+			stats.Packages.Medium++
+		case cvss.Low:
+			stats.Packages.Low++
+		case cvss.None:
+			stats.Packages.None++
+		default:
+			stats.Packages.Unknown++
+		}
+	}
+
+	return stats
+}
+
+// severity of a score that is multiplied by 10 as used in the report
+func severity(score int32, unscored bool) cvss.Severity {
+	if unscored {
+		return cvss.Unknown
+	}
+	return cvss.Rating(float32(score) / 10)
+}
@@ -51,6 +51,8 @@ var vaultMarshalNameMap = map[VaultType]string{
 	VaultType_AWSParameterStore:  "aws-parameter-store",
 	VaultType_GCPBerglas:         "gcp-berglas",
 	VaultType_Memory:             "memory",
+	VaultType_SOPS:               "sops",
+	VaultType_Environment:        "env",
 }
 
 func (t *VaultType) Value() string {
@@ -13,11 +13,14 @@ import (
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/awsparameterstore"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/awssecretsmanager"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/encryptedfile"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/envvault"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/gcpberglas"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/gcpsecretmanager"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/hashivault"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/inmemory"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/keyring"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/sops"
 )
 
 func New(vCfg *vault.VaultConfiguration) (vault.Vault, error) {
@@ -34,10 +37,13 @@ func New(vCfg *vault.VaultConfiguration) (vault.Vault, error) {
 		token := vCfg.Options["token"]
 		v = hashivault.New(serverUrl, token)
 	case vault.VaultType_EncryptedFile:
-		path := vCfg.Options["path"]
-		keyRingName := vCfg.Name
-		password := vCfg.Options["password"]
-		v = keyring.NewEncryptedFile(path, keyRingName, password)
+		opts := []encryptedfile.Option{}
+		if keyFile := vCfg.Options["key-file"]; keyFile != "" {
+			opts = append(opts, encryptedfile.WithKeyFile(keyFile))
+		} else {
+			opts = append(opts, encryptedfile.WithPassphrase(vCfg.Options["password"]))
+		}
+		v = encryptedfile.New(vCfg.Options["path"], vCfg.Name, opts...)
 	case vault.VaultType_KeyRing:
 		keyRingName := vCfg.Name
 		v = keyring.New(keyRingName)
@@ -72,6 +78,18 @@ func New(vCfg *vault.VaultConfiguration) (vault.Vault, error) {
 			opts = append(opts, gcpberglas.WithBucket(bucketName))
 		}
 		v = gcpberglas.New(projectID, opts...)
+	case vault.VaultType_SOPS:
+		opts := []sops.Option{}
+		if binary := vCfg.Options["binary"]; binary != "" {
+			opts = append(opts, sops.WithBinary(binary))
+		}
+		sopsVault, err := sops.New(vCfg.Options["path"], opts...)
+		if err != nil {
+			return nil, err
+		}
+		v = sopsVault
+	case vault.VaultType_Environment:
+		v = envvault.New(envvault.WithPrefix(vCfg.Options["prefix"]))
 
 	default:
 		return nil, errors.Errorf("could not connect to vault: %s (%s)", vCfg.Name, vCfg.Type.String())
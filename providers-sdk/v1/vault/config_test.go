@@ -25,13 +25,15 @@ func TestVaultTypeParser(t *testing.T) {
 - GCPBerglas
 - AWSParameterStore
 - Memory
+- sops
+- env
 `
 
 	v := []VaultType{}
 	err := yaml.Unmarshal([]byte(content), &v)
 	require.NoError(t, err)
 
-	assert.Equal(t, 11, len(v))
+	assert.Equal(t, 13, len(v))
 	assert.Equal(t, VaultType_None, v[0])
 	assert.Equal(t, VaultType_KeyRing, v[1])
 	assert.Equal(t, VaultType_LinuxKernelKeyring, v[2])
@@ -43,6 +45,8 @@ func TestVaultTypeParser(t *testing.T) {
 	assert.Equal(t, VaultType_GCPBerglas, v[8])
 	assert.Equal(t, VaultType_AWSParameterStore, v[9])
 	assert.Equal(t, VaultType_Memory, v[10])
+	assert.Equal(t, VaultType_SOPS, v[11])
+	assert.Equal(t, VaultType_Environment, v[12])
 }
 
 func TestVaultTypeMarshal(t *testing.T) {
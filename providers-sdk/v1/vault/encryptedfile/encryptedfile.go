@@ -0,0 +1,447 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package encryptedfile implements a vault that keeps all secrets in a single
+// local file. The file is encrypted with XChaCha20-Poly1305, either with a key
+// derived from a passphrase via scrypt or with a random key stored in a
+// separate key file. It does not need any keyring daemon and therefore works
+// the same on workstations and headless CI runners.
+//
+// Vault directories written by the previous keyring based implementation are
+// still read with the vault password and are migrated to the new file with the
+// next change to the vault.
+//
+// age is not supported: it is not a dependency of cnquery, and its passphrase
+// mode uses the same scrypt and ChaCha20-Poly1305 primitives as this format.
+// age identities can be added as another kdf without changing the envelope.
+package encryptedfile
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	fileVersion = 1
+
+	kdfScrypt  = "scrypt"
+	kdfKeyFile = "key-file"
+
+	// scrypt parameters for newly written files, see
+	// https://pkg.go.dev/golang.org/x/crypto/scrypt#Key
+	scryptN       = 1 << 15
+	scryptR       = 8
+	scryptP       = 1
+	scryptSaltLen = 16
+)
+
+type Option func(*Vault)
+
+// WithPassphrase encrypts the vault with a key derived from the passphrase
+func WithPassphrase(passphrase string) Option {
+	return func(v *Vault) {
+		v.passphrase = passphrase
+		v.keyFile = ""
+	}
+}
+
+// WithKeyFile encrypts the vault with the key stored in the given file,
+// see GenerateKeyFile
+func WithKeyFile(path string) Option {
+	return func(v *Vault) {
+		v.keyFile = path
+		v.passphrase = ""
+	}
+}
+
+// New creates a vault that is stored in the file at path. If path points to
+// a directory, the secrets are stored in <name>.vault inside of it.
+func New(path string, name string, opts ...Option) *Vault {
+	v := &Vault{
+		path: path,
+		name: name,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+type Vault struct {
+	path       string
+	name       string
+	passphrase string
+	keyFile    string
+	lock       sync.Mutex
+}
+
+// envelope is the on-disk format of the vault file
+type envelope struct {
+	Version int    `json:"version"`
+	Kdf     string `json:"kdf"`
+	Salt    []byte `json:"salt,omitempty"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// additionalData binds the key derivation settings to the ciphertext, so
+// they cannot be swapped without failing the decryption
+func (e *envelope) additionalData() []byte {
+	header := *e
+	header.Nonce = nil
+	header.Data = nil
+	data, _ := json.Marshal(header)
+	return data
+}
+
+// storedSecret keeps the encoding as plain number, since the JSON
+// representation of vault.SecretEncoding does not round-trip
+type storedSecret struct {
+	Label    string `json:"label,omitempty"`
+	Data     []byte `json:"data"`
+	Encoding int32  `json:"encoding,omitempty"`
+}
+
+func (v *Vault) About(context.Context, *vault.Empty) (*vault.VaultInfo, error) {
+	return &vault.VaultInfo{Name: "Encrypted File Vault: " + v.name}, nil
+}
+
+func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	if id == nil {
+		return nil, errors.New("secret id is empty")
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	secrets, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := secrets[id.Key]
+	if !ok {
+		return nil, vault.NotFoundError
+	}
+	return &vault.Secret{
+		Key:      id.Key,
+		Label:    s.Label,
+		Data:     s.Data,
+		Encoding: secretEncoding(vault.SecretEncoding(s.Encoding)),
+	}, nil
+}
+
+// secretEncoding returns json for secrets without encoding, e.g. the ones
+// stored by 'cnquery vault add-secret'. The keyring vault that this vault
+// replaces stored all secrets as json.
+func secretEncoding(encoding vault.SecretEncoding) vault.SecretEncoding {
+	if encoding == vault.SecretEncoding_encoding_undefined {
+		return vault.SecretEncoding_encoding_json
+	}
+	return encoding
+}
+
+func (v *Vault) Set(ctx context.Context, secret *vault.Secret) (*vault.SecretID, error) {
+	if secret == nil {
+		return nil, errors.New("secret is empty")
+	}
+	if secret.Key == "" {
+		return nil, errors.New("secret key is empty")
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	secrets, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets[secret.Key] = storedSecret{
+		Label:    secret.Label,
+		Data:     secret.Data,
+		Encoding: int32(secretEncoding(secret.Encoding)),
+	}
+	if err := v.store(secrets); err != nil {
+		return nil, err
+	}
+	return &vault.SecretID{Key: secret.Key}, nil
+}
+
+// List returns the ids of all secrets in the vault, sorted by key
+func (v *Vault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	secrets, err := v.load()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secrets))
+	for k := range secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+// Rotate re-encrypts all secrets with the new passphrase or key file. The
+// vault uses the new settings for all subsequent operations.
+func (v *Vault) Rotate(ctx context.Context, opts ...Option) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	secrets, err := v.load()
+	if err != nil {
+		return err
+	}
+
+	passphrase, keyFile := v.passphrase, v.keyFile
+	for _, opt := range opts {
+		opt(v)
+	}
+	if err := v.store(secrets); err != nil {
+		v.passphrase, v.keyFile = passphrase, keyFile
+		return err
+	}
+	return nil
+}
+
+func (v *Vault) filePath() (string, error) {
+	if v.path == "" {
+		return "", errors.New("no path configured for encrypted file vault")
+	}
+	path, err := homedir.Expand(v.path)
+	if err != nil {
+		return "", err
+	}
+
+	if stat, err := os.Stat(path); err == nil && stat.IsDir() {
+		name := v.name
+		if name == "" {
+			name = "mondoo"
+		}
+		path = filepath.Join(path, name+".vault")
+	}
+	return path, nil
+}
+
+// load reads and decrypts all secrets. A vault file that does not exist yet
+// is treated as an empty vault.
+func (v *Vault) load() (map[string]storedSecret, error) {
+	path, err := v.filePath()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return v.loadMissing(path)
+	}
+	if err != nil {
+		return nil, errors.New("failed to read vault file: " + err.Error())
+	}
+
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, errors.New("vault file is corrupt: " + err.Error())
+	}
+	if env.Version != fileVersion {
+		return nil, errors.New("unsupported vault file version")
+	}
+
+	key, err := v.key(&env)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, errors.New("vault file is corrupt: invalid nonce")
+	}
+
+	plaintext, err := aead.Open(nil, env.Nonce, env.Data, env.additionalData())
+	if err != nil {
+		return nil, errors.New("failed to decrypt vault file, the passphrase or key file may be wrong")
+	}
+
+	secrets := map[string]storedSecret{}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, errors.New("vault file is corrupt: " + err.Error())
+	}
+	return secrets, nil
+}
+
+// loadMissing is called when the vault file does not exist yet. If the vault
+// directory still holds secrets of the previous format, they are read instead.
+func (v *Vault) loadMissing(path string) (map[string]storedSecret, error) {
+	// the previous format used the configured path as directory
+	dir := filepath.Dir(path)
+	if configured, err := homedir.Expand(v.path); err != nil || filepath.Clean(configured) != dir {
+		return map[string]storedSecret{}, nil
+	}
+
+	files, err := legacyFiles(dir)
+	if err != nil {
+		return nil, errors.New("failed to read vault directory: " + err.Error())
+	}
+	if len(files) == 0 {
+		return map[string]storedSecret{}, nil
+	}
+	log.Info().Str("path", dir).Msg("read vault secrets of the previous encrypted-file format, they are migrated with the next change to the vault")
+	return v.loadLegacy(dir, files)
+}
+
+// store encrypts all secrets and replaces the vault file. The new file is
+// renamed into place, so readers never see a partially written vault.
+func (v *Vault) store(secrets map[string]storedSecret) error {
+	path, err := v.filePath()
+	if err != nil {
+		return err
+	}
+
+	env := envelope{Version: fileVersion}
+	if v.keyFile != "" {
+		env.Kdf = kdfKeyFile
+	} else {
+		env.Kdf = kdfScrypt
+		env.N, env.R, env.P = scryptN, scryptR, scryptP
+		env.Salt = make([]byte, scryptSaltLen)
+		if _, err := rand.Read(env.Salt); err != nil {
+			return err
+		}
+	}
+
+	key, err := v.key(&env)
+	if err != nil {
+		return err
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	env.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return err
+	}
+	env.Data = aead.Seal(nil, env.Nonce, plaintext, env.additionalData())
+
+	raw, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.New("failed to create vault directory: " + err.Error())
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.New("failed to write vault file: " + err.Error())
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return errors.New("failed to write vault file: " + err.Error())
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.New("failed to write vault file: " + err.Error())
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.New("failed to write vault file: " + err.Error())
+	}
+	return nil
+}
+
+// key returns the encryption key for the given envelope
+func (v *Vault) key(env *envelope) ([]byte, error) {
+	switch env.Kdf {
+	case kdfScrypt:
+		if v.keyFile != "" {
+			return nil, errors.New("vault file is encrypted with a passphrase, but a key file was provided")
+		}
+		if v.passphrase == "" {
+			return nil, errors.New("no passphrase provided for encrypted file vault")
+		}
+		return scrypt.Key([]byte(v.passphrase), env.Salt, env.N, env.R, env.P, chacha20poly1305.KeySize)
+	case kdfKeyFile:
+		if v.keyFile == "" {
+			return nil, errors.New("vault file is encrypted with a key file, but no key file was provided")
+		}
+		return readKeyFile(v.keyFile)
+	default:
+		return nil, errors.New("unsupported vault file encryption '" + env.Kdf + "'")
+	}
+}
+
+// GenerateKeyFile writes a new random key to the given path. The file must
+// not exist yet, so existing keys are never overwritten by accident.
+func GenerateKeyFile(path string) error {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(base64.StdEncoding.EncodeToString(key) + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.New("failed to read vault key file: " + err.Error())
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil || len(key) != chacha20poly1305.KeySize {
+		return nil, errors.New("invalid vault key file '" + path + "'")
+	}
+	return key, nil
+}
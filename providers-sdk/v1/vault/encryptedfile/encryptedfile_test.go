@@ -0,0 +1,150 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package encryptedfile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault/keyring"
+)
+
+func TestPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.vault")
+	v := New(path, "mondoo", WithPassphrase("superpassword"))
+	ctx := context.Background()
+
+	credSecret := map[string]string{
+		"key":  "value",
+		"key2": "value2",
+	}
+	credBytes, err := json.Marshal(credSecret)
+	require.NoError(t, err)
+
+	key := "mondoo-test-secret-key"
+	cred := &vault.Secret{
+		Key:      key,
+		Label:    "mondoo: " + key,
+		Data:     credBytes,
+		Encoding: vault.SecretEncoding_encoding_json,
+	}
+
+	id, err := v.Set(ctx, cred)
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "value2")
+
+	// create a new instance to test file reading
+	v2 := New(path, "mondoo", WithPassphrase("superpassword"))
+	newCred, err := v2.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, key, newCred.Key)
+	assert.Equal(t, cred.Label, newCred.Label)
+	assert.Equal(t, cred.Encoding, newCred.Encoding)
+	assert.EqualValues(t, cred.Data, newCred.Data)
+
+	_, err = v2.Get(ctx, &vault.SecretID{Key: "missing"})
+	assert.Equal(t, vault.NotFoundError, err)
+
+	_, err = New(path, "mondoo", WithPassphrase("wrong")).Get(ctx, id)
+	assert.Error(t, err)
+}
+
+func TestSecretWithoutEncoding(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.vault")
+	v := New(path, "mondoo", WithPassphrase("superpassword"))
+	ctx := context.Background()
+
+	// cnquery vault add-secret stores the value without encoding
+	id, err := v.Set(ctx, &vault.Secret{
+		Key:  "ssh-admin",
+		Data: []byte(`{"user": "admin", "password": "secret"}`),
+	})
+	require.NoError(t, err)
+
+	secret, err := New(path, "mondoo", WithPassphrase("superpassword")).Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, vault.SecretEncoding_encoding_json, secret.Encoding)
+
+	cred, err := secret.Credential()
+	require.NoError(t, err)
+	assert.Equal(t, "admin", cred.User)
+	assert.Equal(t, []byte("secret"), cred.Secret)
+}
+
+func TestKeyFileAndRotation(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "vault.key")
+	require.NoError(t, GenerateKeyFile(keyFile))
+	assert.Error(t, GenerateKeyFile(keyFile), "must not overwrite existing keys")
+
+	// directories get a vault file named after the vault
+	v := New(dir, "ci", WithKeyFile(keyFile))
+	ctx := context.Background()
+
+	_, err := v.Set(ctx, &vault.Secret{Key: "b", Data: []byte("secret-b")})
+	require.NoError(t, err)
+	_, err = v.Set(ctx, &vault.Secret{Key: "a", Data: []byte("secret-a")})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "ci.vault"))
+
+	ids, err := v.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	assert.Equal(t, "a", ids[0].Key)
+	assert.Equal(t, "b", ids[1].Key)
+
+	require.NoError(t, v.Rotate(ctx, WithPassphrase("rotated")))
+
+	_, err = New(dir, "ci", WithKeyFile(keyFile)).Get(ctx, &vault.SecretID{Key: "a"})
+	assert.Error(t, err)
+
+	s, err := New(dir, "ci", WithPassphrase("rotated")).Get(ctx, &vault.SecretID{Key: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret-a"), s.Data)
+}
+
+func TestLegacyKeyringFiles(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	old := keyring.NewEncryptedFile(dir, "mondoo", "superpassword")
+	_, err := old.Set(ctx, &vault.Secret{Key: "ssh/host", Label: "mondoo: ssh/host", Data: []byte(`{"user":"admin"}`)})
+	require.NoError(t, err)
+	_, err = old.Set(ctx, &vault.Secret{Key: "api", Data: []byte(`{"token":"abc"}`)})
+	require.NoError(t, err)
+
+	_, err = New(dir, "mondoo", WithKeyFile(filepath.Join(dir, "missing.key"))).Get(ctx, &vault.SecretID{Key: "api"})
+	assert.ErrorContains(t, err, "cnquery vault rotate-key")
+
+	_, err = New(dir, "mondoo", WithPassphrase("wrong")).Get(ctx, &vault.SecretID{Key: "api"})
+	assert.ErrorContains(t, err, "previous encrypted-file format")
+
+	v := New(dir, "mondoo", WithPassphrase("superpassword"))
+	s, err := v.Get(ctx, &vault.SecretID{Key: "ssh/host"})
+	require.NoError(t, err)
+	assert.Equal(t, "mondoo: ssh/host", s.Label)
+	assert.Equal(t, []byte(`{"user":"admin"}`), s.Data)
+	assert.Equal(t, vault.SecretEncoding_encoding_json, s.Encoding)
+
+	// the next change writes all secrets to the new vault file
+	_, err = v.Set(ctx, &vault.Secret{Key: "new", Data: []byte("secret")})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "mondoo.vault"))
+
+	ids, err := New(dir, "mondoo", WithPassphrase("superpassword")).List(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+	assert.Equal(t, "api", ids[0].Key)
+	assert.Equal(t, "new", ids[1].Key)
+	assert.Equal(t, "ssh/host", ids[2].Key)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package encryptedfile
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/keyring"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+)
+
+// legacyAlgorithm is the JWE key algorithm of the keyring file backend,
+// which stored the encrypted-file vault before this package existed
+const legacyAlgorithm = "PBES2-HS256+A128KW"
+
+// legacyMigrationSteps explains how to move secrets of the previous format
+// to a key file
+const legacyMigrationSteps = "the vault directory contains secrets in the previous encrypted-file format, which can only be read with the vault password. " +
+	"Configure the vault with its password and run 'cnquery vault rotate-key --new-key-file <file>' to migrate them, then switch the inventory to the key file"
+
+// legacyFiles returns the files in dir that were written by the keyring
+// file backend. It stored every secret in its own JWE compact token, named
+// after the percent-encoded key of the secret.
+func legacyFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if isLegacyToken(raw) {
+			res = append(res, path)
+		}
+	}
+	return res, nil
+}
+
+func isLegacyToken(raw []byte) bool {
+	parts := strings.Split(strings.TrimSpace(string(raw)), ".")
+	if len(parts) != 5 {
+		return false
+	}
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil {
+		return false
+	}
+	return h.Alg == legacyAlgorithm
+}
+
+// loadLegacy reads the secrets of a vault directory in the previous format.
+// They are written to the new vault file with the next change to the vault,
+// the old files are left in place.
+func (v *Vault) loadLegacy(dir string, files []string) (map[string]storedSecret, error) {
+	if v.keyFile != "" {
+		return nil, errors.New(legacyMigrationSteps)
+	}
+
+	ring, err := keyring.Open(keyring.Config{
+		ServiceName:     v.name,
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		FileDir:         dir,
+		FilePasswordFunc: func(string) (string, error) {
+			return v.passphrase, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]storedSecret, len(files))
+	for _, path := range files {
+		key, err := url.PathUnescape(filepath.Base(path))
+		if err != nil {
+			return nil, errors.New("invalid secret file name in vault directory: " + path)
+		}
+		item, err := ring.Get(key)
+		if err != nil {
+			return nil, errors.New("failed to decrypt vault secret '" + key + "' of the previous encrypted-file format, the password may be wrong")
+		}
+		// the keyring vault always returned secrets as json
+		secrets[item.Key] = storedSecret{
+			Label:    item.Label,
+			Data:     item.Data,
+			Encoding: int32(vault.SecretEncoding_encoding_json),
+		}
+	}
+	return secrets, nil
+}
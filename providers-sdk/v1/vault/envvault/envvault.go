@@ -0,0 +1,116 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package envvault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+)
+
+type Option func(*Vault)
+
+// WithPrefix only exposes environment variables that start with the prefix.
+// The prefix is not part of the secret id.
+func WithPrefix(prefix string) Option {
+	return func(v *Vault) {
+		v.prefix = prefix
+	}
+}
+
+// New creates a read-only vault that maps secret ids to environment
+// variables. A secret id is looked up as is first. If no such variable
+// exists, it is upper-cased and all characters that are not allowed in
+// variable names are replaced by underscores, e.g. `ssh-key` becomes `SSH_KEY`.
+func New(opts ...Option) *Vault {
+	v := &Vault{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+type Vault struct {
+	prefix string
+}
+
+func (v *Vault) About(context.Context, *vault.Empty) (*vault.VaultInfo, error) {
+	return &vault.VaultInfo{Name: "Environment Variable Vault"}, nil
+}
+
+func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	if id == nil || id.Key == "" {
+		return nil, errors.New("secret id is empty")
+	}
+
+	val, ok := os.LookupEnv(v.prefix + id.Key)
+	if !ok {
+		val, ok = os.LookupEnv(v.prefix + VariableName(id.Key))
+	}
+	if !ok {
+		return nil, vault.NotFoundError
+	}
+
+	return &vault.Secret{
+		Key:      id.Key,
+		Data:     []byte(val),
+		Encoding: secretEncoding(val),
+	}, nil
+}
+
+func (v *Vault) Set(ctx context.Context, secret *vault.Secret) (*vault.SecretID, error) {
+	return nil, errors.New("the environment variable vault is read-only")
+}
+
+// List returns all environment variables with the configured prefix. Without
+// a prefix, listing would expose the entire environment, so it is refused.
+func (v *Vault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	if v.prefix == "" {
+		return nil, errors.New("secrets can only be listed if the vault has a prefix")
+	}
+
+	keys := []string{}
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, v.prefix) && len(name) > len(v.prefix) {
+			keys = append(keys, strings.TrimPrefix(name, v.prefix))
+		}
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+// VariableName turns a secret id into a valid environment variable name
+func VariableName(key string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(key) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+// secretEncoding detects values that hold a full credential as JSON object,
+// e.g. {"type": "password", "user": "admin", "password": "..."}. All other
+// values are treated as the raw secret.
+func secretEncoding(val string) vault.SecretEncoding {
+	trimmed := strings.TrimSpace(val)
+	if strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)) {
+		return vault.SecretEncoding_encoding_json
+	}
+	return vault.SecretEncoding_encoding_binary
+}
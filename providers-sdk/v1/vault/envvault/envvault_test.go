@@ -0,0 +1,47 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package envvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+)
+
+func TestVault(t *testing.T) {
+	t.Setenv("MONDOO_TEST_SSH_PASSWORD", "supersecret")
+	t.Setenv("MONDOO_TEST_admin", `{"type": "password", "user": "admin", "password": "pass"}`)
+
+	v := New(WithPrefix("MONDOO_TEST_"))
+	ctx := context.Background()
+
+	s, err := v.Get(ctx, &vault.SecretID{Key: "ssh-password"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("supersecret"), s.Data)
+	assert.Equal(t, vault.SecretEncoding_encoding_binary, s.Encoding)
+
+	s, err = v.Get(ctx, &vault.SecretID{Key: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, vault.SecretEncoding_encoding_json, s.Encoding)
+	cred, err := s.Credential()
+	require.NoError(t, err)
+	assert.Equal(t, vault.CredentialType_password, cred.Type)
+	assert.Equal(t, "admin", cred.User)
+	assert.Equal(t, []byte("pass"), cred.Secret)
+
+	_, err = v.Get(ctx, &vault.SecretID{Key: "missing"})
+	assert.Equal(t, vault.NotFoundError, err)
+
+	ids, err := v.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	assert.Equal(t, "SSH_PASSWORD", ids[0].Key)
+	assert.Equal(t, "admin", ids[1].Key)
+
+	_, err = New().List(ctx)
+	assert.Error(t, err)
+}
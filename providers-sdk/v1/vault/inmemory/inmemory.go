@@ -6,6 +6,7 @@ package inmemory
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
 )
@@ -60,3 +61,17 @@ func (v *inmemoryVault) Get(ctx context.Context, id *vault.SecretID) (*vault.Sec
 	}
 	return s, nil
 }
+
+func (v *inmemoryVault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	keys := make([]string, 0, len(v.secrets))
+	for k := range v.secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
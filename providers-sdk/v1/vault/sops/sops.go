@@ -0,0 +1,202 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/vault"
+)
+
+// keySeparator separates the keys of nested values in a secret id,
+// e.g. `ssh/production` refers to the key `production` below `ssh`
+const keySeparator = "/"
+
+type Option func(*Vault)
+
+// WithBinary sets the sops executable, by default it is looked up in PATH
+func WithBinary(binary string) Option {
+	return func(v *Vault) {
+		v.binary = binary
+	}
+}
+
+// New creates a read-only vault for a SOPS encrypted YAML or JSON file,
+// see https://github.com/getsops/sops
+//
+// The file is decrypted with the sops binary, so all key types that sops
+// supports work, e.g. age keys via SOPS_AGE_KEY_FILE or cloud KMS keys.
+// The binary must be installed on the system that runs the scan, it is
+// looked up here so that a missing binary fails the vault configuration
+// instead of the first credential lookup. The file is only decrypted once
+// and kept in memory afterwards.
+func New(path string, opts ...Option) (*Vault, error) {
+	v := newVault(path, opts...)
+	binary, err := exec.LookPath(v.binary)
+	if err != nil {
+		return nil, errors.New("cannot find sops binary '" + v.binary + "', install sops (https://github.com/getsops/sops) or set the vault option 'binary' to its path")
+	}
+	v.binary = binary
+	return v, nil
+}
+
+func newVault(path string, opts ...Option) *Vault {
+	v := &Vault{
+		path:   path,
+		binary: "sops",
+	}
+	v.decrypt = v.decryptWithBinary
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+type Vault struct {
+	path    string
+	binary  string
+	decrypt func(ctx context.Context, path string) ([]byte, error)
+
+	lock    sync.Mutex
+	secrets map[string]interface{}
+}
+
+func (v *Vault) About(context.Context, *vault.Empty) (*vault.VaultInfo, error) {
+	return &vault.VaultInfo{Name: "SOPS Vault: " + v.path}, nil
+}
+
+func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, error) {
+	if id == nil || id.Key == "" {
+		return nil, errors.New("secret id is empty")
+	}
+
+	secrets, err := v.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	val, ok := lookup(secrets, id.Key)
+	if !ok || val == nil {
+		return nil, vault.NotFoundError
+	}
+
+	switch x := val.(type) {
+	case string:
+		return &vault.Secret{Key: id.Key, Data: []byte(x), Encoding: vault.SecretEncoding_encoding_binary}, nil
+	case map[string]interface{}:
+		// objects hold an entire credential, e.g. type, user and password
+		data, err := json.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		return &vault.Secret{Key: id.Key, Data: data, Encoding: vault.SecretEncoding_encoding_json}, nil
+	case []interface{}:
+		return nil, errors.New("secret '" + id.Key + "' is a list and cannot be used as credential")
+	default:
+		return &vault.Secret{Key: id.Key, Data: []byte(fmt.Sprintf("%v", x)), Encoding: vault.SecretEncoding_encoding_binary}, nil
+	}
+}
+
+func (v *Vault) Set(ctx context.Context, secret *vault.Secret) (*vault.SecretID, error) {
+	return nil, errors.New("the sops vault is read-only, use sops to edit " + v.path)
+}
+
+// List returns the ids of all top-level secrets, sorted by key
+func (v *Vault) List(ctx context.Context) ([]*vault.SecretID, error) {
+	secrets, err := v.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secrets))
+	for k := range secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+func (v *Vault) load(ctx context.Context) (map[string]interface{}, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.secrets != nil {
+		return v.secrets, nil
+	}
+	if v.path == "" {
+		return nil, errors.New("no path configured for sops vault")
+	}
+
+	path, err := homedir.Expand(v.path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := v.decrypt(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]interface{}{}
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return nil, errors.New("failed to parse decrypted sops file: " + err.Error())
+	}
+	// sops metadata is removed on decryption, but make sure it never shows up as secret
+	delete(secrets, "sops")
+
+	v.secrets = secrets
+	return secrets, nil
+}
+
+func (v *Vault) decryptWithBinary(ctx context.Context, path string) ([]byte, error) {
+	log.Debug().Str("path", path).Msg("decrypt sops file")
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, v.binary, "--decrypt", "--output-type", "json", path)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, errors.New("failed to decrypt sops file " + path + ": " + msg)
+	}
+	return stdout.Bytes(), nil
+}
+
+// lookup finds the value for the secret id. Keys that exist at the top level
+// win over nested lookups.
+func lookup(secrets map[string]interface{}, key string) (interface{}, bool) {
+	if val, ok := secrets[key]; ok {
+		return val, true
+	}
+
+	var cur interface{} = secrets
+	for _, part := range strings.Split(key, keySeparator) {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
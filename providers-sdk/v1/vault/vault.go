@@ -4,6 +4,7 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 
@@ -17,6 +18,11 @@ type Resolver interface {
 	GetCredential(cred *Credential) (*Credential, error)
 }
 
+// Lister is implemented by vaults that can enumerate the secrets they store
+type Lister interface {
+	List(ctx context.Context) ([]*SecretID, error)
+}
+
 //go:generate protoc --proto_path=../../../:. --go_out=. --go_opt=paths=source_relative --rangerrpc_out=. vault.proto
 
 func EscapeSecretID(key string) string {
@@ -193,21 +193,27 @@ const (
 	VaultType_GCPBerglas VaultType = 8
 	// protolint:disable:next ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
 	VaultType_Memory VaultType = 9
+	// protolint:disable:next ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
+	VaultType_SOPS VaultType = 10
+	// protolint:disable:next ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
+	VaultType_Environment VaultType = 11
 )
 
 // Enum value maps for VaultType.
 var (
 	VaultType_name = map[int32]string{
-		0: "None",
-		1: "KeyRing",
-		2: "LinuxKernelKeyring",
-		3: "EncryptedFile",
-		4: "HashiCorp",
-		5: "GCPSecretsManager",
-		6: "AWSSecretsManager",
-		7: "AWSParameterStore",
-		8: "GCPBerglas",
-		9: "Memory",
+		0:  "None",
+		1:  "KeyRing",
+		2:  "LinuxKernelKeyring",
+		3:  "EncryptedFile",
+		4:  "HashiCorp",
+		5:  "GCPSecretsManager",
+		6:  "AWSSecretsManager",
+		7:  "AWSParameterStore",
+		8:  "GCPBerglas",
+		9:  "Memory",
+		10: "SOPS",
+		11: "Environment",
 	}
 	VaultType_value = map[string]int32{
 		"None":               0,
@@ -220,6 +226,8 @@ var (
 		"AWSParameterStore":  7,
 		"GCPBerglas":         8,
 		"Memory":             9,
+		"SOPS":               10,
+		"Environment":        11,
 	}
 )
 
@@ -697,7 +705,7 @@ var file_vault_proto_rawDesc = []byte{
 	0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f,
 	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
 	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x10,
-	0x03, 0x2a, 0xbd, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x03, 0x2a, 0xd8, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
 	0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x65, 0x79,
 	0x52, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x4b,
 	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x11,
@@ -709,24 +717,26 @@ var file_vault_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x41, 0x57, 0x53, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x74,
 	0x6f, 0x72, 0x65, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x43, 0x50, 0x42, 0x65, 0x72, 0x67,
 	0x6c, 0x61, 0x73, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10,
-	0x09, 0x32, 0xd8, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x41,
-	0x62, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x43, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x63, 0x6e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x1c,
-	0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x09, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x50, 0x53, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x0b, 0x32, 0xd8, 0x01, 0x0a,
+	0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12,
+	0x1b, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63,
 	0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x42, 0x32, 0x5a, 0x30,
-	0x67, 0x6f, 0x2e, 0x6d, 0x6f, 0x6e, 0x64, 0x6f, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a,
+	0x03, 0x47, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x43, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x6e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x6f, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x64, 0x6f, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -468,6 +468,46 @@ func TestString_Methods(t *testing.T) {
 			Code:        "'hello ' + 'world'",
 			Expectation: "hello world",
 		},
+		{
+			Code:        "'a-b-c'.replace('-', '_')",
+			Expectation: "a_b_c",
+		},
+		{
+			Code:        "'key = value'.replace(/\\s*=\\s*/, '=')",
+			Expectation: "key=value",
+		},
+		{
+			Code:        "'/etc/ssh'.startsWith('/etc')",
+			Expectation: true,
+		},
+		{
+			Code:        "'/etc/ssh'.endsWith('.conf')",
+			Expectation: false,
+		},
+		{
+			Code:        "'hello world'.substr(6)",
+			Expectation: "world",
+		},
+		{
+			Code:        "'hello world'.substr(0, 4)",
+			Expectation: "hell",
+		},
+		{
+			Code:        "'hello world'.substr(-3, 2)",
+			Expectation: "rl",
+		},
+		{
+			Code:        "'7'.padLeft(3, '0')",
+			Expectation: "007",
+		},
+		{
+			Code:        "'ab'.padRight(4)",
+			Expectation: "ab  ",
+		},
+		{
+			Code:        "format('%s runs on port %d', 'sshd', 22)",
+			Expectation: "sshd runs on port 22",
+		},
 	})
 }
 
@@ -705,6 +745,57 @@ func TestArray(t *testing.T) {
 			Code:        "[3,1,3,4,2] - [3,4,5]",
 			Expectation: []interface{}{int64(1), int64(2)},
 		},
+		{
+			Code:        "[3,1,2].sort",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "['b','c','a'].sort.reverse",
+			Expectation: []interface{}{"c", "b", "a"},
+		},
+		{
+			Code:        "['aaa','b','cc'].sortBy(_.length)",
+			Expectation: []interface{}{"b", "cc", "aaa"},
+		},
+		{
+			Code:        "[1,2,3,4].take(2)",
+			Expectation: []interface{}{int64(1), int64(2)},
+		},
+		{
+			Code:        "[1,2,3,4].skip(3)",
+			Expectation: []interface{}{int64(4)},
+		},
+		{
+			Code:        "[1,2,3].take(5)",
+			Expectation: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			Code:        "[1,2,3].sum",
+			Expectation: int64(6),
+		},
+		{
+			Code:        "[1.5,2.5].sum",
+			Expectation: float64(4),
+		},
+		{
+			Code:        "[1,2,3,4].avg",
+			Expectation: float64(2.5),
+		},
+		{
+			Code:        "[3,1,2].min",
+			Expectation: int64(1),
+		},
+		{
+			Code:        "['b','c','a'].max",
+			Expectation: "c",
+		},
+		{
+			Code: "['a','bb','cc'].groupBy(_.length)",
+			Expectation: map[string]interface{}{
+				"1": []interface{}{"a"},
+				"2": []interface{}{"bb", "cc"},
+			},
+		},
 	})
 }
 
@@ -829,6 +920,128 @@ func TestSemver(t *testing.T) {
 	})
 }
 
+func TestVersion(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "version('1.2.3') == version('1.2.3')",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1.10.0') > '1.9.1'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1:1.1.1k-1', 'deb') >= '1.1.1z-9'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1.1.1k-1', 'deb') < '1.1.1k-1+deb11u1'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1.0~rc1', 'deb') < '1.0'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1:3.0.7-16.el9', 'rpm') > '3.0.7-18.el9'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "version('1.2.2-r7', 'apk') < '1.2.2-r10'",
+			ResultIndex: 2, Expectation: true,
+		},
+	})
+}
+
+func TestIP(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "ip('10.1.2.3') == ip('10.1.2.3')",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "ip('::ffff:10.1.2.3') == '10.1.2.3'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "ip('10.1.2.3') != ip('10.1.2.4')",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "ip('10.1.2.3').inRange('10.0.0.0/8')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('10.1.2.0/24').inRange('10.1.0.0/16')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('10.0.0.0/8').inRange('10.1.0.0/16')",
+			ResultIndex: 0, Expectation: false,
+		},
+		{
+			Code:        "ip('192.168.1.1').inRange(ip('10.0.0.0/8'))",
+			ResultIndex: 0, Expectation: false,
+		},
+		{
+			Code:        "ip('10.0.0.0/8').overlaps('10.1.0.0/16')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('0.0.0.0/0').overlaps('172.16.4.0/22')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('10.0.0.0/8').overlaps('192.168.0.0/16')",
+			ResultIndex: 0, Expectation: false,
+		},
+		{
+			Code:        "ip('10.1.2.3').inRange('::ffff:10.0.0.0/104')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('::ffff:10.0.0.0/104').overlaps('10.0.0.0/8')",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('::ffff:10.0.0.0/104') == '10.0.0.0/8'",
+			ResultIndex: 2, Expectation: true,
+		},
+		{
+			Code:        "ip('172.16.4.1').isPrivate",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('8.8.8.8').isPrivate",
+			ResultIndex: 0, Expectation: false,
+		},
+		{
+			Code:        "ip('::1').isLoopback",
+			ResultIndex: 0, Expectation: true,
+		},
+		{
+			Code:        "ip('2001:db8::1').version",
+			ResultIndex: 0, Expectation: int64(6),
+		},
+		{
+			Code:        "ip('10.1.2.3').version",
+			ResultIndex: 0, Expectation: int64(4),
+		},
+		{
+			Code:        "ip('10.1.2.3/20').prefix",
+			ResultIndex: 0, Expectation: int64(20),
+		},
+		{
+			Code:        "ip('10.1.2.3').prefix",
+			ResultIndex: 0, Expectation: int64(32),
+		},
+		{
+			Code:        "ip('10.1.2.3/20').subnet",
+			ResultIndex: 0, Expectation: "10.1.0.0/20",
+		},
+	})
+}
+
 func TestResource_Default(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock())
 	res := x.TestQuery(t, "mondoo")
@@ -125,3 +125,48 @@ func TestParse_Duration(t *testing.T) {
 		},
 	})
 }
+
+func TestParse_Encodings(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "parse.base64('c3VwZXItc2VjcmV0')",
+			ResultIndex: 0,
+			Expectation: "super-secret",
+		},
+		{
+			Code:        "parse.hex('68656c6c6f')",
+			ResultIndex: 0,
+			Expectation: "hello",
+		},
+		{
+			Code:        "parse.jwt('eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiIxMjM0IiwiYWRtaW4iOnRydWV9.c2ln')['claims']",
+			ResultIndex: 0,
+			Expectation: map[string]interface{}{"sub": "1234", "admin": true},
+		},
+		{
+			Code:        "parse.jwt('eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiIxMjM0IiwiYWRtaW4iOnRydWV9.c2ln')['header']['alg']",
+			ResultIndex: 0,
+			Expectation: "HS256",
+		},
+		{
+			Code:        "parse.urlQuery('https://example.com/?a=1&b=2&b=3')",
+			ResultIndex: 0,
+			Expectation: map[string]interface{}{"a": "1", "b": []interface{}{"2", "3"}},
+		},
+		{
+			Code:        "'hello'.sha256",
+			ResultIndex: 0,
+			Expectation: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		},
+		{
+			Code:        "'hello'.sha1",
+			ResultIndex: 0,
+			Expectation: "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+		},
+		{
+			Code:        "'hello'.md5",
+			ResultIndex: 0,
+			Expectation: "5d41402abc4b2a76b9719d911017c592",
+		},
+	})
+}
@@ -29,6 +29,7 @@ var Config = plugin.Provider{
 		shared.Type_FileSystem.String(),
 		shared.Type_Winrm.String(),
 		shared.Type_Device.String(),
+		shared.Type_Sbom.String(),
 	},
 	Connectors: []plugin.Connector{
 		{
@@ -56,6 +57,8 @@ var Config = plugin.Provider{
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -107,6 +110,8 @@ var Config = plugin.Provider{
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -166,6 +171,8 @@ var Config = plugin.Provider{
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -205,6 +212,8 @@ var Config = plugin.Provider{
 					Default: "",
 					Desc:    "HTTP proxy to use for container pulls",
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -244,6 +253,8 @@ var Config = plugin.Provider{
 					Default: "",
 					Desc:    "HTTP proxy to use for container pulls",
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -261,6 +272,19 @@ var Config = plugin.Provider{
 					Desc:    "Path to a local file or directory for the connection to use.",
 					Option:  plugin.FlagOption_Deprecated,
 				},
+				osvDatabaseFlag,
+				vexFlag,
+			},
+		},
+		{
+			Name:    "sbom",
+			Use:     "sbom PATH",
+			Short:   "a CycloneDX or SPDX software bill of materials",
+			MinArgs: 1,
+			MaxArgs: 1,
+			Flags: []plugin.Flag{
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -288,6 +312,8 @@ var Config = plugin.Provider{
 					Desc:   "List of platform IDs to inject to the asset.",
 					Option: plugin.FlagOption_Hidden,
 				},
+				osvDatabaseFlag,
+				vexFlag,
 			},
 		},
 	},
@@ -328,3 +354,18 @@ var Config = plugin.Provider{
 		},
 	},
 }
+
+// osvDatabaseFlag configures a local OSV database for vulnerability matching
+var osvDatabaseFlag = plugin.Flag{
+	Long:    shared.OsvDatabaseOption,
+	Type:    plugin.FlagType_String,
+	Default: "",
+	Desc:    "Path to a local OSV database (directory or zip file) to match vulnerabilities without Mondoo Platform.",
+}
+
+// vexFlag configures VEX documents to suppress triaged vulnerabilities
+var vexFlag = plugin.Flag{
+	Long: shared.VexOption,
+	Type: plugin.FlagType_List,
+	Desc: "Paths to OpenVEX or CycloneDX VEX documents. Vulnerabilities that are not affected or fixed are not reported.",
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
-	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
@@ -37,10 +36,12 @@ func NewImageConnection(id uint32, conf *inventory.Config, asset *inventory.Asse
 
 	conf.Options[tar.OPTION_FILE] = f.Name()
 
+	layers := &tar.Layers{}
 	return tar.NewConnection(id, conf, asset,
+		tar.WithLayers(layers),
 		tar.WithFetchFn(func() (string, error) {
 			log.Debug().Msg("tar> starting image extract to temporary file")
-			err = tar.StreamToTmpFile(mutate.Extract(img), f)
+			err = tar.StreamImageToTmpFile(img, f, layers)
 			if err != nil {
 				_ = os.Remove(f.Name())
 				return "", err
@@ -158,9 +159,11 @@ func NewFromTar(id uint32, conf *inventory.Config, asset *inventory.Asset) (*tar
 	imageFilename = f.Name()
 	conf.Options[tar.OPTION_FILE] = imageFilename
 
+	layers := &tar.Layers{}
 	c, err := tar.NewConnection(id, conf, asset,
+		tar.WithLayers(layers),
 		tar.WithFetchFn(func() (string, error) {
-			err = tar.StreamToTmpFile(mutate.Extract(img), f)
+			err = tar.StreamImageToTmpFile(img, f, layers)
 			if err != nil {
 				_ = os.Remove(imageFilename)
 				return imageFilename, err
@@ -13,7 +13,6 @@ import (
 
 	"github.com/docker/docker/client"
 	"github.com/google/go-containerregistry/pkg/name"
-	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
@@ -307,16 +306,18 @@ func NewContainerImageConnection(id uint32, conf *inventory.Config, asset *inven
 
 	conf.Options[tar.OPTION_FILE] = filename
 
+	layers := &tar.Layers{}
 	tarConn, err := tar.NewConnection(
 		id,
 		conf,
 		asset,
+		tar.WithLayers(layers),
 		tar.WithFetchFn(func() (string, error) {
 			img, err := image.LoadImageFromDockerEngine(ii.ID, disableInmemoryCache)
 			if err != nil {
 				return filename, err
 			}
-			err = tar.StreamToTmpFile(mutate.Extract(img), tmpFile)
+			err = tar.StreamImageToTmpFile(img, tmpFile, layers)
 			if err != nil {
 				_ = os.Remove(filename)
 				return filename, err
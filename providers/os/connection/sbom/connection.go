@@ -0,0 +1,125 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/sbom"
+	"go.mondoo.com/cnquery/v11/utils/multierr"
+)
+
+var _ shared.Connection = (*Connection)(nil)
+
+// Connection exposes the inventory of an SBOM document. There is
+// no file system or shell behind it, all packages are taken from the document.
+type Connection struct {
+	plugin.Connection
+	asset *inventory.Asset
+	fs    afero.Fs
+
+	Bom *sbom.Sbom
+}
+
+func NewConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) (*Connection, error) {
+	if conf == nil {
+		return nil, errors.New("missing configuration to create sbom connection")
+	}
+	if conf.Path == "" {
+		return nil, errors.New("please specify a path to the sbom file")
+	}
+
+	absSrc, err := filepath.Abs(conf.Path)
+	if err != nil {
+		return nil, multierr.Wrap(err, "can't get absolute path for sbom file")
+	}
+
+	bom, err := sbom.ParseFile(absSrc)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Str("path", absSrc).Int("packages", len(bom.Packages)).Msg("loaded sbom")
+
+	asset.Platform = &inventory.Platform{
+		Name:    "sbom",
+		Title:   "SBOM",
+		Runtime: string(shared.Type_Sbom),
+	}
+	if bom.Asset.Platform.Name != "" {
+		asset.Platform.Name = bom.Asset.Platform.Name
+		asset.Platform.Title = bom.Asset.Platform.Name
+		asset.Platform.Version = bom.Asset.Platform.Version
+	}
+
+	h := sha256.New()
+	h.Write([]byte(absSrc))
+	hash := hex.EncodeToString(h.Sum(nil))
+	platformID := "//platformid.api.mondoo.app/runtime/sbom/hash/" + hash
+	conf.PlatformId = platformID
+	asset.PlatformIds = []string{platformID}
+
+	if asset.Name == "" {
+		asset.Name = bom.Asset.Name
+	}
+	if asset.Name == "" {
+		asset.Name = strings.TrimSuffix(filepath.Base(absSrc), filepath.Ext(absSrc))
+	}
+
+	return &Connection{
+		Connection: plugin.NewConnection(id, asset),
+		asset:      asset,
+		fs:         afero.NewMemMapFs(),
+		Bom:        bom,
+	}, nil
+}
+
+func (c *Connection) RunCommand(command string) (*shared.Command, error) {
+	return nil, plugin.ErrRunCommandNotImplemented
+}
+
+func (c *Connection) FileSystem() afero.Fs {
+	return c.fs
+}
+
+func (c *Connection) FileInfo(path string) (shared.FileInfoDetails, error) {
+	stat, err := c.fs.Stat(path)
+	if err != nil {
+		return shared.FileInfoDetails{}, err
+	}
+	return shared.FileInfoDetails{
+		Mode: shared.FileModeDetails{stat.Mode()},
+		Size: stat.Size(),
+		Uid:  -1,
+		Gid:  -1,
+	}, nil
+}
+
+func (c *Connection) Capabilities() shared.Capabilities {
+	return shared.Capability_None
+}
+
+func (c *Connection) Name() string {
+	return string(shared.Type_Sbom)
+}
+
+func (c *Connection) Type() shared.ConnectionType {
+	return shared.Type_Sbom
+}
+
+func (c *Connection) Asset() *inventory.Asset {
+	return c.asset
+}
+
+func (c *Connection) UpdateAsset(asset *inventory.Asset) {
+	c.asset = asset
+}
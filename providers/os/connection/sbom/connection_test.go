@@ -0,0 +1,90 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
+)
+
+func writeSbom(t *testing.T, name string, data string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	return path
+}
+
+func TestNewConnection(t *testing.T) {
+	t.Run("without path", func(t *testing.T) {
+		conn, err := NewConnection(0, &inventory.Config{}, &inventory.Asset{})
+		require.Nil(t, conn)
+		require.EqualError(t, err, "please specify a path to the sbom file")
+	})
+
+	t.Run("unsupported file", func(t *testing.T) {
+		conn, err := NewConnection(0, &inventory.Config{Path: "./connection.go"}, &inventory.Asset{})
+		require.Nil(t, conn)
+		require.Error(t, err)
+	})
+
+	t.Run("cyclonedx", func(t *testing.T) {
+		path := writeSbom(t, "alpine.cdx.json", `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "metadata": {
+    "component": {"type": "container", "name": "alpine", "version": "3.19"}
+  },
+  "components": [
+    {"type": "operating-system", "name": "alpine", "version": "3.19.1"},
+    {"type": "library", "name": "busybox", "version": "1.36.1-r15", "purl": "pkg:apk/alpine/busybox@1.36.1-r15?arch=x86_64"},
+    {"type": "library", "name": "requests", "version": "2.31.0", "purl": "pkg:pypi/requests@2.31.0"}
+  ]
+}`)
+		conf := &inventory.Config{Path: path}
+		asset := &inventory.Asset{Connections: []*inventory.Config{conf}}
+		conn, err := NewConnection(0, conf, asset)
+		require.NoError(t, err)
+
+		assert.Equal(t, "alpine@3.19", asset.Name)
+		assert.Equal(t, "alpine", asset.Platform.Name)
+		assert.Equal(t, "3.19.1", asset.Platform.Version)
+		assert.Equal(t, "sbom", asset.Platform.Runtime)
+		require.Len(t, asset.PlatformIds, 1)
+		assert.True(t, strings.HasPrefix(asset.PlatformIds[0], "//platformid.api.mondoo.app/runtime/sbom/hash/"))
+		assert.Len(t, conn.Bom.Packages, 2)
+
+		_, err = conn.RunCommand("ls")
+		assert.Error(t, err)
+	})
+
+	t.Run("spdx", func(t *testing.T) {
+		path := writeSbom(t, "packages.spdx", "SPDXVersion: SPDX-2.3\n"+
+			"DataLicense: CC0-1.0\n"+
+			"SPDXID: SPDXRef-DOCUMENT\n"+
+			"DocumentName: debian-12\n"+
+			"DocumentNamespace: https://example.com/spdx/debian-12\n"+
+			"Creator: Tool: syft-1.5.0\n"+
+			"Created: 2024-06-01T10:00:00Z\n\n"+
+			"PackageName: libc6\n"+
+			"SPDXID: SPDXRef-Package-deb-libc6\n"+
+			"PackageVersion: 2.36-9\n"+
+			"PackageDownloadLocation: NOASSERTION\n"+
+			"ExternalRef: PACKAGE-MANAGER purl pkg:deb/debian/libc6@2.36-9?arch=amd64&distro=debian-12\n")
+		conf := &inventory.Config{Path: path}
+		asset := &inventory.Asset{Connections: []*inventory.Config{conf}}
+		conn, err := NewConnection(0, conf, asset)
+		require.NoError(t, err)
+
+		assert.Equal(t, "debian-12", asset.Name)
+		// the platform is taken from the distro of the package urls
+		assert.Equal(t, "debian", asset.Platform.Name)
+		assert.Equal(t, "12", asset.Platform.Version)
+		assert.Len(t, conn.Bom.Packages, 1)
+	})
+}
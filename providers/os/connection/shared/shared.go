@@ -43,8 +43,14 @@ const (
 	Type_ContainerRegistry ConnectionType = "container-registry"
 	Type_RegistryImage     ConnectionType = "registry-image"
 	Type_Device            ConnectionType = "device"
+	Type_Sbom              ConnectionType = "sbom"
 
 	ContainerProxyOption string = "container-proxy"
+	// path to a local OSV database that is used for vulnerability matching
+	// instead of the Mondoo upstream
+	OsvDatabaseOption string = "osv-db"
+	// comma-separated paths to VEX documents with the triaged vulnerabilities
+	VexOption string = "vex"
 )
 
 type Connection interface {
@@ -37,6 +37,7 @@ type Connection struct {
 
 	fs      *FS
 	closeFN func()
+	layers  *Layers
 	// fields are exposed since the tar backend is re-used for the docker backend
 	PlatformKind         string
 	PlatformRuntime      string
@@ -98,6 +99,25 @@ func (p *Connection) EnsureLoaded() {
 	}
 }
 
+// ImageLayers returns the layers of the container image, if the connection
+// is backed by a flattened container image
+func (c *Connection) ImageLayers() []*Layer {
+	c.EnsureLoaded()
+	return c.layers.List()
+}
+
+// FileLayer returns the container image layer that provides the file
+func (c *Connection) FileLayer(path string) *Layer {
+	c.EnsureLoaded()
+	return c.layers.FileLayer(path)
+}
+
+// FilesLayer returns the container image layer that provides most of the files
+func (c *Connection) FilesLayer(paths []string) *Layer {
+	c.EnsureLoaded()
+	return c.layers.FilesLayer(paths)
+}
+
 func (p *Connection) FileSystem() afero.Fs {
 	p.EnsureLoaded()
 	return p.fs
@@ -176,6 +196,8 @@ type tarConnectionOptions struct {
 	closeFn func()
 	// function to fetch the tar file from a remote location on first access
 	fetchFn func() (string, error)
+	// layers of the container image, populated by the fetch function
+	layers *Layers
 }
 
 type tarClientOption func(*tarConnectionOptions)
@@ -192,6 +214,14 @@ func WithFetchFn(fetchFn func() (string, error)) tarClientOption {
 	}
 }
 
+// WithLayers sets the layer index of a flattened container image. The fetch
+// function is expected to populate it, e.g. via StreamImageToTmpFile.
+func WithLayers(layers *Layers) tarClientOption {
+	return func(o *tarConnectionOptions) {
+		o.layers = layers
+	}
+}
+
 // NewConnection is opening a tar file and creating a new tar connection. The tar file is expected to be a valid
 // tar file and contains a flattened file structure. Nested tar files as used in docker images are not supported and
 // need to be extracted before using this connection.
@@ -220,6 +250,7 @@ func NewConnection(id uint32, conf *inventory.Config, asset *inventory.Asset, op
 		fs:              NewFs(filename),
 		closeFN:         params.closeFn,
 		fetchFn:         params.fetchFn,
+		layers:          params.layers,
 		PlatformKind:    conf.Type,
 		PlatformRuntime: conf.Runtime,
 		conf:            conf,
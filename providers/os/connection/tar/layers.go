@@ -0,0 +1,246 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tar
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/rs/zerolog/log"
+)
+
+const whiteoutPrefix = ".wh."
+
+// Layer is a layer of a container image
+type Layer struct {
+	// Index of the layer, the base layer has index 0
+	Index int
+	// Digest of the uncompressed layer content (diff id), this is the digest
+	// listed in the rootfs section of the image configuration
+	Digest string
+	// Image history command that created the layer, e.g. a Dockerfile RUN instruction
+	CreatedBy string
+	// Time when the layer was created
+	Created time.Time
+}
+
+// Layers tracks which layer of a flattened container image provides a file
+type Layers struct {
+	list  []*Layer
+	files map[string]int
+}
+
+// List returns all layers of the image, starting with the base layer
+func (l *Layers) List() []*Layer {
+	if l == nil {
+		return nil
+	}
+	return l.list
+}
+
+// FileLayer returns the layer that provides the given file in the flattened
+// image or nil if the file is unknown
+func (l *Layers) FileLayer(path string) *Layer {
+	if l == nil || l.files == nil {
+		return nil
+	}
+	idx, ok := l.files[path]
+	if !ok {
+		return nil
+	}
+	return l.list[idx]
+}
+
+// FilesLayer returns the layer that provides most of the given files. This is
+// used to attribute a package to a layer by the files it installed. If
+// multiple layers provide the same amount of files, the most recent one wins
+// since it overwrote the files of the earlier layers.
+func (l *Layers) FilesLayer(paths []string) *Layer {
+	if l == nil || l.files == nil {
+		return nil
+	}
+	counts := make([]int, len(l.list))
+	found := false
+	for _, path := range paths {
+		if idx, ok := l.files[path]; ok {
+			counts[idx]++
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	res := 0
+	for i := range counts {
+		if counts[i] >= counts[res] {
+			res = i
+		}
+	}
+	return l.list[res]
+}
+
+// newLayers creates the layer list from the image configuration. Each history
+// entry that is not an empty layer belongs to the next layer of the rootfs.
+func newLayers(img v1.Image) (*Layers, error) {
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading image config: %w", err)
+	}
+
+	res := &Layers{
+		list:  make([]*Layer, len(cfg.RootFS.DiffIDs)),
+		files: map[string]int{},
+	}
+	for i := range cfg.RootFS.DiffIDs {
+		res.list[i] = &Layer{
+			Index:  i,
+			Digest: cfg.RootFS.DiffIDs[i].String(),
+		}
+	}
+
+	history := []v1.History{}
+	for i := range cfg.History {
+		if !cfg.History[i].EmptyLayer {
+			history = append(history, cfg.History[i])
+		}
+	}
+	// images without a complete history cannot be mapped reliably
+	if len(history) == len(res.list) {
+		for i := range history {
+			res.list[i].CreatedBy = strings.TrimSpace(history[i].CreatedBy)
+			res.list[i].Created = history[i].Created.Time
+		}
+	}
+
+	return res, nil
+}
+
+// ExtractImage flattens the file system of a container image into a tar
+// stream and records the layer that provides each file into layers. It
+// follows mutate.Extract and handles whiteout files the same way. If the
+// layers of the image do not match its config, the image is flattened with
+// mutate.Extract and layers stays empty.
+func ExtractImage(img v1.Image, w io.Writer, layers *Layers) error {
+	idx, err := newLayers(img)
+	if err != nil {
+		return err
+	}
+
+	imgLayers, err := img.Layers()
+	if err != nil {
+		return fmt.Errorf("retrieving image layers: %w", err)
+	}
+	if len(imgLayers) != len(idx.list) {
+		log.Warn().Int("layers", len(imgLayers)).Int("config", len(idx.list)).
+			Msg("tar> image layers do not match its config, files are not attributed to layers")
+		if layers != nil {
+			*layers = Layers{}
+		}
+		reader := mutate.Extract(img)
+		defer reader.Close()
+		_, err := io.Copy(w, reader)
+		return err
+	}
+
+	tarWriter := tar.NewWriter(w)
+	defer tarWriter.Close()
+
+	fileMap := map[string]bool{}
+
+	// we iterate through the layers in reverse order, the first layer that
+	// provides a file is the one that is visible in the flattened image
+	for i := len(imgLayers) - 1; i >= 0; i-- {
+		if err := extractLayer(imgLayers[i], i, tarWriter, fileMap, idx.files); err != nil {
+			return err
+		}
+	}
+
+	if layers != nil {
+		*layers = *idx
+	}
+	return nil
+}
+
+func extractLayer(layer v1.Layer, index int, tarWriter *tar.Writer, fileMap map[string]bool, files map[string]int) error {
+	layerReader, err := layer.Uncompressed()
+	if err != nil {
+		return fmt.Errorf("reading layer contents: %w", err)
+	}
+	defer layerReader.Close()
+
+	tarReader := tar.NewReader(layerReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar: %w", err)
+		}
+
+		header.Name = filepath.Clean(header.Name)
+		header.Format = tar.FormatPAX
+
+		basename := filepath.Base(header.Name)
+		dirname := filepath.Dir(header.Name)
+		tombstone := strings.HasPrefix(basename, whiteoutPrefix)
+		if tombstone {
+			basename = basename[len(whiteoutPrefix):]
+		}
+
+		var name string
+		if header.Typeflag == tar.TypeDir {
+			name = header.Name
+		} else {
+			name = filepath.Join(dirname, basename)
+		}
+
+		if _, ok := fileMap[name]; ok {
+			continue
+		}
+		if inWhiteoutDir(fileMap, name) {
+			continue
+		}
+
+		// a file that is not a directory hides all entries with the same
+		// name in earlier layers
+		fileMap[name] = tombstone || (header.Typeflag != tar.TypeDir)
+		if tombstone {
+			continue
+		}
+
+		files[Abs(header.Name)] = index
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if header.Size > 0 {
+			if _, err := io.CopyN(tarWriter, tarReader, header.Size); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func inWhiteoutDir(fileMap map[string]bool, file string) bool {
+	for file != "" {
+		dirname := filepath.Dir(file)
+		if file == dirname {
+			break
+		}
+		if val, ok := fileMap[dirname]; ok && val {
+			return true
+		}
+		file = dirname
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tar_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cnquerytar "go.mondoo.com/cnquery/v11/providers/os/connection/tar"
+)
+
+type testFile struct {
+	name    string
+	dir     bool
+	content string
+}
+
+func newTestLayer(t *testing.T, files ...testFile) v1.Layer {
+	buf := bytes.Buffer{}
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		h := &tar.Header{Name: f.name, Mode: 0o644, Typeflag: tar.TypeReg, Size: int64(len(f.content))}
+		if f.dir {
+			h = &tar.Header{Name: f.name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		require.NoError(t, tw.WriteHeader(h))
+		_, err := tw.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	data := buf.Bytes()
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	})
+	require.NoError(t, err)
+	return layer
+}
+
+func newTestImage(t *testing.T) v1.Image {
+	base := newTestLayer(t,
+		testFile{name: "etc/os-release", content: "ID=debian"},
+		testFile{name: "bin/sh", content: "sh"},
+		testFile{name: "opt/old", content: "old"},
+		testFile{name: "tmp/dir", dir: true},
+		testFile{name: "tmp/dir/a", content: "a"},
+		testFile{name: "var/lib/dpkg/info/base-files.list", content: "/etc/os-release"},
+	)
+	app := newTestLayer(t,
+		testFile{name: "./bin/sh", content: "patched sh"},
+		testFile{name: "opt/.wh.old"},
+		testFile{name: "tmp/.wh.dir"},
+		testFile{name: "app/package.json", content: "{}"},
+		testFile{name: "var/lib/dpkg/info/curl.list", content: "/usr/bin/curl"},
+	)
+
+	img, err := mutate.Append(empty.Image,
+		mutate.Addendum{Layer: base, History: v1.History{CreatedBy: "/bin/sh -c #(nop) ADD file:1234 in / "}},
+		mutate.Addendum{Layer: app, History: v1.History{CreatedBy: "RUN /bin/sh -c apt-get install -y curl # buildkit"}},
+	)
+	require.NoError(t, err)
+
+	// history entries without layer must be skipped
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.History = []v1.History{
+		cfg.History[0],
+		{CreatedBy: "ENV PATH=/usr/local/bin", EmptyLayer: true},
+		cfg.History[1],
+	}
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	return img
+}
+
+// readTarFiles returns the content of all files in a tar stream by name
+func readTarFiles(t *testing.T, r io.Reader) map[string]string {
+	files := map[string]string{}
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[h.Name] = string(content)
+	}
+	return files
+}
+
+func TestExtractImage(t *testing.T) {
+	img := newTestImage(t)
+
+	out := bytes.Buffer{}
+	layers := &cnquerytar.Layers{}
+	require.NoError(t, cnquerytar.ExtractImage(img, &out, layers))
+
+	files := readTarFiles(t, &out)
+	assert.Equal(t, map[string]string{
+		"etc/os-release":                    "ID=debian",
+		"bin/sh":                            "patched sh",
+		"app/package.json":                  "{}",
+		"var/lib/dpkg/info/base-files.list": "/etc/os-release",
+		"var/lib/dpkg/info/curl.list":       "/usr/bin/curl",
+	}, files)
+
+	require.Len(t, layers.List(), 2)
+	base := layers.List()[0]
+	app := layers.List()[1]
+	assert.Equal(t, 0, base.Index)
+	assert.Equal(t, "/bin/sh -c #(nop) ADD file:1234 in /", base.CreatedBy)
+	assert.Equal(t, 1, app.Index)
+	assert.Equal(t, "RUN /bin/sh -c apt-get install -y curl # buildkit", app.CreatedBy)
+
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, cfg.RootFS.DiffIDs[1].String(), app.Digest)
+
+	assert.Equal(t, base, layers.FileLayer("/etc/os-release"))
+	assert.Equal(t, app, layers.FileLayer("/bin/sh"))
+	assert.Equal(t, app, layers.FileLayer("/var/lib/dpkg/info/curl.list"))
+	assert.Nil(t, layers.FileLayer("/opt/old"))
+	assert.Nil(t, layers.FileLayer("/tmp/dir/a"))
+
+	// the layer with most files wins, the most recent one on a tie
+	assert.Equal(t, base, layers.FilesLayer([]string{"/etc/os-release", "/var/lib/dpkg/info/base-files.list", "/bin/sh"}))
+	assert.Equal(t, app, layers.FilesLayer([]string{"/etc/os-release", "/bin/sh", "/unknown"}))
+	assert.Nil(t, layers.FilesLayer([]string{"/unknown"}))
+}
+
+func TestExtractImageWithoutLayers(t *testing.T) {
+	out := bytes.Buffer{}
+	require.NoError(t, cnquerytar.ExtractImage(newTestImage(t), &out, nil))
+	assert.NotZero(t, out.Len())
+
+	var layers *cnquerytar.Layers
+	assert.Nil(t, layers.List())
+	assert.Nil(t, layers.FileLayer("/bin/sh"))
+}
+
+// extraLayerImage has one more layer than its config lists
+type extraLayerImage struct {
+	v1.Image
+	extra v1.Layer
+}
+
+func (i *extraLayerImage) Layers() ([]v1.Layer, error) {
+	layers, err := i.Image.Layers()
+	if err != nil {
+		return nil, err
+	}
+	return append(layers, i.extra), nil
+}
+
+func TestExtractImageWithMismatchedConfig(t *testing.T) {
+	img := &extraLayerImage{
+		Image: newTestImage(t),
+		extra: newTestLayer(t, testFile{name: "usr/bin/extra", content: "extra"}),
+	}
+
+	out := bytes.Buffer{}
+	layers := &cnquerytar.Layers{}
+	require.NoError(t, cnquerytar.ExtractImage(img, &out, layers))
+
+	files := readTarFiles(t, &out)
+	// the image is still flattened, only the layers of the files are unknown
+	assert.Equal(t, "extra", files["usr/bin/extra"])
+	assert.Equal(t, "patched sh", files["bin/sh"])
+	assert.Empty(t, layers.List())
+	assert.Nil(t, layers.FileLayer("/bin/sh"))
+	assert.Nil(t, layers.FilesLayer([]string{"/bin/sh"}))
+}
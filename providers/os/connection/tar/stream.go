@@ -6,6 +6,8 @@ package tar
 import (
 	"io"
 	"os"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 )
 
 func RandomFile() (*os.File, error) {
@@ -23,3 +25,11 @@ func StreamToTmpFile(r io.ReadCloser, outFile *os.File) error {
 
 	return r.Close()
 }
+
+// StreamImageToTmpFile flattens a container image into a file and records the
+// layer of each file into layers. The user of this method is responsible for
+// deleting the file later
+func StreamImageToTmpFile(img v1.Image, outFile *os.File, layers *Layers) error {
+	defer outFile.Close()
+	return ExtractImage(img, outFile, layers)
+}
@@ -22,6 +22,7 @@ import (
 	"go.mondoo.com/cnquery/v11/providers/os/connection/fs"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/local"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/mock"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/sbom"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/ssh"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/tar"
@@ -139,10 +140,13 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		} else {
 			log.Warn().Msg("no path provided as an arg, looking for --path flag")
 		}
+	case "sbom":
+		conf.Type = shared.Type_Sbom.String()
+		conf.Path = req.Args[0]
 	}
 
 	user := ""
-	if len(req.Args) != 0 && !(strings.HasPrefix(req.Connector, "docker") || strings.HasPrefix(req.Connector, "container")) {
+	if len(req.Args) != 0 && !(strings.HasPrefix(req.Connector, "docker") || strings.HasPrefix(req.Connector, "container") || req.Connector == "sbom") {
 		target := req.Args[0]
 		if !strings.Contains(target, "://") {
 			target = "ssh://" + target
@@ -224,6 +228,23 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		}
 	}
 
+	if osvDatabase, ok := flags[shared.OsvDatabaseOption]; ok {
+		osvDatabaseVal := osvDatabase.RawData().Value.(string)
+		if osvDatabaseVal != "" {
+			conf.Options[shared.OsvDatabaseOption] = osvDatabaseVal
+		}
+	}
+
+	if vex, ok := flags[shared.VexOption]; ok {
+		paths := []string{}
+		for _, path := range vex.Array {
+			paths = append(paths, path.RawData().Value.(string))
+		}
+		if len(paths) > 0 {
+			conf.Options[shared.VexOption] = strings.Join(paths, ",")
+		}
+	}
+
 	if lun, ok := flags["lun"]; ok {
 		conf.Options["lun"] = lun.RawData().Value.(string)
 	}
@@ -472,6 +493,13 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 				asset.PlatformIds = []string{pID}
 			}
 
+		case shared.Type_Sbom.String():
+			conn, err = sbom.NewConnection(connId, conf, asset)
+			if err != nil {
+				return nil, err
+			}
+			asset.Platform.Family = detector.Family(asset.Platform.Name)
+
 		// Do not expose mock connection as a supported type
 		case "mock":
 			conn, err = mock.New(connId, "", asset)
@@ -0,0 +1,77 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"strconv"
+
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/apparmor"
+)
+
+func (a *mqlApparmor) id() (string, error) {
+	return "apparmor", nil
+}
+
+func (a *mqlApparmor) enabled() (bool, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	return apparmor.Enabled(conn.FileSystem())
+}
+
+func (a *mqlApparmor) profiles() ([]any, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	profiles, err := apparmor.ReadProfiles(conn.FileSystem())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(profiles))
+	for i, profile := range profiles {
+		obj, err := CreateResource(a.MqlRuntime, "apparmor.profile", map[string]*llx.RawData{
+			"__id": llx.StringData("apparmor.profile/" + profile.Name),
+			"name": llx.StringData(profile.Name),
+			"mode": llx.StringData(profile.Mode),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func (a *mqlApparmor) processes() ([]any, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	processes, err := apparmor.ReadProcesses(conn.FileSystem())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(processes))
+	for i, p := range processes {
+		obj, err := CreateResource(a.MqlRuntime, "apparmor.process", map[string]*llx.RawData{
+			"__id":    llx.StringData("apparmor.process/" + strconv.FormatInt(p.Pid, 10)),
+			"pid":     llx.IntData(p.Pid),
+			"command": llx.StringData(p.Command),
+			"profile": llx.StringData(p.Profile),
+			"mode":    llx.StringData(p.Mode),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func (a *mqlApparmorProcess) process() (*mqlProcess, error) {
+	res, err := NewResource(a.MqlRuntime, "process", map[string]*llx.RawData{
+		"pid": llx.IntData(a.Pid.Data),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlProcess), nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package apparmor
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	ModeEnforce    = "enforce"
+	ModeComplain   = "complain"
+	ModeUnconfined = "unconfined"
+)
+
+const (
+	enabledPath    = "/sys/module/apparmor/parameters/enabled"
+	securityfsPath = "/sys/kernel/security/apparmor"
+)
+
+// Enabled returns whether AppArmor is enabled in the kernel
+func Enabled(fs afero.Fs) (bool, error) {
+	data, err := afero.ReadFile(fs, enabledPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(data)) == "Y", nil
+}
+
+// Profile is a profile loaded into the kernel
+type Profile struct {
+	Name string
+	// Mode of the profile, e.g. enforce or complain
+	Mode string
+}
+
+// ReadProfiles reads the loaded profiles from the securityfs
+func ReadProfiles(fs afero.Fs) ([]Profile, error) {
+	f, err := fs.Open(path.Join(securityfsPath, "profiles"))
+	if os.IsNotExist(err) {
+		return []Profile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseProfiles(f)
+}
+
+// ParseProfiles parses the list of profiles, e.g. /usr/sbin/cupsd (enforce)
+func ParseProfiles(r io.Reader) ([]Profile, error) {
+	res := []Profile{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		name, mode := parseLabel(line)
+		res = append(res, Profile{Name: name, Mode: mode})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, scanner.Err()
+}
+
+// Process is the confinement of a running process
+type Process struct {
+	Pid     int64
+	Command string
+	// Profile that confines the process, unconfined if there is none
+	Profile string
+	Mode    string
+}
+
+// ReadProcesses reads the confinement of all processes from the procfs
+func ReadProcesses(fs afero.Fs) ([]Process, error) {
+	entries, err := afero.ReadDir(fs, "/proc")
+	if os.IsNotExist(err) {
+		return []Process{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Process{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pid, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		label, err := readProcessLabel(fs, entry.Name())
+		if err != nil {
+			// processes may exit while we read them
+			continue
+		}
+		profile, mode := parseLabel(label)
+		comm, _ := afero.ReadFile(fs, path.Join("/proc", entry.Name(), "comm"))
+		res = append(res, Process{
+			Pid:     pid,
+			Command: strings.TrimSpace(string(comm)),
+			Profile: profile,
+			Mode:    mode,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Pid < res[j].Pid
+	})
+	return res, nil
+}
+
+// readProcessLabel reads the AppArmor label of a process. Newer kernels have
+// a separate attribute for every security module, older kernels only have the
+// one of the active module.
+func readProcessLabel(fs afero.Fs, pid string) (string, error) {
+	data, err := afero.ReadFile(fs, path.Join("/proc", pid, "attr", "apparmor", "current"))
+	if os.IsNotExist(err) {
+		data, err = afero.ReadFile(fs, path.Join("/proc", pid, "attr", "current"))
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\x00\n"), nil
+}
+
+// parseLabel splits a label like /usr/sbin/cupsd (enforce) into the profile
+// and the mode
+func parseLabel(label string) (string, string) {
+	label = strings.TrimSpace(label)
+	if label == ModeUnconfined {
+		return ModeUnconfined, ModeUnconfined
+	}
+	idx := strings.LastIndex(label, " (")
+	if idx < 0 || !strings.HasSuffix(label, ")") {
+		return label, ""
+	}
+	return label[:idx], label[idx+2 : len(label)-1]
+}
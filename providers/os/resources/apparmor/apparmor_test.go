@@ -0,0 +1,55 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package apparmor
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers/os/fs"
+)
+
+func TestAppArmor(t *testing.T) {
+	tfs := fs.NewMountedFs("testdata")
+
+	enabled, err := Enabled(tfs)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	profiles, err := ReadProfiles(tfs)
+	require.NoError(t, err)
+	assert.Equal(t, []Profile{
+		{Name: "/usr/bin/man", Mode: ModeEnforce},
+		{Name: "/usr/sbin/cups-browsed", Mode: ModeComplain},
+		{Name: "/usr/sbin/cupsd", Mode: ModeEnforce},
+		{Name: "docker-default", Mode: ModeEnforce},
+	}, profiles)
+
+	processes, err := ReadProcesses(tfs)
+	require.NoError(t, err)
+	assert.Equal(t, []Process{
+		{Pid: 1, Command: "systemd", Profile: ModeUnconfined, Mode: ModeUnconfined},
+		{Pid: 812, Command: "cupsd", Profile: "/usr/sbin/cupsd", Mode: ModeEnforce},
+		{Pid: 1023, Command: "cups-browsed", Profile: "/usr/sbin/cups-browsed", Mode: ModeComplain},
+	}, processes)
+}
+
+func TestAppArmorSnapshot(t *testing.T) {
+	mfs := afero.NewMemMapFs()
+	require.NoError(t, mfs.MkdirAll("/proc", 0o755))
+
+	enabled, err := Enabled(mfs)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	profiles, err := ReadProfiles(mfs)
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	processes, err := ReadProcesses(mfs)
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+}
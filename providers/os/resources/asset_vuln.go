@@ -6,6 +6,8 @@ package resources
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -18,6 +20,8 @@ import (
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd/cvss"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/util/convert"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/osv"
+	"google.golang.org/protobuf/proto"
 )
 
 // TODO: generalize this kind of function
@@ -40,18 +44,8 @@ func getKernelVersion(kernel *mqlKernel) string {
 	return val.(string)
 }
 
-func fetchVulnReport(runtime *plugin.Runtime) (interface{}, error) {
-	mcc := runtime.Upstream
-	if mcc == nil || mcc.ApiEndpoint == "" {
-		return nil, resources.MissingUpstreamError{}
-	}
-
-	// get new mvd client
-	scannerClient, err := mvd.NewAdvisoryScannerClient(mcc.ApiEndpoint, mcc.HttpClient, mcc.Plugins...)
-	if err != nil {
-		return nil, err
-	}
-
+// newAnalyseAssetRequest collects the installed packages for the vulnerability scan
+func newAnalyseAssetRequest(runtime *plugin.Runtime) (*mvd.AnalyseAssetRequest, error) {
 	conn := runtime.Connection.(shared.Connection)
 	apiPackages := []*mvd.Package{}
 	kernelVersion := ""
@@ -90,10 +84,179 @@ func fetchVulnReport(runtime *plugin.Runtime) (interface{}, error) {
 		}
 	}
 
-	scanjob := &mvd.AnalyseAssetRequest{
+	return &mvd.AnalyseAssetRequest{
 		Platform:      mvd.NewMvdPlatform(conn.Asset().Platform),
 		Packages:      apiPackages,
 		KernelVersion: kernelVersion,
+	}, nil
+}
+
+// osvDatabase returns the path of the local OSV database, if one is configured
+func osvDatabase(runtime *plugin.Runtime) string {
+	conn := runtime.Connection.(shared.Connection)
+	asset := conn.Asset()
+	if asset == nil {
+		return ""
+	}
+	for _, conf := range asset.Connections {
+		if path := conf.Options[shared.OsvDatabaseOption]; path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+type mqlPlatformAdvisoriesInternal struct {
+	lock        sync.Mutex
+	localReport *mvd.VulnReport
+}
+
+// getLocalVulnReport matches the packages against the local OSV database. The
+// report is cached, since all vulnerability resources of the asset use it.
+func getLocalVulnReport(runtime *plugin.Runtime, path string) (*mvd.VulnReport, error) {
+	obj, err := CreateResource(runtime, "platform.advisories", map[string]*llx.RawData{})
+	if err != nil {
+		return nil, err
+	}
+	advisories := obj.(*mqlPlatformAdvisories)
+
+	advisories.lock.Lock()
+	defer advisories.lock.Unlock()
+	if advisories.localReport != nil {
+		return advisories.localReport, nil
+	}
+
+	scanjob, err := newAnalyseAssetRequest(runtime)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugDumpYAML("vuln-scan-job", scanjob)
+
+	log.Debug().Str("path", path).Msg("run advisory scan against local osv database")
+	report, err := osv.AnalyseAsset(path, scanjob)
+	if err != nil {
+		return nil, err
+	}
+	advisories.localReport = report
+	return report, nil
+}
+
+// filterVexReport removes the advisories and CVEs that are not affected or
+// fixed according to the VEX documents of the asset. The report itself is
+// not modified, since it is shared by all vulnerability resources.
+func filterVexReport(runtime *plugin.Runtime, report *mvd.VulnReport) (*mvd.VulnReport, error) {
+	filter, err := newVexFilter(runtime)
+	if err != nil || filter == nil {
+		return report, err
+	}
+	return filter.filterReport(report), nil
+}
+
+func (f *vexFilter) filterReport(report *mvd.VulnReport) *mvd.VulnReport {
+	// package urls of the packages that each advisory and CVE affects
+	advisoryPurls := make([][]string, len(report.Advisories))
+	cvePurls := map[string][]string{}
+	for i, a := range report.Advisories {
+		for _, p := range a.Affected {
+			advisoryPurls[i] = f.appendPurls(advisoryPurls[i], p.Name)
+		}
+		for _, c := range a.Cves {
+			for _, p := range a.Affected {
+				cvePurls[c.ID] = f.appendPurls(cvePurls[c.ID], p.Name)
+			}
+		}
+	}
+
+	suppressed := map[string]struct{}{}
+	for id, purls := range cvePurls {
+		if stmt := f.statement([]string{id}, purls); stmt != nil && stmt.Status.Suppresses() {
+			suppressed[id] = struct{}{}
+		}
+	}
+	isSuppressed := func(id string) bool {
+		_, ok := suppressed[id]
+		return ok
+	}
+
+	advisories := []*mvd.Advisory{}
+	filtered := false
+	for i, a := range report.Advisories {
+		cves := make([]string, len(a.Cves))
+		for j := range a.Cves {
+			cves[j] = a.Cves[j].ID
+		}
+		if f.suppresses(a.ID, advisoryPurls[i], cves, suppressed) {
+			log.Debug().Str("advisory", a.ID).Msg("advisory is suppressed by vex statement")
+			filtered = true
+			continue
+		}
+		if slices.ContainsFunc(cves, isSuppressed) {
+			a = proto.Clone(a).(*mvd.Advisory)
+			a.Cves = slices.DeleteFunc(a.Cves, func(c *mvd.CVE) bool { return isSuppressed(c.ID) })
+			filtered = true
+		}
+		advisories = append(advisories, a)
+	}
+	if !filtered {
+		return report
+	}
+
+	// packages are only affected by the advisories that are left
+	scores := map[string]int32{}
+	for _, a := range advisories {
+		for _, p := range a.Affected {
+			key := p.Name + "/" + p.Version + "/" + p.Arch
+			if score, ok := scores[key]; !ok || a.Score > score {
+				scores[key] = a.Score
+			}
+		}
+	}
+	packages := make([]*mvd.Package, len(report.Packages))
+	for i, p := range report.Packages {
+		score, affected := scores[p.Name+"/"+p.Version+"/"+p.Arch]
+		if p.Affected != affected || p.Score != score {
+			p = proto.Clone(p).(*mvd.Package)
+			p.Affected, p.Score = affected, score
+		}
+		packages[i] = p
+	}
+
+	return &mvd.VulnReport{
+		Platform:   report.Platform,
+		Packages:   packages,
+		Advisories: advisories,
+		Stats:      mvd.NewReportStats(packages, advisories),
+		Published:  report.Published,
+	}
+}
+
+func fetchVulnReport(runtime *plugin.Runtime) (interface{}, error) {
+	if path := osvDatabase(runtime); path != "" {
+		report, err := getLocalVulnReport(runtime, path)
+		if err != nil {
+			return nil, err
+		}
+		report, err = filterVexReport(runtime, report)
+		if err != nil {
+			return nil, err
+		}
+		return convert.JsonToDict(report)
+	}
+
+	mcc := runtime.Upstream
+	if mcc == nil || mcc.ApiEndpoint == "" {
+		return nil, resources.MissingUpstreamError{}
+	}
+
+	// get new mvd client
+	scannerClient, err := mvd.NewAdvisoryScannerClient(mcc.ApiEndpoint, mcc.HttpClient, mcc.Plugins...)
+	if err != nil {
+		return nil, err
+	}
+
+	scanjob, err := newAnalyseAssetRequest(runtime)
+	if err != nil {
+		return nil, err
 	}
 	logger.DebugDumpYAML("vuln-scan-job", scanjob)
 
@@ -102,6 +265,10 @@ func fetchVulnReport(runtime *plugin.Runtime) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	report, err = filterVexReport(runtime, report)
+	if err != nil {
+		return nil, err
+	}
 
 	return convert.JsonToDict(report)
 }
@@ -116,6 +283,14 @@ func (p *mqlAsset) vulnerabilityReport() (interface{}, error) {
 }
 
 func getAdvisoryReport(runtime *plugin.Runtime) (*mvd.VulnReport, error) {
+	if path := osvDatabase(runtime); path != "" {
+		report, err := getLocalVulnReport(runtime, path)
+		if err != nil {
+			return nil, err
+		}
+		return filterVexReport(runtime, report)
+	}
+
 	mcc := runtime.Upstream
 	if mcc == nil || mcc.ApiEndpoint == "" {
 		return nil, resources.MissingUpstreamError{}
@@ -139,7 +314,7 @@ func getAdvisoryReport(runtime *plugin.Runtime) (*mvd.VulnReport, error) {
 
 	vulnReport := gql.ConvertToMvdVulnReport(gqlVulnReport)
 
-	return vulnReport, nil
+	return filterVexReport(runtime, vulnReport)
 }
 
 func (a *mqlPlatformAdvisories) id() (string, error) {
@@ -205,8 +380,8 @@ func (a *mqlPlatformAdvisories) list() ([]interface{}, error) {
 			"mrn":         llx.StringData(advisory.Mrn),
 			"title":       llx.StringData(advisory.Title),
 			"description": llx.StringData(advisory.Description),
-			"published":   llx.TimeData(*published),
-			"modified":    llx.TimeData(*modified),
+			"published":   llx.TimeDataPtr(published),
+			"modified":    llx.TimeDataPtr(modified),
 			"worstScore":  llx.ResourceData(cvssScore, "audit.cvss"),
 		})
 		if err != nil {
@@ -0,0 +1,65 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/vex"
+)
+
+func TestVexFilterReport(t *testing.T) {
+	doc, err := vex.Parse(strings.NewReader(`{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "statements": [
+    {
+      "vulnerability": {"name": "CVE-2023-38545"},
+      "products": [{"@id": "pkg:deb/debian/curl"}],
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_present"
+    },
+    {
+      "vulnerability": {"name": "CVE-2023-38546"},
+      "products": [{"@id": "pkg:deb/debian/curl"}],
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_present"
+    }
+  ]
+}`))
+	require.NoError(t, err)
+	filter := &vexFilter{
+		doc: doc,
+		purls: map[string][]string{
+			"curl":     {"pkg:deb/debian/curl@7.88.1-10?arch=amd64"},
+			"libcurl4": {"pkg:deb/debian/libcurl4@7.88.1-10?arch=amd64"},
+		},
+	}
+
+	curl := &mvd.Package{Name: "curl", Version: "7.88.1-10", Arch: "amd64", Affected: true, Score: 75}
+	libcurl := &mvd.Package{Name: "libcurl4", Version: "7.88.1-10", Arch: "amd64", Affected: true, Score: 98}
+	report := &mvd.VulnReport{
+		Packages: []*mvd.Package{curl, libcurl},
+		Advisories: []*mvd.Advisory{
+			// the statement for curl does not apply to the affected libcurl
+			{ID: "DSA-5587-1", Score: 98, Affected: []*mvd.Package{libcurl}, Cves: []*mvd.CVE{{ID: "CVE-2023-38545"}}},
+			{ID: "DLA-3613-1", Score: 75, Affected: []*mvd.Package{curl}, Cves: []*mvd.CVE{{ID: "CVE-2023-38546"}}},
+		},
+	}
+
+	res := filter.filterReport(report)
+	require.Len(t, res.Advisories, 1)
+	assert.Equal(t, "DSA-5587-1", res.Advisories[0].ID)
+	assert.Equal(t, int32(1), res.Stats.Advisories.Total)
+	assert.Equal(t, int32(1), res.Stats.Packages.Affected)
+	assert.False(t, res.Packages[0].Affected)
+	assert.True(t, res.Packages[1].Affected)
+
+	// the shared report is not modified
+	assert.Len(t, report.Advisories, 2)
+	assert.True(t, curl.Affected)
+}
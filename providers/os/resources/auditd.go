@@ -0,0 +1,309 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/auditd"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+const (
+	defaultAuditdConfig = "/etc/audit/auditd.conf"
+	defaultAuditdRules  = "/etc/audit/rules.d"
+)
+
+func initAuditdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		path, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("wrong type for 'path' in auditd.config initialization, it must be a string")
+		}
+
+		f, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(path),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		args["file"] = llx.ResourceData(f, "file")
+
+		delete(args, "path")
+	}
+
+	return args, nil, nil
+}
+
+func (s *mqlAuditdConfig) id() (string, error) {
+	file := s.GetFile()
+	if file.Error != nil {
+		return "", file.Error
+	}
+
+	return file.Data.Path.Data, nil
+}
+
+func (s *mqlAuditdConfig) file() (*mqlFile, error) {
+	f, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(defaultAuditdConfig),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.(*mqlFile), nil
+}
+
+func (s *mqlAuditdConfig) content(file *mqlFile) (string, error) {
+	c := file.GetContent()
+	return c.Data, c.Error
+}
+
+func (s *mqlAuditdConfig) params(content string) (map[string]any, error) {
+	params, err := auditd.ParseConfig(strings.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]any, len(params))
+	for k, v := range params {
+		res[k] = v
+	}
+	return res, nil
+}
+
+type mqlAuditdRulesInternal struct {
+	lock sync.Mutex
+}
+
+func initAuditdRules(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		if _, ok := x.Value.(string); !ok {
+			return nil, nil, errors.New("wrong type for 'path' in auditd.rules initialization, it must be a string")
+		}
+		return args, nil, nil
+	}
+
+	args["path"] = llx.StringData(defaultAuditdRules)
+	return args, nil, nil
+}
+
+func (s *mqlAuditdRules) id() (string, error) {
+	return s.Path.Data, nil
+}
+
+// parse reads the rules of a rules file or of all .rules files in a
+// directory, in the order augenrules combines them
+func (s *mqlAuditdRules) parse(rulesPath string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rules, err := readAuditdRules(s.MqlRuntime, rulesPath)
+	if err != nil {
+		s.Controls = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Files = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Syscalls = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		return err
+	}
+
+	controls, files, syscalls, err := newAuditdRules(s.MqlRuntime, s.__id, rules)
+	if err != nil {
+		return err
+	}
+	s.Controls = plugin.TValue[[]any]{Data: controls, State: plugin.StateIsSet}
+	s.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	s.Syscalls = plugin.TValue[[]any]{Data: syscalls, State: plugin.StateIsSet}
+	return nil
+}
+
+func readAuditdRules(runtime *plugin.Runtime, rulesPath string) (*auditd.Rules, error) {
+	conn := runtime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	rules := &auditd.Rules{}
+	stat, err := afs.Stat(rulesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return rules, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{rulesPath}
+	if stat.IsDir() {
+		entries, err := afs.ReadDir(rulesPath)
+		if err != nil {
+			return nil, err
+		}
+		paths = []string{}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".rules") {
+				paths = append(paths, path.Join(rulesPath, entry.Name()))
+			}
+		}
+		sort.Strings(paths)
+	}
+
+	for _, p := range paths {
+		f, err := afs.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		err = auditd.ParseRules(f, rules)
+		f.Close()
+		if err != nil {
+			return nil, errors.New("cannot parse " + p + ": " + err.Error())
+		}
+	}
+	return rules, nil
+}
+
+func (s *mqlAuditdRules) controls(path string) ([]any, error) {
+	return nil, s.parse(path)
+}
+
+func (s *mqlAuditdRules) files(path string) ([]any, error) {
+	return nil, s.parse(path)
+}
+
+func (s *mqlAuditdRules) syscalls(path string) ([]any, error) {
+	return nil, s.parse(path)
+}
+
+type mqlAuditdLoadedRulesInternal struct {
+	lock sync.Mutex
+}
+
+func (s *mqlAuditdLoadedRules) id() (string, error) {
+	return "auditd.loadedRules", nil
+}
+
+func (s *mqlAuditdLoadedRules) load() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// filesystem snapshots have no running kernel
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	if !conn.Capabilities().Has(shared.Capability_RunCommand) {
+		s.Files = plugin.TValue[[]any]{State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Syscalls = plugin.TValue[[]any]{State: plugin.StateIsSet | plugin.StateIsNull}
+		return nil
+	}
+
+	rules, err := runAuditctl(conn)
+	if err != nil {
+		s.Files = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Syscalls = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		return err
+	}
+
+	_, files, syscalls, err := newAuditdRules(s.MqlRuntime, s.__id, rules)
+	if err != nil {
+		return err
+	}
+	s.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	s.Syscalls = plugin.TValue[[]any]{Data: syscalls, State: plugin.StateIsSet}
+	return nil
+}
+
+func runAuditctl(conn shared.Connection) (*auditd.Rules, error) {
+	cmd, err := conn.RunCommand("auditctl -l")
+	if err != nil {
+		return nil, err
+	}
+	if cmd.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(cmd.Stderr)
+		return nil, errors.New("failed to list audit rules: " + strings.TrimSpace(string(stderr)))
+	}
+
+	rules := &auditd.Rules{}
+	if err := auditd.ParseRules(cmd.Stdout, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *mqlAuditdLoadedRules) files() ([]any, error) {
+	return nil, s.load()
+}
+
+func (s *mqlAuditdLoadedRules) syscalls() ([]any, error) {
+	return nil, s.load()
+}
+
+// newAuditdRules creates the resources of the rules, they are identified
+// by their position in the rules of the owner
+func newAuditdRules(runtime *plugin.Runtime, ownerID string, rules *auditd.Rules) ([]any, []any, []any, error) {
+	controls := make([]any, len(rules.Controls))
+	for i, control := range rules.Controls {
+		obj, err := CreateResource(runtime, "auditd.rule.control", map[string]*llx.RawData{
+			"__id":  llx.StringData(ownerID + "\x00control\x00" + strconv.Itoa(i)),
+			"flag":  llx.StringData(control.Flag),
+			"value": llx.StringData(control.Value),
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		controls[i] = obj
+	}
+
+	files := make([]any, len(rules.Files))
+	for i, rule := range rules.Files {
+		obj, err := CreateResource(runtime, "auditd.rule.file", map[string]*llx.RawData{
+			"__id":        llx.StringData(ownerID + "\x00file\x00" + strconv.Itoa(i)),
+			"path":        llx.StringData(rule.Path),
+			"permissions": llx.StringData(rule.Permissions),
+			"keys":        llx.ArrayData(llx.TArr2Raw(rule.Keys), types.String),
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		files[i] = obj
+	}
+
+	syscalls := make([]any, len(rules.Syscalls))
+	for i, rule := range rules.Syscalls {
+		ruleID := ownerID + "\x00syscall\x00" + strconv.Itoa(i)
+		fields := make([]any, len(rule.Fields))
+		for j, field := range rule.Fields {
+			obj, err := CreateResource(runtime, "auditd.rule.field", map[string]*llx.RawData{
+				"__id":  llx.StringData(ruleID + "\x00" + strconv.Itoa(j)),
+				"name":  llx.StringData(field.Name),
+				"op":    llx.StringData(field.Op),
+				"value": llx.StringData(field.Value),
+			})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			fields[j] = obj
+		}
+
+		obj, err := CreateResource(runtime, "auditd.rule.syscall", map[string]*llx.RawData{
+			"__id":        llx.StringData(ruleID),
+			"action":      llx.StringData(rule.Action),
+			"list":        llx.StringData(rule.List),
+			"syscalls":    llx.ArrayData(llx.TArr2Raw(rule.Syscalls), types.String),
+			"fields":      llx.ArrayData(fields, types.Resource("auditd.rule.field")),
+			"permissions": llx.StringData(rule.Permissions),
+			"keys":        llx.ArrayData(llx.TArr2Raw(rule.Keys), types.String),
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		syscalls[i] = obj
+	}
+
+	return controls, files, syscalls, nil
+}
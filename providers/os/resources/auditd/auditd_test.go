@@ -0,0 +1,129 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package auditd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	f, err := os.Open("testdata/auditd.conf")
+	require.NoError(t, err)
+	defer f.Close()
+
+	config, err := ParseConfig(f)
+	require.NoError(t, err)
+	assert.Len(t, config, 15)
+	assert.Equal(t, "/var/log/audit/audit.log", config["log_file"])
+	assert.Equal(t, "keep_logs", config["max_log_file_action"])
+	assert.Equal(t, "halt", config["admin_space_left_action"])
+}
+
+func TestParseRuleFiles(t *testing.T) {
+	files, err := filepath.Glob("testdata/rules.d/*.rules")
+	require.NoError(t, err)
+
+	rules := &Rules{}
+	for _, path := range files {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		err = ParseRules(f, rules)
+		f.Close()
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []Control{
+		{Flag: "-D"},
+		{Flag: "-b", Value: "8192"},
+		{Flag: "--backlog_wait_time", Value: "60000"},
+		{Flag: "-f", Value: "1"},
+		{Flag: "--loginuid-immutable"},
+		{Flag: "-e", Value: "2"},
+	}, rules.Controls)
+
+	assert.Equal(t, []FileRule{
+		{Path: "/etc/sudoers", Permissions: "wa", Keys: []string{"scope"}},
+		{Path: "/etc/sudoers.d", Permissions: "wa", Keys: []string{"scope"}},
+	}, rules.Files)
+
+	require.Len(t, rules.Syscalls, 4)
+	assert.Equal(t, SyscallRule{
+		Action:   "always",
+		List:     "exit",
+		Syscalls: []string{"adjtimex", "settimeofday", "clock_settime"},
+		Fields:   []Field{{Name: "arch", Op: "=", Value: "b64"}},
+		Keys:     []string{"time-change"},
+	}, rules.Syscalls[0])
+	// the list may come first and syscalls may be passed separately
+	assert.Equal(t, SyscallRule{
+		Action:   "always",
+		List:     "exit",
+		Syscalls: []string{"adjtimex", "settimeofday", "clock_settime"},
+		Fields:   []Field{{Name: "arch", Op: "=", Value: "b32"}},
+		Keys:     []string{"time-change"},
+	}, rules.Syscalls[1])
+	assert.Equal(t, SyscallRule{
+		Action: "always",
+		List:   "exit",
+		Fields: []Field{
+			{Name: "path", Op: "=", Value: "/usr/bin/sudo"},
+			{Name: "auid", Op: ">=", Value: "1000"},
+			{Name: "auid", Op: "!=", Value: "unset"},
+		},
+		Permissions: "x",
+		Keys:        []string{"privileged"},
+	}, rules.Syscalls[2])
+	assert.Equal(t, []Field{
+		{Name: "arch", Op: "=", Value: "b64"},
+		{Name: "exit", Op: "=", Value: "-EACCES"},
+		{Name: "auid", Op: ">=", Value: "1000"},
+		{Name: "auid", Op: "!=", Value: "unset"},
+	}, rules.Syscalls[3].Fields)
+}
+
+func TestParseAuditctl(t *testing.T) {
+	f, err := os.Open("testdata/auditctl.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	rules := &Rules{}
+	require.NoError(t, ParseRules(f, rules))
+	assert.Empty(t, rules.Controls)
+	assert.Len(t, rules.Files, 2)
+	require.Len(t, rules.Syscalls, 3)
+	assert.Equal(t, []string{"time-change"}, rules.Syscalls[1].Keys)
+	assert.Equal(t, SyscallRule{
+		Action:   "always",
+		List:     "exit",
+		Syscalls: []string{"all"},
+		Fields: []Field{
+			{Name: "path", Op: "=", Value: "/usr/bin/sudo"},
+			{Name: "auid", Op: ">=", Value: "1000"},
+			{Name: "auid", Op: "!=", Value: "-1"},
+		},
+		Permissions: "x",
+		Keys:        []string{"privileged"},
+	}, rules.Syscalls[2])
+
+	rules = &Rules{}
+	require.NoError(t, ParseRules(strings.NewReader("No rules\n"), rules))
+	assert.Equal(t, &Rules{}, rules)
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	err := ParseRules(strings.NewReader("-w /etc/passwd -p wa -k identity\n-w /etc/group -p\n"), &Rules{})
+	assert.EqualError(t, err, "invalid audit rule in line 2: missing value for -p")
+
+	err = ParseRules(strings.NewReader("-a always -S open\n"), &Rules{})
+	assert.EqualError(t, err, "invalid audit rule in line 1: invalid action and list always")
+
+	err = ParseRules(strings.NewReader("-x foo\n"), &Rules{})
+	assert.EqualError(t, err, "invalid audit rule in line 1: unsupported option -x")
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package auditd
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// ParseConfig parses the auditd configuration, which has one key = value
+// entry per line
+func ParseConfig(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		res[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return res, scanner.Err()
+}
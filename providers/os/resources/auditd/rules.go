@@ -0,0 +1,205 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package auditd
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Control is a rule that configures the audit system, e.g. -e 2
+type Control struct {
+	Flag  string
+	Value string
+}
+
+// FileRule watches a file or directory, e.g. -w /etc/passwd -p wa -k identity
+type FileRule struct {
+	Path string
+	// Permissions that trigger the rule: r, w, x, and a for attribute changes
+	Permissions string
+	Keys        []string
+}
+
+// Field is a filter of a syscall rule, e.g. auid>=1000
+type Field struct {
+	Name  string
+	Op    string
+	Value string
+}
+
+// SyscallRule audits system calls, e.g. -a always,exit -S unlink -F auid>=1000
+type SyscallRule struct {
+	// Action is always or never
+	Action string
+	// List is the list the rule is added to, e.g. exit or task
+	List     string
+	Syscalls []string
+	// Fields are the filters of the rule, keys and permissions excluded
+	Fields []Field
+	// Permissions from the perm field, e.g. wa
+	Permissions string
+	Keys        []string
+}
+
+// Rules are the rules of one or more rule files, or of auditctl -l
+type Rules struct {
+	Controls []Control
+	Files    []FileRule
+	Syscalls []SyscallRule
+}
+
+var ruleActions = map[string]struct{}{
+	"always": {},
+	"never":  {},
+}
+
+// controlFlags are the options that take a value, without it they are
+// only a flag like -D
+var controlFlags = map[string]bool{
+	"-b":                   true,
+	"-f":                   true,
+	"-e":                   true,
+	"-r":                   true,
+	"-D":                   false,
+	"-i":                   false,
+	"-c":                   false,
+	"--backlog_wait_time":  true,
+	"--loginuid-immutable": false,
+	"--reset-lost":         false,
+}
+
+// ParseRules parses audit rules as they are written in rule files and
+// printed by auditctl -l. Rules are added to res, so that the rules of
+// multiple files can be combined.
+func ParseRules(r io.Reader, res *Rules) error {
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || line == "No rules" {
+			continue
+		}
+		if err := parseRule(strings.Fields(line), res); err != nil {
+			return errors.New("invalid audit rule in line " + strconv.Itoa(lineNumber) + ": " + err.Error())
+		}
+	}
+	return scanner.Err()
+}
+
+func parseRule(args []string, res *Rules) error {
+	switch args[0] {
+	case "-w":
+		return parseFileRule(args, res)
+	case "-a", "-A":
+		return parseSyscallRule(args, res)
+	}
+
+	hasValue, ok := controlFlags[args[0]]
+	if !ok {
+		return errors.New("unsupported option " + args[0])
+	}
+	control := Control{Flag: args[0]}
+	if hasValue {
+		if len(args) < 2 {
+			return errors.New("missing value for " + args[0])
+		}
+		control.Value = args[1]
+	}
+	res.Controls = append(res.Controls, control)
+	return nil
+}
+
+func parseFileRule(args []string, res *Rules) error {
+	rule := FileRule{}
+	for i := 0; i < len(args); i++ {
+		if i+1 >= len(args) {
+			return errors.New("missing value for " + args[i])
+		}
+		value := args[i+1]
+		switch args[i] {
+		case "-w":
+			rule.Path = value
+		case "-p":
+			rule.Permissions = value
+		case "-k":
+			rule.Keys = append(rule.Keys, value)
+		default:
+			return errors.New("unsupported option " + args[i] + " in file watch")
+		}
+		i++
+	}
+	res.Files = append(res.Files, rule)
+	return nil
+}
+
+func parseSyscallRule(args []string, res *Rules) error {
+	rule := SyscallRule{}
+	for i := 0; i < len(args); i++ {
+		if i+1 >= len(args) {
+			return errors.New("missing value for " + args[i])
+		}
+		value := args[i+1]
+		switch args[i] {
+		case "-a", "-A":
+			// the action and the list may be in either order
+			first, second, ok := strings.Cut(value, ",")
+			if !ok {
+				return errors.New("invalid action and list " + value)
+			}
+			if _, ok := ruleActions[first]; ok {
+				rule.Action, rule.List = first, second
+			} else {
+				rule.Action, rule.List = second, first
+			}
+		case "-S":
+			rule.Syscalls = append(rule.Syscalls, strings.Split(value, ",")...)
+		case "-F", "-C":
+			field, err := parseField(value)
+			if err != nil {
+				return err
+			}
+			switch field.Name {
+			case "key":
+				rule.Keys = append(rule.Keys, field.Value)
+			case "perm":
+				rule.Permissions = field.Value
+			default:
+				rule.Fields = append(rule.Fields, field)
+			}
+		case "-k":
+			rule.Keys = append(rule.Keys, value)
+		default:
+			return errors.New("unsupported option " + args[i] + " in syscall rule")
+		}
+		i++
+	}
+	res.Syscalls = append(res.Syscalls, rule)
+	return nil
+}
+
+// fieldOps are the comparison operators of fields, the ones with two
+// characters first
+var fieldOps = []string{"!=", ">=", "<=", "&=", "=", ">", "<", "&"}
+
+func parseField(s string) (Field, error) {
+	idx := strings.IndexAny(s, "=!<>&")
+	if idx <= 0 {
+		return Field{}, errors.New("invalid field " + s)
+	}
+	for _, op := range fieldOps {
+		if strings.HasPrefix(s[idx:], op) {
+			return Field{
+				Name:  s[:idx],
+				Op:    op,
+				Value: s[idx+len(op):],
+			}, nil
+		}
+	}
+	return Field{}, errors.New("invalid field " + s)
+}
@@ -4,6 +4,8 @@
 package resources
 
 import (
+	"strconv"
+
 	"github.com/google/go-containerregistry/pkg/name"
 	"go.mondoo.com/cnquery/v11/llx"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
@@ -81,3 +83,51 @@ func newLumiContainerRepository(runtime *plugin.Runtime, repo name.Repository) (
 func (k *mqlContainerRepository) id() (string, error) {
 	return k.FullName.Data, nil
 }
+
+func (k *mqlContainerImage) layers() ([]interface{}, error) {
+	// layers are only known for the image that is scanned
+	conn, ok := k.MqlRuntime.Connection.(*tar.Connection)
+	if !ok || conn.Metadata.Labels["docker.io/digests"] != k.Reference.Data {
+		k.Layers.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	layers := conn.ImageLayers()
+	res := make([]interface{}, 0, len(layers))
+	for i := range layers {
+		layer, err := newMqlContainerImageLayer(k.MqlRuntime, layers[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, layer)
+	}
+	return res, nil
+}
+
+func (k *mqlContainerImageLayer) id() (string, error) {
+	return strconv.FormatInt(k.Index.Data, 10) + "/" + k.Digest.Data, nil
+}
+
+// newMqlContainerImageLayer creates the resource for a layer, it returns nil
+// if there is no layer
+func newMqlContainerImageLayer(runtime *plugin.Runtime, layer *tar.Layer) (*mqlContainerImageLayer, error) {
+	if layer == nil {
+		return nil, nil
+	}
+
+	created := llx.NilData
+	if !layer.Created.IsZero() {
+		created = llx.TimeData(layer.Created)
+	}
+
+	res, err := CreateResource(runtime, "container.image.layer", map[string]*llx.RawData{
+		"index":     llx.IntData(layer.Index),
+		"digest":    llx.StringData(layer.Digest),
+		"createdBy": llx.StringData(layer.CreatedBy),
+		"created":   created,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlContainerImageLayer), nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/cron"
+)
+
+const (
+	defaultCrontab    = "/etc/crontab"
+	defaultCronDir    = "/etc/cron.d"
+	defaultAnacrontab = "/etc/anacrontab"
+)
+
+// cronUserSpools are the directories that hold the crontabs of users on
+// Debian, RHEL, SUSE, BSD, and macOS
+var cronUserSpools = []string{
+	"/var/spool/cron/crontabs",
+	"/var/spool/cron",
+	"/var/spool/cron/tabs",
+	"/var/cron/tabs",
+	"/usr/lib/cron/tabs",
+}
+
+func (c *mqlCron) id() (string, error) {
+	return "cron", nil
+}
+
+func (c *mqlCron) list() ([]any, error) {
+	conn := c.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	res := []any{}
+	add := func(path string, typ string, user string) error {
+		entries, err := c.parseCrontab(path, typ, user)
+		if err != nil {
+			return err
+		}
+		res = append(res, entries...)
+		return nil
+	}
+
+	if err := add(defaultCrontab, cron.TypeSystem, ""); err != nil {
+		return nil, err
+	}
+
+	files, err := cronDirFiles(afs, defaultCronDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range files {
+		if err := add(path.Join(defaultCronDir, name), cron.TypeSystem, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// the files in the spools are named after their users
+	for _, spool := range cronUserSpools {
+		files, err := cronDirFiles(afs, spool)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range files {
+			if err := add(path.Join(spool, name), cron.TypeUser, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := add(defaultAnacrontab, cron.TypeAnacron, ""); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// cronDirFiles returns the files in the directory, which are read by cron. It
+// skips hidden files and backups of editors and package managers.
+func cronDirFiles(afs *afero.Afero, dir string) ([]string, error) {
+	entries, err := afs.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") ||
+			strings.Contains(name, ".dpkg-") || strings.HasSuffix(name, ".rpmsave") ||
+			strings.HasSuffix(name, ".rpmorig") || strings.HasSuffix(name, ".rpmnew") {
+			continue
+		}
+		res = append(res, name)
+	}
+	return res, nil
+}
+
+func (c *mqlCron) parseCrontab(path string, typ string, user string) ([]any, error) {
+	raw, err := CreateResource(c.MqlRuntime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	file := raw.(*mqlFile)
+
+	exists := file.GetExists()
+	if exists.Error != nil {
+		return nil, exists.Error
+	}
+	if !exists.Data {
+		return nil, nil
+	}
+
+	content := file.GetContent()
+	if content.Error != nil {
+		return nil, content.Error
+	}
+
+	var entries []*cron.Entry
+	if typ == cron.TypeAnacron {
+		entries, err = cron.ParseAnacrontab(strings.NewReader(content.Data))
+	} else {
+		entries, err = cron.ParseCrontab(strings.NewReader(content.Data), typ, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(entries))
+	for i, entry := range entries {
+		obj, err := CreateResource(c.MqlRuntime, "cron.entry", map[string]*llx.RawData{
+			"__id":       llx.StringData(path + ":" + strconv.Itoa(entry.LineNumber)),
+			"schedule":   llx.StringData(entry.Schedule),
+			"user":       llx.StringData(entry.User),
+			"command":    llx.StringData(entry.Command),
+			"type":       llx.StringData(typ),
+			"file":       llx.ResourceData(file, "file"),
+			"lineNumber": llx.IntData(entry.LineNumber),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
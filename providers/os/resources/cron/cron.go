@@ -0,0 +1,120 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package cron parses the scheduled jobs of cron and anacron
+package cron
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// TypeSystem are crontabs with a user field, i.e. /etc/crontab and /etc/cron.d
+	TypeSystem = "system"
+	// TypeUser are the crontabs of users, which are managed with crontab -e
+	TypeUser = "user"
+	// TypeAnacron are jobs in the anacrontab
+	TypeAnacron = "anacron"
+)
+
+// Entry is a scheduled job
+type Entry struct {
+	// Schedule of the job, e.g. "*/5 * * * *" or "@reboot". For anacron jobs
+	// it is the period in days or @daily, @weekly, or @monthly.
+	Schedule string
+	// User the job runs as
+	User       string
+	Command    string
+	LineNumber int
+}
+
+var reEnvironment = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*=`)
+
+// ParseCrontab parses a crontab. System crontabs have a user field after the
+// schedule, the jobs of user crontabs run as the given user.
+func ParseCrontab(r io.Reader, typ string, user string) ([]*Entry, error) {
+	res := []*Entry{}
+	err := scanLines(r, func(line string, lineNumber int) {
+		var schedule, rest string
+		if strings.HasPrefix(line, "@") {
+			schedule, rest = cutField(line)
+		} else {
+			fields := []string{}
+			rest = line
+			for i := 0; i < 5 && rest != ""; i++ {
+				var field string
+				field, rest = cutField(rest)
+				fields = append(fields, field)
+			}
+			if len(fields) < 5 {
+				return
+			}
+			schedule = strings.Join(fields, " ")
+		}
+
+		entry := &Entry{
+			Schedule:   schedule,
+			User:       user,
+			LineNumber: lineNumber,
+		}
+		if typ == TypeSystem {
+			entry.User, rest = cutField(rest)
+		}
+		entry.Command = rest
+		if entry.Command == "" {
+			return
+		}
+		res = append(res, entry)
+	})
+	return res, err
+}
+
+// ParseAnacrontab parses an anacrontab, its jobs are run as root:
+// period delay job-identifier command
+func ParseAnacrontab(r io.Reader) ([]*Entry, error) {
+	res := []*Entry{}
+	err := scanLines(r, func(line string, lineNumber int) {
+		period, rest := cutField(line)
+		_, rest = cutField(rest)
+		_, command := cutField(rest)
+		if command == "" {
+			return
+		}
+		res = append(res, &Entry{
+			Schedule:   period,
+			User:       "root",
+			Command:    command,
+			LineNumber: lineNumber,
+		})
+	})
+	return res, err
+}
+
+// scanLines calls fn for every line that is not empty, a comment, or an
+// environment variable
+func scanLines(r io.Reader, fn func(line string, lineNumber int)) error {
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || reEnvironment.MatchString(line) {
+			continue
+		}
+		fn(line, lineNumber)
+	}
+	return scanner.Err()
+}
+
+// cutField returns the first whitespace separated field and the trimmed rest
+func cutField(s string) (string, string) {
+	idx := strings.IndexFunc(s, unicode.IsSpace)
+	if idx < 0 {
+		return s, ""
+	}
+	return s[:idx], strings.TrimSpace(s[idx:])
+}
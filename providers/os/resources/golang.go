@@ -0,0 +1,279 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"cmp"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/golang"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+// golangDefaultPaths are the locations we search for go binaries if no path
+// is provided
+var golangDefaultPaths = []string{
+	"/app",
+	"/bin",
+	"/go/bin",
+	"/home",
+	"/opt",
+	"/root/go/bin",
+	"/sbin",
+	"/srv",
+	"/usr/bin",
+	"/usr/libexec",
+	"/usr/local/bin",
+	"/usr/local/go/bin",
+	"/usr/local/sbin",
+	"/usr/sbin",
+}
+
+func initGolangBinaries(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in golang.binaries initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (r *mqlGolangBinaries) id() (string, error) {
+	path := r.Path.Data
+	if path == "" {
+		return "golang.binaries", nil
+	}
+
+	return "golang.binaries/" + path, nil
+}
+
+type mqlGolangBinariesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlGolangBinaries) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlGolangBinaries) packages() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+// isGolangCandidate filters files that may be executables, to avoid reading
+// the header of every file
+func isGolangCandidate(path string, info os.FileInfo) bool {
+	if !info.Mode().IsRegular() || info.Size() < 4 {
+		return false
+	}
+	return info.Mode().Perm()&0o111 != 0 || strings.HasSuffix(strings.ToLower(path), ".exe")
+}
+
+// readGolangBinary returns the build information of a go binary, or nil if
+// the file is not a go binary
+func readGolangBinary(afs *afero.Afero, path string) *golang.Binary {
+	f, err := afs.Open(path)
+	if err != nil {
+		log.Debug().Err(err).Str("path", path).Msg("could not open file")
+		return nil
+	}
+	defer f.Close()
+
+	header := make([]byte, 4)
+	if _, err := f.ReadAt(header, 0); err != nil || !golang.IsExecutable(header) {
+		return nil
+	}
+
+	bin, err := golang.ReadBinary(f, path)
+	if err != nil {
+		// most executables are not built with go
+		return nil
+	}
+	return bin
+}
+
+var golangSearch = &fileSearch{
+	roots: golangDefaultPaths,
+	match: func(afs *afero.Afero, path string, info os.FileInfo) bool {
+		return isGolangCandidate(path, info)
+	},
+}
+
+// readGolangBinaries returns the go binaries among the given files
+func readGolangBinaries(afs *afero.Afero, paths []string) []*golang.Binary {
+	res := []*golang.Binary{}
+	for _, path := range paths {
+		if bin := readGolangBinary(afs, path); bin != nil {
+			res = append(res, bin)
+		}
+	}
+	return res
+}
+
+func (r *mqlGolangBinaries) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	conn := r.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	var binaries []*golang.Binary
+	if path := r.Path.Data; path != "" {
+		isDir, err := afs.IsDir(path)
+		if err != nil {
+			return err
+		}
+		if isDir {
+			binaries = readGolangBinaries(afs, searchFiles(afs, golangSearch.in(path))[0])
+		} else {
+			bin := readGolangBinary(afs, path)
+			if bin == nil {
+				return errors.New("path " + path + " is not a go binary")
+			}
+			binaries = []*golang.Binary{bin}
+		}
+	} else {
+		paths, err := defaultSearchFiles(r.MqlRuntime, golangSearch)
+		if err != nil {
+			return err
+		}
+		binaries = readGolangBinaries(afs, paths)
+	}
+	slices.SortFunc(binaries, func(a, b *golang.Binary) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	// modules are shared between binaries, each package lists all binaries
+	// that it is compiled into
+	modules := map[string]*golang.Module{}
+	locations := map[string][]string{}
+	for _, bin := range binaries {
+		for _, mod := range bin.Modules() {
+			if _, ok := modules[mod.Purl]; !ok {
+				modules[mod.Purl] = mod
+			}
+			if !slices.Contains(locations[mod.Purl], bin.Path) {
+				locations[mod.Purl] = append(locations[mod.Purl], bin.Path)
+			}
+		}
+	}
+
+	mqlPkgs := map[string]*mqlGolangPackage{}
+	for purl, mod := range modules {
+		mqlPkg, err := newGolangPackage(r.MqlRuntime, mod, locations[purl])
+		if err != nil {
+			return err
+		}
+		mqlPkgs[purl] = mqlPkg
+	}
+
+	sortedPurls := make([]string, 0, len(mqlPkgs))
+	for purl := range mqlPkgs {
+		sortedPurls = append(sortedPurls, purl)
+	}
+	slices.Sort(sortedPurls)
+	packages := make([]interface{}, len(sortedPurls))
+	for i := range sortedPurls {
+		packages[i] = mqlPkgs[sortedPurls[i]]
+	}
+	r.Packages = plugin.TValue[[]interface{}]{Data: packages, State: plugin.StateIsSet}
+
+	resources := make([]interface{}, 0, len(binaries))
+	for _, bin := range binaries {
+		mqlBin, err := newGolangBinary(r.MqlRuntime, bin, mqlPkgs)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, mqlBin)
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: resources, State: plugin.StateIsSet}
+
+	return nil
+}
+
+func newGolangBinary(runtime *plugin.Runtime, bin *golang.Binary, mqlPkgs map[string]*mqlGolangPackage) (*mqlGolangBinary, error) {
+	deps := make([]interface{}, 0, len(bin.Deps))
+	for i := range bin.Deps {
+		deps = append(deps, mqlPkgs[bin.Deps[i].Purl])
+	}
+
+	mainModule := llx.NilData
+	if bin.Main != nil {
+		mainModule = llx.ResourceData(mqlPkgs[bin.Main.Purl], "golang.package")
+	}
+
+	settings := make(map[string]interface{}, len(bin.Settings))
+	for k, v := range bin.Settings {
+		settings[k] = v
+	}
+
+	mqlBin, err := CreateResource(runtime, "golang.binary", map[string]*llx.RawData{
+		"__id":         llx.StringData(bin.Path),
+		"path":         llx.StringData(bin.Path),
+		"goVersion":    llx.StringData(bin.GoVersion),
+		"mainModule":   mainModule,
+		"dependencies": llx.ArrayData(deps, types.Resource("golang.package")),
+		"settings":     llx.MapData(settings, types.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mqlBin.(*mqlGolangBinary), nil
+}
+
+func newGolangPackage(runtime *plugin.Runtime, mod *golang.Module, locations []string) (*mqlGolangPackage, error) {
+	cpes := []interface{}{}
+	for i := range mod.Cpes {
+		cpe, err := runtime.CreateSharedResource("cpe", map[string]*llx.RawData{
+			"uri": llx.StringData(mod.Cpes[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cpes = append(cpes, cpe)
+	}
+
+	mqlFiles, err := newPkgFileInfos(runtime, locations)
+	if err != nil {
+		return nil, err
+	}
+
+	mqlPkg, err := CreateResource(runtime, "golang.package", map[string]*llx.RawData{
+		"id":      llx.StringData(mod.Purl),
+		"name":    llx.StringData(mod.Name),
+		"version": llx.StringData(mod.Version),
+		"purl":    llx.StringData(mod.Purl),
+		"cpes":    llx.ArrayData(cpes, types.Resource("cpe")),
+		"files":   llx.ArrayData(mqlFiles, types.Resource("pkgFileInfo")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mqlPkg.(*mqlGolangPackage), nil
+}
+
+func (r *mqlGolangPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
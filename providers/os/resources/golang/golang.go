@@ -0,0 +1,218 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package golang
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"io"
+	"runtime/debug"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/cpe"
+)
+
+// StdlibName is the name we use for the Go standard library, which is
+// compiled into every binary with the version of the toolchain
+const StdlibName = "stdlib"
+
+// develVersion is set for main modules that were not built from a tagged version
+const develVersion = "(devel)"
+
+type Binary struct {
+	Path      string
+	GoVersion string
+	Main      *Module
+	Deps      []*Module
+	Settings  map[string]string
+}
+
+type Module struct {
+	Name    string
+	Version string
+	Sum     string
+	Purl    string
+	Cpes    []string
+}
+
+// Modules returns all modules of the binary, including the main module and
+// the standard library
+func (b *Binary) Modules() []*Module {
+	res := []*Module{NewStdlib(b.GoVersion)}
+	if b.Main != nil {
+		res = append(res, b.Main)
+	}
+	return append(res, b.Deps...)
+}
+
+var (
+	elfMagic   = []byte("\x7fELF")
+	peMagic    = []byte("MZ")
+	machoMagic = [][]byte{
+		{0xfe, 0xed, 0xfa, 0xce},
+		{0xfe, 0xed, 0xfa, 0xcf},
+		{0xce, 0xfa, 0xed, 0xfe},
+		{0xcf, 0xfa, 0xed, 0xfe},
+	}
+)
+
+// IsExecutable checks the header of a file for ELF, PE and Mach-O magic bytes
+func IsExecutable(header []byte) bool {
+	if bytes.HasPrefix(header, elfMagic) || bytes.HasPrefix(header, peMagic) {
+		return true
+	}
+	for i := range machoMagic {
+		if bytes.HasPrefix(header, machoMagic[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadBinary reads the build information that the Go toolchain embeds into
+// every executable. It returns an error for binaries that were not built
+// with Go.
+func ReadBinary(r io.ReaderAt, path string) (*Binary, error) {
+	info, err := buildinfo.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Binary{
+		Path:      path,
+		GoVersion: info.GoVersion,
+		Settings:  map[string]string{},
+	}
+	for i := range info.Settings {
+		res.Settings[info.Settings[i].Key] = info.Settings[i].Value
+	}
+
+	if info.Main.Path != "" {
+		res.Main = newModule(&info.Main)
+	}
+	for i := range info.Deps {
+		res.Deps = append(res.Deps, newModule(info.Deps[i]))
+	}
+	return res, nil
+}
+
+func newModule(mod *debug.Module) *Module {
+	// replaced modules are built from the replacement
+	if mod.Replace != nil {
+		mod = mod.Replace
+	}
+
+	version := mod.Version
+	if version == develVersion {
+		version = ""
+	}
+
+	return &Module{
+		Name:    mod.Path,
+		Version: version,
+		Sum:     mod.Sum,
+		Purl:    NewPackageUrl(mod.Path, version),
+		Cpes:    NewCpes(mod.Path, version),
+	}
+}
+
+// NewStdlib returns the standard library module for the toolchain version,
+// e.g. go1.22.5
+func NewStdlib(goVersion string) *Module {
+	version := strings.TrimPrefix(goVersion, "go")
+	// toolchains may carry experiments, e.g. go1.22.5 X:nocoverageredesign
+	version, _, _ = strings.Cut(version, " ")
+
+	mod := &Module{
+		Name:    StdlibName,
+		Version: version,
+		Purl:    NewPackageUrl(StdlibName, version),
+		Cpes:    []string{},
+	}
+	cpeEntry, err := cpe.NewPackage2Cpe("golang", "go", version, "", "")
+	if err != nil {
+		log.Debug().Str("version", goVersion).Err(err).Msg("failed to create cpe")
+	} else {
+		mod.Cpes = append(mod.Cpes, cpeEntry)
+	}
+	return mod
+}
+
+// NewPackageUrl creates a golang package url for a given module
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#golang
+func NewPackageUrl(path string, version string) string {
+	namespace := ""
+	name := path
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		namespace = path[:i]
+		name = path[i+1:]
+	}
+
+	return packageurl.NewPackageURL(
+		packageurl.TypeGolang,
+		namespace,
+		name,
+		version,
+		nil,
+		"").String()
+}
+
+func NewCpes(path string, version string) []string {
+	cpes := []string{}
+	if version == "" {
+		return cpes
+	}
+
+	vendor, product := cpeVendorProduct(path)
+	cpeEntry, err := cpe.NewPackage2Cpe(vendor, product, strings.TrimPrefix(version, "v"), "", "")
+	// we only add the cpe if it could be created
+	// if the cpe could not be created, we log the error and continue to ensure the package is still added to the list
+	if err != nil {
+		log.Debug().Str("name", path).Str("version", version).Err(err).Msg("failed to create cpe")
+	} else if cpeEntry != "" {
+		cpes = append(cpes, cpeEntry)
+	}
+	return cpes
+}
+
+// cpeVendorProduct derives vendor and product from a module path, e.g.
+// github.com/opencontainers/runc is vendor opencontainers and product runc
+func cpeVendorProduct(path string) (string, string) {
+	parts := strings.Split(path, "/")
+	// major version suffixes are not part of the product name
+	if len(parts) > 1 && isMajorVersion(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+
+	host := parts[0]
+	switch {
+	case len(parts) >= 3 && (host == "github.com" || host == "gitlab.com" || host == "bitbucket.org"):
+		return parts[1], parts[2]
+	case len(parts) >= 3 && host == "golang.org" && parts[1] == "x":
+		return "golang", parts[2]
+	}
+
+	// use the domain name without its top-level domain as vendor, e.g.
+	// go.etcd.io/etcd has vendor etcd
+	labels := strings.Split(host, ".")
+	vendor := labels[0]
+	if len(labels) >= 2 {
+		vendor = labels[len(labels)-2]
+	}
+	return vendor, parts[len(parts)-1]
+}
+
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
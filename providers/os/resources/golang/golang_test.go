@@ -0,0 +1,74 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package golang
+
+import (
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBinary(t *testing.T) {
+	// the test binary itself is built with go and carries build info
+	path, err := os.Executable()
+	require.NoError(t, err)
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	header := make([]byte, 4)
+	_, err = f.ReadAt(header, 0)
+	require.NoError(t, err)
+	assert.True(t, IsExecutable(header))
+
+	bin, err := ReadBinary(f, path)
+	require.NoError(t, err)
+	assert.Equal(t, runtime.Version(), bin.GoVersion)
+	require.NotNil(t, bin.Main)
+	assert.Equal(t, "go.mondoo.com/cnquery/v11", bin.Main.Name)
+	assert.Equal(t, runtime.GOOS, bin.Settings["GOOS"])
+
+	var testify *Module
+	for _, dep := range bin.Deps {
+		if dep.Name == "github.com/stretchr/testify" {
+			testify = dep
+		}
+	}
+	require.NotNil(t, testify)
+	assert.True(t, strings.HasPrefix(testify.Purl, "pkg:golang/github.com/stretchr/testify@v"))
+	assert.True(t, strings.HasPrefix(testify.Cpes[0], "cpe:2.3:a:stretchr:testify:"))
+
+	stdlib := bin.Modules()[0]
+	assert.Equal(t, StdlibName, stdlib.Name)
+	assert.Equal(t, "pkg:golang/stdlib@"+stdlib.Version, stdlib.Purl)
+}
+
+func TestNotAGoBinary(t *testing.T) {
+	assert.False(t, IsExecutable([]byte("#!/bin/sh")))
+	_, err := ReadBinary(strings.NewReader("#!/bin/sh\necho hello\n"), "/usr/bin/hello")
+	assert.Error(t, err)
+}
+
+func TestModuleIdentifiers(t *testing.T) {
+	assert.Equal(t, "pkg:golang/golang.org/x/net@v0.23.0", NewPackageUrl("golang.org/x/net", "v0.23.0"))
+	assert.Equal(t, "pkg:golang/stdlib@1.22.5", NewStdlib("go1.22.5").Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:golang:go:1.22.5:*:*:*:*:*:*:*"}, NewStdlib("go1.22.5 X:nocoverageredesign").Cpes)
+
+	tests := map[string][2]string{
+		"github.com/opencontainers/runc": {"opencontainers", "runc"},
+		"golang.org/x/net":               {"golang", "net"},
+		"github.com/docker/docker/v25":   {"docker", "docker"},
+		"go.etcd.io/etcd/client/v3":      {"etcd", "client"},
+		"k8s.io/client-go":               {"k8s", "client-go"},
+	}
+	for path, expected := range tests {
+		vendor, product := cpeVendorProduct(path)
+		assert.Equal(t, expected[0], vendor, path)
+		assert.Equal(t, expected[1], product, path)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"cmp"
+	"errors"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/java"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+// javaDefaultPaths are the locations we search for java archives if no path
+// is provided. They cover common application server, container image and
+// distribution package layouts.
+var javaDefaultPaths = []string{
+	"/app",
+	"/deployments",
+	"/home",
+	"/opt",
+	"/root",
+	"/srv",
+	"/usr/lib",
+	"/usr/local",
+	"/usr/share",
+	"/var/lib",
+}
+
+var javaSearch = &fileSearch{
+	roots: javaDefaultPaths,
+	match: func(afs *afero.Afero, path string, info os.FileInfo) bool {
+		return java.IsArchive(path)
+	},
+}
+
+func initJavaPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in java.packages initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (r *mqlJavaPackages) id() (string, error) {
+	path := r.Path.Data
+	if path == "" {
+		return "java.packages", nil
+	}
+
+	return "java.packages/" + path, nil
+}
+
+type mqlJavaPackagesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlJavaPackages) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlJavaPackages) files() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func parseJavaArchive(afs *afero.Afero, path string) ([]*java.Package, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return java.ParseArchive(f, stat.Size(), path)
+}
+
+func (r *mqlJavaPackages) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	conn := r.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	var archives []string
+	if path := r.Path.Data; path != "" {
+		isDir, err := afs.IsDir(path)
+		if err != nil {
+			return err
+		}
+		if isDir {
+			archives = searchFiles(afs, javaSearch.in(path))[0]
+		} else if java.IsArchive(path) {
+			archives = []string{path}
+		} else {
+			return errors.New("path " + path + " is not a jar, war or ear file")
+		}
+	} else {
+		var err error
+		archives, err = defaultSearchFiles(r.MqlRuntime, javaSearch)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the same package may be found in multiple archives, we report it once
+	// with all locations
+	pkgs := map[string]*java.Package{}
+	locations := map[string][]string{}
+	for _, archive := range archives {
+		found, err := parseJavaArchive(afs, archive)
+		if err != nil {
+			log.Debug().Err(err).Str("path", archive).Msg("could not parse java archive")
+			continue
+		}
+		for i := range found {
+			pkg := found[i]
+			if _, ok := pkgs[pkg.Purl]; !ok {
+				pkgs[pkg.Purl] = pkg
+			}
+			if !slices.Contains(locations[pkg.Purl], pkg.Location) {
+				locations[pkg.Purl] = append(locations[pkg.Purl], pkg.Location)
+			}
+		}
+	}
+
+	sorted := make([]*java.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		sorted = append(sorted, pkg)
+	}
+	slices.SortFunc(sorted, func(a, b *java.Package) int {
+		if n := cmp.Compare(a.Name, b.Name); n != 0 {
+			return n
+		}
+		if n := cmp.Compare(a.GroupID, b.GroupID); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	resources := make([]interface{}, 0, len(sorted))
+	for i := range sorted {
+		mqlPkg, err := newJavaPackage(r.MqlRuntime, sorted[i], locations[sorted[i].Purl])
+		if err != nil {
+			return err
+		}
+		resources = append(resources, mqlPkg)
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: resources, State: plugin.StateIsSet}
+
+	mqlFiles, err := newPkgFileInfos(r.MqlRuntime, archives)
+	if err != nil {
+		return err
+	}
+	r.Files = plugin.TValue[[]interface{}]{Data: mqlFiles, State: plugin.StateIsSet}
+
+	return nil
+}
+
+func newPkgFileInfos(runtime *plugin.Runtime, paths []string) ([]interface{}, error) {
+	mqlFiles := make([]interface{}, 0, len(paths))
+	for i := range paths {
+		lf, err := CreateResource(runtime, "pkgFileInfo", map[string]*llx.RawData{
+			"path": llx.StringData(paths[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		mqlFiles = append(mqlFiles, lf)
+	}
+	return mqlFiles, nil
+}
+
+func newJavaPackage(runtime *plugin.Runtime, pkg *java.Package, locations []string) (*mqlJavaPackage, error) {
+	cpes := []interface{}{}
+	for i := range pkg.Cpes {
+		cpe, err := runtime.CreateSharedResource("cpe", map[string]*llx.RawData{
+			"uri": llx.StringData(pkg.Cpes[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cpes = append(cpes, cpe)
+	}
+
+	mqlFiles, err := newPkgFileInfos(runtime, locations)
+	if err != nil {
+		return nil, err
+	}
+
+	mqlPkg, err := CreateResource(runtime, "java.package", map[string]*llx.RawData{
+		"id":      llx.StringData(pkg.Purl),
+		"name":    llx.StringData(pkg.Name),
+		"groupId": llx.StringData(pkg.GroupID),
+		"version": llx.StringData(pkg.Version),
+		"purl":    llx.StringData(pkg.Purl),
+		"cpes":    llx.ArrayData(cpes, types.Resource("cpe")),
+		"files":   llx.ArrayData(mqlFiles, types.Resource("pkgFileInfo")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mqlPkg.(*mqlJavaPackage), nil
+}
+
+func (r *mqlJavaPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
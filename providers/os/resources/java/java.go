@@ -0,0 +1,285 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package java
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/cpe"
+)
+
+const (
+	// NestedArchiveSeparator separates the path of an archive from the path of
+	// an entry within it, e.g. /app/app.war!/WEB-INF/lib/log4j-core-2.17.1.jar
+	NestedArchiveSeparator = "!/"
+
+	// maxNestingDepth limits how deep we descend into archives within archives
+	maxNestingDepth = 4
+	// maxNestedArchiveSize limits the size of nested archives, which need to be
+	// loaded into memory to be read
+	maxNestedArchiveSize = 256 * 1024 * 1024
+)
+
+type Package struct {
+	Name    string
+	GroupID string
+	Version string
+	Purl    string
+	Cpes    []string
+	// Location of the archive that contains the package
+	Location string
+}
+
+// IsArchive returns true for file names of Java archives
+func IsArchive(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".jar", ".war", ".ear":
+		return true
+	}
+	return false
+}
+
+// ParseArchive reads all packages from a Java archive. It reads Maven's
+// pom.properties files, falls back to the META-INF/MANIFEST.MF and the
+// file name, and descends into nested archives, e.g. the libraries of
+// WAR files or Spring Boot fat jars.
+func ParseArchive(r io.ReaderAt, size int64, location string) ([]*Package, error) {
+	return parseArchive(r, size, location, 0)
+}
+
+func parseArchive(r io.ReaderAt, size int64, location string, depth int) ([]*Package, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("failed to open java archive " + location + ": " + err.Error())
+	}
+
+	pkgs := []*Package{}
+	var manifest map[string]string
+	for _, f := range zr.File {
+		switch {
+		case strings.HasPrefix(f.Name, "META-INF/maven/") && path.Base(f.Name) == "pom.properties":
+			props, err := readZipFile(f, parseProperties)
+			if err != nil {
+				log.Debug().Err(err).Str("file", location+NestedArchiveSeparator+f.Name).Msg("could not read pom.properties")
+				continue
+			}
+			if pkg := newPackage(props["groupId"], props["artifactId"], props["version"], location); pkg != nil {
+				pkgs = append(pkgs, pkg)
+			}
+
+		case f.Name == "META-INF/MANIFEST.MF":
+			manifest, err = readZipFile(f, ParseManifest)
+			if err != nil {
+				log.Debug().Err(err).Str("file", location+NestedArchiveSeparator+f.Name).Msg("could not read MANIFEST.MF")
+			}
+
+		case IsArchive(f.Name) && !f.FileInfo().IsDir():
+			if depth >= maxNestingDepth {
+				log.Debug().Str("file", location+NestedArchiveSeparator+f.Name).Msg("skip java archive, nesting is too deep")
+				continue
+			}
+			if f.UncompressedSize64 > maxNestedArchiveSize {
+				log.Debug().Str("file", location+NestedArchiveSeparator+f.Name).Msg("skip java archive, it is too large")
+				continue
+			}
+			data, err := readZipFile(f, io.ReadAll)
+			if err != nil {
+				log.Debug().Err(err).Str("file", location+NestedArchiveSeparator+f.Name).Msg("could not read nested java archive")
+				continue
+			}
+			nested, err := parseArchive(bytes.NewReader(data), int64(len(data)), location+NestedArchiveSeparator+f.Name, depth+1)
+			if err != nil {
+				log.Debug().Err(err).Msg("could not parse nested java archive")
+				continue
+			}
+			pkgs = append(pkgs, nested...)
+		}
+	}
+
+	// archives without maven metadata are identified by their manifest or name
+	if !hasOwnPackage(pkgs, location) {
+		if pkg := packageFromManifest(manifest, location); pkg != nil {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+
+	return pkgs, nil
+}
+
+func hasOwnPackage(pkgs []*Package, location string) bool {
+	for i := range pkgs {
+		if pkgs[i].Location == location {
+			return true
+		}
+	}
+	return false
+}
+
+func readZipFile[T any](f *zip.File, parse func(io.Reader) (T, error)) (T, error) {
+	rc, err := f.Open()
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	defer rc.Close()
+	return parse(io.LimitReader(rc, maxNestedArchiveSize))
+}
+
+// fileNameVersion splits file names like commons-lang3-3.12.0.jar into name
+// and version
+var fileNameVersion = regexp.MustCompile(`^(.+?)-(\d[\w.\-+]*)$`)
+
+func packageFromManifest(manifest map[string]string, location string) *Package {
+	name := firstValue(manifest, "Implementation-Title", "Bundle-Name", "Specification-Title")
+	version := firstValue(manifest, "Implementation-Version", "Bundle-Version", "Specification-Version")
+	groupID := firstValue(manifest, "Implementation-Vendor-Id")
+
+	// the bundle symbolic name usually is the fully qualified name, e.g.
+	// org.apache.commons.commons-io
+	if symbolicName := firstValue(manifest, "Bundle-SymbolicName"); symbolicName != "" && groupID == "" {
+		symbolicName, _, _ = strings.Cut(symbolicName, ";")
+		if i := strings.LastIndex(symbolicName, "."); i > 0 {
+			groupID = symbolicName[:i]
+		}
+	}
+
+	fileName := strings.TrimSuffix(path.Base(location), path.Ext(location))
+	if m := fileNameVersion.FindStringSubmatch(fileName); m != nil {
+		// the file name is the most reliable source for the artifact id
+		name = m[1]
+		if version == "" {
+			version = m[2]
+		}
+	} else if name == "" || strings.Contains(name, " ") {
+		name = fileName
+	}
+
+	return newPackage(groupID, name, version, location)
+}
+
+func firstValue(m map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if v := strings.TrimSpace(m[key]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func newPackage(groupID string, artifactID string, version string, location string) *Package {
+	groupID = strings.TrimSpace(groupID)
+	artifactID = strings.TrimSpace(artifactID)
+	version = strings.TrimSpace(version)
+	if artifactID == "" {
+		return nil
+	}
+
+	return &Package{
+		Name:     artifactID,
+		GroupID:  groupID,
+		Version:  version,
+		Purl:     NewPackageUrl(groupID, artifactID, version),
+		Cpes:     NewCpes(groupID, artifactID, version),
+		Location: location,
+	}
+}
+
+// NewPackageUrl creates a maven package url for a given package
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#maven
+func NewPackageUrl(groupID string, artifactID string, version string) string {
+	return packageurl.NewPackageURL(
+		packageurl.TypeMaven,
+		groupID,
+		artifactID,
+		version,
+		nil,
+		"").String()
+}
+
+func NewCpes(groupID string, artifactID string, version string) []string {
+	cpes := []string{}
+	if version == "" {
+		return cpes
+	}
+
+	cpeEntry, err := cpe.NewPackage2Cpe(vendorFromGroupID(groupID, artifactID), artifactID, version, "", "")
+	// we only add the cpe if it could be created
+	// if the cpe could not be created, we log the error and continue to ensure the package is still added to the list
+	if err != nil {
+		log.Debug().Str("name", artifactID).Str("version", version).Err(err).Msg("failed to create cpe")
+	} else if cpeEntry != "" {
+		cpes = append(cpes, cpeEntry)
+	}
+	return cpes
+}
+
+// vendorFromGroupID derives the vendor from the group id, which by
+// convention is a reversed domain name, e.g. org.apache.logging.log4j
+func vendorFromGroupID(groupID string, artifactID string) string {
+	parts := strings.Split(groupID, ".")
+	switch {
+	case len(parts) >= 2 && parts[1] != "":
+		return parts[1]
+	case parts[0] != "":
+		return parts[0]
+	default:
+		return artifactID
+	}
+}
+
+// parseProperties parses Java properties files like pom.properties
+func parseProperties(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		idx := strings.IndexAny(line, "=:")
+		if idx < 0 {
+			continue
+		}
+		res[strings.TrimSpace(line[:idx])] = strings.TrimSpace(line[idx+1:])
+	}
+	return res, scanner.Err()
+}
+
+// ParseManifest parses the main section of a META-INF/MANIFEST.MF file
+// see https://docs.oracle.com/en/java/javase/21/docs/specs/jar/jar.html#jar-manifest
+func ParseManifest(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	lastKey := ""
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			// only the main section describes the archive itself
+			break
+		}
+		// continuation lines start with a single space
+		if line[0] == ' ' {
+			if lastKey != "" {
+				res[lastKey] += line[1:]
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		lastKey = strings.TrimSpace(key)
+		res[lastKey] = strings.TrimSpace(value)
+	}
+	return res, scanner.Err()
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package java
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newArchive(t *testing.T, files map[string][]byte) []byte {
+	buf := bytes.Buffer{}
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestParseArchive(t *testing.T) {
+	log4j := newArchive(t, map[string][]byte{
+		"META-INF/MANIFEST.MF": []byte("Manifest-Version: 1.0\nImplementation-Title: Apache Log4j Core\n"),
+		"META-INF/maven/org.apache.logging.log4j/log4j-core/pom.properties": []byte(
+			"#Created by Apache Maven 3.6.3\nversion=2.14.1\ngroupId=org.apache.logging.log4j\nartifactId=log4j-core\n"),
+	})
+	// no maven metadata, only a manifest
+	commons := newArchive(t, map[string][]byte{
+		"META-INF/MANIFEST.MF": []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: org.apache.commons.commons-\r\n lang3\r\nBundle-Version: 3.12.0\r\n\r\nName: org/apache/\r\n"),
+	})
+	war := newArchive(t, map[string][]byte{
+		"WEB-INF/lib/log4j-core-2.14.1.jar": log4j,
+		"WEB-INF/lib/commons-lang3.jar":     commons,
+		"WEB-INF/web.xml":                   []byte("<web-app/>"),
+	})
+
+	pkgs, err := ParseArchive(bytes.NewReader(war), int64(len(war)), "/opt/app/shop-1.0.war")
+	require.NoError(t, err)
+	require.Len(t, pkgs, 3)
+
+	byName := map[string]*Package{}
+	for i := range pkgs {
+		byName[pkgs[i].Name] = pkgs[i]
+	}
+
+	p := byName["log4j-core"]
+	require.NotNil(t, p)
+	assert.Equal(t, "org.apache.logging.log4j", p.GroupID)
+	assert.Equal(t, "2.14.1", p.Version)
+	assert.Equal(t, "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", p.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:apache:log4j-core:2.14.1:*:*:*:*:*:*:*"}, p.Cpes)
+	assert.Equal(t, "/opt/app/shop-1.0.war!/WEB-INF/lib/log4j-core-2.14.1.jar", p.Location)
+
+	p = byName["commons-lang3"]
+	require.NotNil(t, p)
+	assert.Equal(t, "org.apache.commons", p.GroupID)
+	assert.Equal(t, "3.12.0", p.Version)
+	assert.Equal(t, "pkg:maven/org.apache.commons/commons-lang3@3.12.0", p.Purl)
+
+	// the war itself is identified by its file name
+	p = byName["shop"]
+	require.NotNil(t, p)
+	assert.Equal(t, "1.0", p.Version)
+	assert.Equal(t, "/opt/app/shop-1.0.war", p.Location)
+}
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest(strings.NewReader("Manifest-Version: 1.0\nImplementation-Title: a very long\n  title\n\nName: section\nImplementation-Title: ignored\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "a very long title", m["Implementation-Title"])
+	assert.Equal(t, "1.0", m["Manifest-Version"])
+}
+
+func TestIsArchive(t *testing.T) {
+	assert.True(t, IsArchive("/opt/app.JAR"))
+	assert.True(t, IsArchive("app.ear"))
+	assert.False(t, IsArchive("app.zip"))
+}
@@ -0,0 +1,454 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"cmp"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/languages"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+// languageDefaultPaths are the locations we search for lock files if no path
+// is provided
+var languageDefaultPaths = []string{
+	"/app",
+	"/home",
+	"/opt",
+	"/root",
+	"/srv",
+	"/usr/src",
+}
+
+// languageSkipDirNames are directories that contain the sources of
+// dependencies, which ship lock files of their own that are not installed
+var languageSkipDirNames = map[string]struct{}{
+	".cargo":       {},
+	".git":         {},
+	"node_modules": {},
+}
+
+// languageSearch describes how the packages of a language ecosystem are found
+type languageSearch struct {
+	// resource is the name of the package resource, e.g. rust.package
+	resource string
+	// description is used in errors for paths that cannot be parsed
+	description string
+	isCandidate func(path string) bool
+	files       *fileSearch
+	parse       languages.Parser
+}
+
+// newLanguageFileSearch searches the lock files of a language below
+// languageDefaultPaths and the given paths
+func newLanguageFileSearch(isCandidate func(path string) bool, defaultPaths ...string) *fileSearch {
+	return &fileSearch{
+		roots:        append(slices.Clone(languageDefaultPaths), defaultPaths...),
+		skipDirNames: languageSkipDirNames,
+		match: func(afs *afero.Afero, path string, info os.FileInfo) bool {
+			return isCandidate(path)
+		},
+	}
+}
+
+var (
+	rustSearch = languageSearch{
+		resource:    "rust.package",
+		description: "a Cargo.lock file",
+		isCandidate: isCargoLock,
+		files:       newLanguageFileSearch(isCargoLock),
+		parse:       languages.ParseCargoLock,
+	}
+	rubySearch = languageSearch{
+		resource:    "ruby.package",
+		description: "a Gemfile.lock or gemspec file",
+		isCandidate: isRubyCandidate,
+		files: newLanguageFileSearch(isRubyCandidate,
+			"/usr/lib/ruby",
+			"/usr/lib64/ruby",
+			"/usr/local/bundle",
+			"/usr/local/lib/ruby",
+			"/usr/share/gems",
+			"/var/lib/gems",
+		),
+		parse: func(r io.Reader, filename string) ([]*languages.Package, error) {
+			if strings.HasSuffix(filename, languages.GemspecExtension) {
+				return languages.ParseGemspec(r, filename)
+			}
+			return languages.ParseGemfileLock(r, filename)
+		},
+	}
+	phpSearch = languageSearch{
+		resource:    "php.package",
+		description: "a composer.lock file",
+		isCandidate: isComposerLock,
+		files:       newLanguageFileSearch(isComposerLock, "/var/www"),
+		parse:       languages.ParseComposerLock,
+	}
+	dotnetSearch = languageSearch{
+		resource:    "dotnet.package",
+		description: "a .deps.json file",
+		isCandidate: isDepsJson,
+		files:       newLanguageFileSearch(isDepsJson, "/usr/lib/dotnet", "/usr/share/dotnet"),
+		parse:       languages.ParseDepsJson,
+	}
+)
+
+func isCargoLock(path string) bool {
+	return filepath.Base(path) == languages.CargoLockFile
+}
+
+func isComposerLock(path string) bool {
+	return filepath.Base(path) == languages.ComposerLockFile
+}
+
+func isDepsJson(path string) bool {
+	return strings.HasSuffix(path, languages.DepsJsonSuffix)
+}
+
+// isRubyCandidate matches Gemfile.lock files and the gem specifications of
+// installed gems, which rubygems stores in specifications directories. Other
+// gemspec files are part of the gem sources and not installed gems.
+func isRubyCandidate(path string) bool {
+	if filepath.Base(path) == languages.GemfileLockFile {
+		return true
+	}
+	if !strings.HasSuffix(path, languages.GemspecExtension) {
+		return false
+	}
+	dir := filepath.Dir(path)
+	// default gems are in specifications/default
+	if filepath.Base(dir) == "default" {
+		dir = filepath.Dir(dir)
+	}
+	return filepath.Base(dir) == "specifications"
+}
+
+func (s languageSearch) parseFile(afs *afero.Afero, path string) ([]*languages.Package, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return s.parse(f, path)
+}
+
+// gather searches for packages and returns the package and file resources
+func (s languageSearch) gather(runtime *plugin.Runtime, path string) ([]interface{}, []interface{}, error) {
+	conn := runtime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	var files []string
+	if path != "" {
+		isDir, err := afs.IsDir(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isDir {
+			files = searchFiles(afs, s.files.in(path))[0]
+		} else if s.isCandidate(path) {
+			files = []string{path}
+		} else {
+			return nil, nil, errors.New("path " + path + " is not " + s.description)
+		}
+	} else {
+		var err error
+		files, err = defaultSearchFiles(runtime, s.files)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the same package may be listed in multiple files, we report it once
+	// with all locations
+	pkgs := map[string]*languages.Package{}
+	for _, file := range files {
+		found, err := s.parseFile(afs, file)
+		if err != nil {
+			log.Debug().Err(err).Str("path", file).Msg("could not parse " + s.resource)
+			continue
+		}
+		for i := range found {
+			pkg := found[i]
+			existing, ok := pkgs[pkg.Purl]
+			if !ok {
+				pkgs[pkg.Purl] = pkg
+				continue
+			}
+			for _, location := range pkg.EvidenceLocations {
+				if !slices.Contains(existing.EvidenceLocations, location) {
+					existing.EvidenceLocations = append(existing.EvidenceLocations, location)
+				}
+			}
+		}
+	}
+
+	sorted := make([]*languages.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		sorted = append(sorted, pkg)
+	}
+	slices.SortFunc(sorted, func(a, b *languages.Package) int {
+		if n := cmp.Compare(a.Name, b.Name); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Purl, b.Purl)
+	})
+
+	resources := make([]interface{}, 0, len(sorted))
+	for i := range sorted {
+		mqlPkg, err := newLanguagePackage(runtime, s.resource, sorted[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, mqlPkg)
+	}
+
+	mqlFiles, err := newPkgFileInfos(runtime, files)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resources, mqlFiles, nil
+}
+
+func newLanguagePackage(runtime *plugin.Runtime, resource string, pkg *languages.Package) (plugin.Resource, error) {
+	cpes := []interface{}{}
+	for i := range pkg.Cpes {
+		cpe, err := runtime.CreateSharedResource("cpe", map[string]*llx.RawData{
+			"uri": llx.StringData(pkg.Cpes[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cpes = append(cpes, cpe)
+	}
+
+	mqlFiles, err := newPkgFileInfos(runtime, pkg.EvidenceLocations)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateResource(runtime, resource, map[string]*llx.RawData{
+		"id":      llx.StringData(pkg.Purl),
+		"name":    llx.StringData(pkg.Name),
+		"version": llx.StringData(pkg.Version),
+		"purl":    llx.StringData(pkg.Purl),
+		"cpes":    llx.ArrayData(cpes, types.Resource("cpe")),
+		"files":   llx.ArrayData(mqlFiles, types.Resource("pkgFileInfo")),
+	})
+}
+
+// languagePackagesArgs validates the optional path argument of the
+// language package resources
+func languagePackagesArgs(resource string, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		_, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in " + resource + " initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func languagePackagesId(resource string, path string) string {
+	if path == "" {
+		return resource
+	}
+	return resource + "/" + path
+}
+
+func initRustPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return languagePackagesArgs("rust.packages", args)
+}
+
+func (r *mqlRustPackages) id() (string, error) {
+	return languagePackagesId("rust.packages", r.Path.Data), nil
+}
+
+type mqlRustPackagesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlRustPackages) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRustPackages) files() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRustPackages) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	pkgs, files, err := rustSearch.gather(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]interface{}]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlRustPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
+
+func initRubyPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return languagePackagesArgs("ruby.packages", args)
+}
+
+func (r *mqlRubyPackages) id() (string, error) {
+	return languagePackagesId("ruby.packages", r.Path.Data), nil
+}
+
+type mqlRubyPackagesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlRubyPackages) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRubyPackages) files() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRubyPackages) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	pkgs, files, err := rubySearch.gather(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]interface{}]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlRubyPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
+
+func initPhpPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return languagePackagesArgs("php.packages", args)
+}
+
+func (r *mqlPhpPackages) id() (string, error) {
+	return languagePackagesId("php.packages", r.Path.Data), nil
+}
+
+type mqlPhpPackagesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlPhpPackages) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlPhpPackages) files() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlPhpPackages) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	pkgs, files, err := phpSearch.gather(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]interface{}]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlPhpPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
+
+func initDotnetPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return languagePackagesArgs("dotnet.packages", args)
+}
+
+func (r *mqlDotnetPackages) id() (string, error) {
+	return languagePackagesId("dotnet.packages", r.Path.Data), nil
+}
+
+type mqlDotnetPackagesInternal struct {
+	mutex sync.Mutex
+}
+
+func (r *mqlDotnetPackages) list() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlDotnetPackages) files() ([]interface{}, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlDotnetPackages) gatherData() error {
+	// ensure we only gather data once, happens when multiple fields are called by MQL
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.List.State&plugin.StateIsSet != 0 {
+		return nil
+	}
+	if r.Path.Error != nil {
+		return r.Path.Error
+	}
+
+	pkgs, files, err := dotnetSearch.gather(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]interface{}]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]interface{}]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlDotnetPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
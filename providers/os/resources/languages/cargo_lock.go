@@ -0,0 +1,48 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"io"
+
+	"github.com/BurntSushi/toml"
+	"github.com/package-url/packageurl-go"
+)
+
+// CargoLockFile is the name of the lock file of Rust projects
+const CargoLockFile = "Cargo.lock"
+
+// cargoLock represents the Cargo.lock file, which is generated by cargo
+// see https://doc.rust-lang.org/cargo/guide/cargo-toml-vs-cargo-lock.html
+type cargoLock struct {
+	Version  int                `toml:"version"`
+	Packages []cargoLockPackage `toml:"package"`
+}
+
+type cargoLockPackage struct {
+	Name         string   `toml:"name"`
+	Version      string   `toml:"version"`
+	Source       string   `toml:"source"`
+	Checksum     string   `toml:"checksum"`
+	Dependencies []string `toml:"dependencies"`
+}
+
+// ParseCargoLock returns all crates listed in a Cargo.lock file, including
+// the crates of the workspace itself
+func ParseCargoLock(r io.Reader, filename string) ([]*Package, error) {
+	var lock cargoLock
+	if _, err := toml.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+
+	res := make([]*Package, 0, len(lock.Packages))
+	for i := range lock.Packages {
+		pkg := lock.Packages[i]
+		if pkg.Name == "" {
+			continue
+		}
+		res = append(res, newPackage(packageurl.TypeCargo, "", pkg.Name, pkg.Version, nil, "", filename))
+	}
+	return res, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// ComposerLockFile is the name of the lock file of PHP projects
+const ComposerLockFile = "composer.lock"
+
+// composerLock represents the composer.lock file
+// see https://getcomposer.org/doc/01-basic-usage.md#commit-your-composer-lock-file-to-version-control
+type composerLock struct {
+	Packages    []composerPackage `json:"packages"`
+	PackagesDev []composerPackage `json:"packages-dev"`
+}
+
+type composerPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Type    string `json:"type"`
+}
+
+// ParseComposerLock returns all packages of a composer.lock file, including
+// development packages
+func ParseComposerLock(r io.Reader, filename string) ([]*Package, error) {
+	var lock composerLock
+	if err := json.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+
+	all := make([]composerPackage, 0, len(lock.Packages)+len(lock.PackagesDev))
+	all = append(all, lock.Packages...)
+	all = append(all, lock.PackagesDev...)
+	res := make([]*Package, 0, len(all))
+	for i := range all {
+		pkg := all[i]
+		if pkg.Name == "" {
+			continue
+		}
+		// composer packages are always named vendor/project
+		vendor, name, ok := strings.Cut(pkg.Name, "/")
+		if !ok {
+			vendor, name = "", pkg.Name
+		}
+		res = append(res, newPackage(packageurl.TypeComposer, vendor, name, pkg.Version, nil, vendor, filename))
+	}
+	return res, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// DepsJsonSuffix is the file name suffix of the dependency manifests that
+// dotnet publishes next to every application, e.g. app.deps.json
+const DepsJsonSuffix = ".deps.json"
+
+// depsJson represents the *.deps.json file of .NET applications
+// see https://github.com/dotnet/sdk/blob/main/documentation/specs/runtime-configuration-file.md
+type depsJson struct {
+	Libraries map[string]depsJsonLibrary `json:"libraries"`
+}
+
+type depsJsonLibrary struct {
+	Type     string `json:"type"`
+	Sha512   string `json:"sha512"`
+	Path     string `json:"path"`
+	HashPath string `json:"hashPath"`
+}
+
+// ParseDepsJson returns all NuGet packages of a *.deps.json file. Projects
+// and runtime packs that are part of the application itself are skipped.
+func ParseDepsJson(r io.Reader, filename string) ([]*Package, error) {
+	var deps depsJson
+	if err := json.NewDecoder(r).Decode(&deps); err != nil {
+		return nil, err
+	}
+
+	res := make([]*Package, 0, len(deps.Libraries))
+	for key, lib := range deps.Libraries {
+		if lib.Type != "package" {
+			continue
+		}
+		// libraries are keyed by name/version
+		name, version, ok := strings.Cut(key, "/")
+		if !ok || name == "" {
+			continue
+		}
+		res = append(res, newPackage(packageurl.TypeNuget, "", name, version, nil, strings.ToLower(name), filename))
+	}
+	// libraries are a map, sort them for stable results
+	slices.SortFunc(res, func(a, b *Package) int {
+		if n := cmp.Compare(a.Name, b.Name); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return res, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// GemfileLockFile is the name of the lock file of bundler
+const GemfileLockFile = "Gemfile.lock"
+
+// gemSpecLine matches the gems in the specs section of a Gemfile.lock, e.g.
+// "    nokogiri (1.16.2-x86_64-linux)". Dependencies of gems are indented with
+// six spaces and are not matched.
+var gemSpecLine = regexp.MustCompile(`^ {4}([^ ()]+) \(([^()]+)\)$`)
+
+// ParseGemfileLock returns all gems of the GEM, GIT and PATH sections of a
+// Gemfile.lock file
+// see https://bundler.io/guides/gemfile_lock.html
+func ParseGemfileLock(r io.Reader, filename string) ([]*Package, error) {
+	res := []*Package{}
+	inSpecs := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case line == "":
+			inSpecs = false
+		case !strings.HasPrefix(line, " "):
+			// a new section, e.g. GEM, PLATFORMS or BUNDLED WITH
+			inSpecs = false
+		case strings.TrimSpace(line) == "specs:":
+			inSpecs = true
+		case inSpecs:
+			m := gemSpecLine.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			res = append(res, newGem(m[1], m[2], filename))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// newGem creates a gem package. Platform specific gems carry the platform
+// in their version, e.g. 1.16.2-x86_64-linux, which is moved into a purl
+// qualifier.
+func newGem(name string, version string, filename string) *Package {
+	var qualifiers packageurl.Qualifiers
+	version, platform, ok := strings.Cut(version, "-")
+	if ok && platform != "ruby" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{"platform": platform})
+	}
+	return newPackage(packageurl.TypeGem, "", name, version, qualifiers, "", filename)
+}
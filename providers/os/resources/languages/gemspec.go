@@ -0,0 +1,63 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// GemspecExtension is the file extension of the gem specifications that
+// rubygems stores for every installed gem in its specifications directory
+const GemspecExtension = ".gemspec"
+
+var (
+	// gemspecStub matches the stub comment that rubygems writes into installed
+	// specifications, e.g. "# stub: rake 13.0.6 ruby lib"
+	gemspecStub = regexp.MustCompile(`^# stub: (\S+) (\S+) (\S+)`)
+	// gemspecAttribute matches attributes like `s.name = "rake".freeze`
+	gemspecAttribute = regexp.MustCompile(`^\s*\w+\.(name|version|platform)\s*=\s*["']([^"']+)["']`)
+)
+
+// ParseGemspec returns the gem of an installed gem specification
+func ParseGemspec(r io.Reader, filename string) ([]*Package, error) {
+	var name, version, platform string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := gemspecStub.FindStringSubmatch(line); m != nil {
+			name, version, platform = m[1], m[2], m[3]
+			break
+		}
+		if m := gemspecAttribute.FindStringSubmatch(line); m != nil {
+			switch m[1] {
+			case "name":
+				name = m[2]
+			case "version":
+				version = m[2]
+			case "platform":
+				platform = m[2]
+			}
+		}
+		if name != "" && version != "" && platform != "" {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if name == "" || version == "" {
+		return nil, errors.New("could not determine name and version of gem specification " + filepath.Base(filename))
+	}
+	if platform != "" && platform != "ruby" && !strings.Contains(version, "-") {
+		version = version + "-" + platform
+	}
+	return []*Package{newGem(name, version, filename)}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package languages parses the lock files and package metadata of language
+// ecosystems that have no dedicated package manager integration, i.e. Rust,
+// Ruby, PHP and .NET.
+package languages
+
+import (
+	"io"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/cpe"
+)
+
+type Parser func(r io.Reader, filename string) ([]*Package, error)
+
+type Package struct {
+	Name              string
+	Version           string
+	Purl              string
+	Cpes              []string
+	EvidenceLocations []string
+}
+
+func newPackage(purlType string, namespace string, name string, version string, qualifiers packageurl.Qualifiers, vendor string, filename string) *Package {
+	fullName := name
+	if namespace != "" {
+		fullName = namespace + "/" + name
+	}
+	if vendor == "" {
+		vendor = name
+	}
+
+	return &Package{
+		Name:              fullName,
+		Version:           version,
+		Purl:              packageurl.NewPackageURL(purlType, namespace, name, version, qualifiers, "").String(),
+		Cpes:              newCpes(vendor, name, version),
+		EvidenceLocations: []string{filename},
+	}
+}
+
+func newCpes(vendor string, name string, version string) []string {
+	cpes := []string{}
+	if version == "" {
+		return cpes
+	}
+
+	cpeEntry, err := cpe.NewPackage2Cpe(vendor, name, strings.TrimPrefix(version, "v"), "", "")
+	// we only add the cpe if it could be created
+	// if the cpe could not be created, we log the error and continue to ensure the package is still added to the list
+	if err != nil {
+		log.Debug().Str("name", name).Str("version", version).Err(err).Msg("failed to create cpe")
+	} else if cpeEntry != "" {
+		cpes = append(cpes, cpeEntry)
+	}
+	return cpes
+}
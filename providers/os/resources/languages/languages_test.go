@@ -0,0 +1,117 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTestdata(t *testing.T, parse Parser, filename string) map[string]*Package {
+	f, err := os.Open("./testdata/" + filename)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pkgs, err := parse(f, "/app/"+filename)
+	require.NoError(t, err)
+
+	res := map[string]*Package{}
+	for i := range pkgs {
+		assert.Equal(t, []string{"/app/" + filename}, pkgs[i].EvidenceLocations)
+		res[pkgs[i].Name] = pkgs[i]
+	}
+	return res
+}
+
+func TestParseCargoLock(t *testing.T) {
+	pkgs := parseTestdata(t, ParseCargoLock, "Cargo.lock")
+	require.Len(t, pkgs, 3)
+
+	p := pkgs["log"]
+	require.NotNil(t, p)
+	assert.Equal(t, "0.4.21", p.Version)
+	assert.Equal(t, "pkg:cargo/log@0.4.21", p.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:log:log:0.4.21:*:*:*:*:*:*:*"}, p.Cpes)
+
+	// workspace crates have no source but are still reported
+	p = pkgs["hello"]
+	require.NotNil(t, p)
+	assert.Equal(t, "pkg:cargo/hello@0.1.0", p.Purl)
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	pkgs := parseTestdata(t, ParseGemfileLock, "Gemfile.lock")
+	require.Len(t, pkgs, 5)
+
+	p := pkgs["rack"]
+	require.NotNil(t, p)
+	assert.Equal(t, "2.2.8", p.Version)
+	assert.Equal(t, "pkg:gem/rack@2.2.8", p.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:rack:rack:2.2.8:*:*:*:*:*:*:*"}, p.Cpes)
+
+	p = pkgs["nokogiri"]
+	require.NotNil(t, p)
+	assert.Equal(t, "1.16.2", p.Version)
+	assert.Equal(t, "pkg:gem/nokogiri@1.16.2?platform=x86_64-linux", p.Purl)
+
+	// gems from git sources are included
+	p = pkgs["activesupport"]
+	require.NotNil(t, p)
+	assert.Equal(t, "7.1.3", p.Version)
+}
+
+func TestParseGemspec(t *testing.T) {
+	pkgs := parseTestdata(t, ParseGemspec, "rake-13.0.6.gemspec")
+	require.Len(t, pkgs, 1)
+
+	p := pkgs["rake"]
+	require.NotNil(t, p)
+	assert.Equal(t, "13.0.6", p.Version)
+	assert.Equal(t, "pkg:gem/rake@13.0.6", p.Purl)
+
+	// specifications without stub comment
+	res, err := ParseGemspec(strings.NewReader("Gem::Specification.new do |s|\n  s.name = \"json\"\n  s.version = \"2.7.1\"\n  s.platform = \"java\"\nend\n"), "json-2.7.1-java.gemspec")
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "pkg:gem/json@2.7.1?platform=java", res[0].Purl)
+
+	_, err = ParseGemspec(strings.NewReader("# not a gem\n"), "broken.gemspec")
+	assert.Error(t, err)
+}
+
+func TestParseComposerLock(t *testing.T) {
+	pkgs := parseTestdata(t, ParseComposerLock, "composer.lock")
+	require.Len(t, pkgs, 3)
+
+	p := pkgs["guzzlehttp/guzzle"]
+	require.NotNil(t, p)
+	assert.Equal(t, "7.8.1", p.Version)
+	assert.Equal(t, "pkg:composer/guzzlehttp/guzzle@7.8.1", p.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:guzzlehttp:guzzle:7.8.1:*:*:*:*:*:*:*"}, p.Cpes)
+
+	p = pkgs["monolog/monolog"]
+	require.NotNil(t, p)
+	assert.Equal(t, "v3.5.0", p.Version)
+	assert.Equal(t, []string{"cpe:2.3:a:monolog:monolog:3.5.0:*:*:*:*:*:*:*"}, p.Cpes)
+
+	// development packages are included
+	assert.NotNil(t, pkgs["phpunit/phpunit"])
+}
+
+func TestParseDepsJson(t *testing.T) {
+	pkgs := parseTestdata(t, ParseDepsJson, "app.deps.json")
+	// the application project itself is not a package
+	require.Len(t, pkgs, 2)
+
+	p := pkgs["Newtonsoft.Json"]
+	require.NotNil(t, p)
+	assert.Equal(t, "13.0.1", p.Version)
+	assert.Equal(t, "pkg:nuget/Newtonsoft.Json@13.0.1", p.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:newtonsoft.json:newtonsoft.json:13.0.1:*:*:*:*:*:*:*"}, p.Cpes)
+	assert.NotNil(t, pkgs["Serilog"])
+}
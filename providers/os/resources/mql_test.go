@@ -467,6 +467,41 @@ func TestDict_Methods_Map(t *testing.T) {
 			Code:        p + "params['aoa'].flat",
 			Expectation: []interface{}{float64(1), float64(2), float64(3)},
 		},
+		{
+			Code:        p + "params['hello'].replace('l', 'L')",
+			Expectation: "heLLo",
+		},
+		{
+			Code:        p + "params['hello'].startsWith('he')",
+			Expectation: true,
+		},
+		{
+			Code:        p + "params['hello'].substr(1, 3)",
+			Expectation: "ell",
+		},
+		{
+			Code:        p + "params['int-array'].sum",
+			Expectation: float64(6),
+		},
+		{
+			Code:        p + "params['int-array'].max",
+			Expectation: float64(3),
+		},
+		{
+			Code:        p + "params['int-array'].reverse.take(2)",
+			Expectation: []interface{}{float64(3), float64(2)},
+		},
+		{
+			Code:        p + "params['users'].sortBy(_['name']).map(_['name'])",
+			Expectation: []interface{}{"loid", "yor"},
+		},
+		{
+			Code: p + "params['string-array'].groupBy(_ == 'a')",
+			Expectation: map[string]interface{}{
+				"true":  []interface{}{"a"},
+				"false": []interface{}{"b", "c"},
+			},
+		},
 	})
 
 	x.TestSimpleErrors(t, []testutils.SimpleTest{
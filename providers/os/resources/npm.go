@@ -12,12 +12,16 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/package-url/packageurl-go"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/v11/llx"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/sbom"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/tar"
 	"go.mondoo.com/cnquery/v11/providers/os/resources/npm"
+	cnquerysbom "go.mondoo.com/cnquery/v11/sbom"
 	"go.mondoo.com/cnquery/v11/types"
 )
 
@@ -67,6 +71,12 @@ var (
 		"/home/%/.npm-global/lib",
 		"/Users/%/.npm-global/lib",
 	}
+	// pnpm keeps global packages in a project with a lock file
+	defaultPnpmGlobalPaths = []string{
+		"/root/.local/share/pnpm/global/*",
+		"/home/*/.local/share/pnpm/global/*",
+		"/Users/*/Library/pnpm/global/*",
+	}
 )
 
 func (r *mqlNpmPackages) gatherPackagesFromSystemDefaults(conn shared.Connection) ([]*npm.Package, []*npm.Package, []string, error) {
@@ -170,9 +180,55 @@ func (r *mqlNpmPackages) gatherPackagesFromSystemDefaults(conn shared.Connection
 			}
 		}
 	}
+
+	for _, pattern := range defaultPnpmGlobalPaths {
+		m, err := afero.Glob(conn.FileSystem(), filepath.Join(pattern, npm.PnpmLockFile))
+		if err != nil {
+			log.Debug().Err(err).Str("path", pattern).Msg("could not search for pnpm packages")
+		}
+		for _, lockfile := range m {
+			log.Debug().Str("path", lockfile).Msg("found pnpm-lock.yaml file")
+			info, files, err := npm.ParseWorkspace(conn.FileSystem(), lockfile)
+			if err != nil {
+				log.Error().Err(err).Str("path", lockfile).Msg("could not parse pnpm-lock.yaml file")
+				continue
+			}
+			directPackageList = append(directPackageList, info.Direct()...)
+			transitivePackageList = append(transitivePackageList, info.Transitive()...)
+			evidenceFiles = append(evidenceFiles, files...)
+		}
+	}
+
 	return directPackageList, transitivePackageList, evidenceFiles, nil
 }
 
+// findWorkspaceLockfile returns the pnpm or yarn lock file of a project
+// directory, or the lock file itself if path is one. It returns an empty
+// string if the project uses a package-lock.json or has no lock file.
+func findWorkspaceLockfile(afs *afero.Afero, path string, isDir bool) string {
+	if !isDir {
+		switch {
+		case filepath.Base(path) == npm.PnpmLockFile, filepath.Base(path) == npm.YarnLockFile:
+			return path
+		case strings.HasSuffix(path, npm.PnpmHiddenLockFile):
+			return path
+		}
+		return ""
+	}
+
+	// package-lock.json takes precedence to keep the results of existing projects
+	if ok, _ := afs.Exists(filepath.Join(path, "package-lock.json")); ok {
+		return ""
+	}
+	for _, name := range []string{npm.PnpmLockFile, npm.YarnLockFile, npm.PnpmHiddenLockFile} {
+		lockfile := filepath.Join(path, name)
+		if ok, _ := afs.Exists(lockfile); ok {
+			return lockfile
+		}
+	}
+	return ""
+}
+
 func (r *mqlNpmPackages) gatherPackagesFromLocation(conn shared.Connection, path string) (*npm.Package, []*npm.Package, []*npm.Package, []string, error) {
 	evidenceFiles := []string{}
 
@@ -183,6 +239,31 @@ func (r *mqlNpmPackages) gatherPackagesFromLocation(conn shared.Connection, path
 		return nil, nil, nil, nil, err
 	}
 
+	// pnpm and yarn projects are resolved with all of their workspaces
+	if lockfile := findWorkspaceLockfile(afs, path, isDir); lockfile != "" {
+		info, files, err := npm.ParseWorkspace(conn.FileSystem(), lockfile)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		return info.Root(), info.Direct(), info.Transitive(), files, nil
+	}
+
+	// pnpm installations without lock file only have the virtual store
+	if isDir {
+		storeExists, _ := afs.Exists(filepath.Join(path, npm.PnpmVirtualStore))
+		lockExists, _ := afs.Exists(filepath.Join(path, "package-lock.json"))
+		if storeExists && !lockExists {
+			info, err := npm.ParsePnpmVirtualStore(conn.FileSystem(), path)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+			for _, pkg := range info.Transitive() {
+				evidenceFiles = append(evidenceFiles, pkg.EvidenceLocations...)
+			}
+			return nil, info.Direct(), info.Transitive(), evidenceFiles, nil
+		}
+	}
+
 	loadPackageLock := false
 	packageLockPath := ""
 	loadPackageJson := false
@@ -286,7 +367,10 @@ func (r *mqlNpmPackages) gatherData() error {
 	var transitiveDependencies []*npm.Package
 	var filePaths []string
 	var err error
-	if path == "" {
+	if sbomConn, ok := conn.(*sbom.Connection); ok {
+		// all packages are taken from the imported sbom, there is no dependency tree
+		transitiveDependencies = npmPackagesFromSbom(sbomConn.Bom)
+	} else if path == "" {
 		// no specific path was provided, we search through default locations
 		// here we are not going to have a root package, only direct and transitive dependencies
 		directDependencies, transitiveDependencies, filePaths, err = r.gatherPackagesFromSystemDefaults(conn)
@@ -323,15 +407,21 @@ func (r *mqlNpmPackages) gatherData() error {
 
 	// create a resource for each package
 	transitiveResources := []interface{}{}
+	mqlPkgs := map[string]*mqlNpmPackage{}
 	for i := range transitiveDependencies {
 		newNpmPackages, err := newNpmPackages(r.MqlRuntime, transitiveDependencies[i])
 		if err != nil {
 			return err
 		}
 		transitiveResources = append(transitiveResources, newNpmPackages)
+		mqlPkgs[transitiveDependencies[i].Purl] = newNpmPackages
 	}
 	r.List = plugin.TValue[[]interface{}]{Data: transitiveResources, State: plugin.StateIsSet}
 
+	// link the dependency graph once all packages exist, since the graph may
+	// contain cycles
+	linkNpmDependencies(transitiveDependencies, mqlPkgs)
+
 	directResources := []interface{}{}
 	for i := range directDependencies {
 		newNpmPackages, err := newNpmPackages(r.MqlRuntime, directDependencies[i])
@@ -359,6 +449,23 @@ func (r *mqlNpmPackages) gatherData() error {
 	return nil
 }
 
+func npmPackagesFromSbom(bom *cnquerysbom.Sbom) []*npm.Package {
+	res := []*npm.Package{}
+	for _, p := range bom.PackagesByType(packageurl.TypeNPM) {
+		res = append(res, &npm.Package{
+			Name:              p.Name,
+			Version:           p.Version,
+			Description:       p.Description,
+			License:           strings.Join(p.Licenses, " AND "),
+			Purl:              p.Purl,
+			Cpes:              p.Cpes,
+			EvidenceLocations: p.FileLocations(),
+			Dependencies:      p.Dependencies,
+		})
+	}
+	return res
+}
+
 func newNpmPackages(runtime *plugin.Runtime, pkg *npm.Package) (*mqlNpmPackage, error) {
 	cpes := []interface{}{}
 	for i := range pkg.Cpes {
@@ -384,13 +491,17 @@ func newNpmPackages(runtime *plugin.Runtime, pkg *npm.Package) (*mqlNpmPackage,
 		mqlFiles = append(mqlFiles, lf)
 	}
 
+	// the same package may be installed in multiple versions
 	mqlPkg, err := CreateResource(runtime, "npm.package", map[string]*llx.RawData{
-		"id":      llx.StringData(pkg.Name),
-		"name":    llx.StringData(pkg.Name),
-		"version": llx.StringData(pkg.Version),
-		"purl":    llx.StringData(pkg.Purl),
-		"cpes":    llx.ArrayData(cpes, types.Resource("cpe")),
-		"files":   llx.ArrayData(mqlFiles, types.Resource("pkgFileInfo")),
+		"id":       llx.StringData(pkg.Purl),
+		"name":     llx.StringData(pkg.Name),
+		"version":  llx.StringData(pkg.Version),
+		"purl":     llx.StringData(pkg.Purl),
+		"cpes":     llx.ArrayData(cpes, types.Resource("cpe")),
+		"license":  llx.StringData(pkg.License),
+		"files":    llx.ArrayData(mqlFiles, types.Resource("pkgFileInfo")),
+		"dev":      llx.BoolData(pkg.Dev),
+		"optional": llx.BoolData(pkg.Optional),
 	})
 	if err != nil {
 		return nil, err
@@ -434,10 +545,70 @@ func (r *mqlNpmPackage) cpes() ([]interface{}, error) {
 	return nil, r.populateData()
 }
 
+func (r *mqlNpmPackage) license() (string, error) {
+	return "", r.populateData()
+}
+
 func (r *mqlNpmPackage) files() ([]interface{}, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (r *mqlNpmPackage) dev() (bool, error) {
+	return false, r.populateData()
+}
+
+func (r *mqlNpmPackage) optional() (bool, error) {
+	return false, r.populateData()
+}
+
+// linkNpmDependencies sets the dependencies of every package that comes with a
+// dependency graph. Packages without one keep their dependencies unset, so
+// they resolve to null instead of an empty list.
+func linkNpmDependencies(pkgs []*npm.Package, mqlPkgs map[string]*mqlNpmPackage) {
+	for _, pkg := range pkgs {
+		mqlPkg, ok := mqlPkgs[pkg.Purl]
+		if !ok || pkg.Dependencies == nil {
+			continue
+		}
+		deps := []interface{}{}
+		for _, purl := range pkg.Dependencies {
+			if dep, ok := mqlPkgs[purl]; ok {
+				deps = append(deps, dep)
+			}
+		}
+		mqlPkg.Dependencies = plugin.TValue[[]interface{}]{Data: deps, State: plugin.StateIsSet}
+	}
+}
+
+func (r *mqlNpmPackage) dependencies() ([]interface{}, error) {
+	// dependencies are only known for packages from lock files with a dependency
+	// graph, without one they are unknown and not empty
+	r.Dependencies.State = plugin.StateIsSet | plugin.StateIsNull
+	return nil, nil
+}
+
+func (r *mqlNpmPackage) layer() (*mqlContainerImageLayer, error) {
+	conn, ok := r.MqlRuntime.Connection.(*tar.Connection)
+	if !ok {
+		r.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	paths := make([]string, 0, len(r.Files.Data))
+	for i := range r.Files.Data {
+		paths = append(paths, r.Files.Data[i].(*mqlPkgFileInfo).Path.Data)
+	}
+
+	layer, err := newMqlContainerImageLayer(r.MqlRuntime, conn.FilesLayer(paths))
+	if err != nil {
+		return nil, err
+	}
+	if layer == nil {
+		r.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+	}
+	return layer, nil
+}
+
 func (r *mqlNpmPackage) populateData() error {
 	// future iterations will read an npm package.json file and populate the data
 	// all data is already available in the package object
@@ -445,6 +616,9 @@ func (r *mqlNpmPackage) populateData() error {
 	r.Version = plugin.TValue[string]{State: plugin.StateIsSet | plugin.StateIsNull}
 	r.Purl = plugin.TValue[string]{State: plugin.StateIsSet | plugin.StateIsNull}
 	r.Cpes = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+	r.License = plugin.TValue[string]{State: plugin.StateIsSet | plugin.StateIsNull}
 	r.Files = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+	r.Dev = plugin.TValue[bool]{State: plugin.StateIsSet | plugin.StateIsNull}
+	r.Optional = plugin.TValue[bool]{State: plugin.StateIsSet | plugin.StateIsNull}
 	return nil
 }
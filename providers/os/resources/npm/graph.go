@@ -0,0 +1,200 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"cmp"
+	"slices"
+)
+
+// dependencyGraph holds the packages of lock files that record the
+// dependencies between packages, e.g. pnpm-lock.yaml and yarn berry lock
+// files. The graph is used to determine which packages are only required for
+// development and which are optional.
+type dependencyGraph struct {
+	// nodes are keyed by the identifier that the lock file uses for a
+	// resolved package. One package may have multiple nodes, e.g. if it is
+	// resolved with different peer dependencies.
+	nodes     map[string]*graphNode
+	importers []*graphImporter
+	evidence  []string
+}
+
+type graphNode struct {
+	name                 string
+	version              string
+	dependencies         []string
+	optionalDependencies []string
+	// dev and optional are set if the lock file records the flags itself
+	dev      bool
+	optional bool
+}
+
+// graphImporter is a project or workspace package that depends on nodes
+type graphImporter struct {
+	// path of the workspace relative to the lock file
+	path                 string
+	dependencies         []string
+	devDependencies      []string
+	optionalDependencies []string
+}
+
+func newDependencyGraph(filename string) *dependencyGraph {
+	g := &dependencyGraph{
+		nodes: map[string]*graphNode{},
+	}
+	if filename != "" {
+		g.evidence = append(g.evidence, filename)
+	}
+	return g
+}
+
+func (g *dependencyGraph) workspaces() []string {
+	res := make([]string, 0, len(g.importers))
+	for i := range g.importers {
+		res = append(res, g.importers[i].path)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// reachable returns the purls of all packages that are reachable from the
+// given node keys
+func (g *dependencyGraph) reachable(keys []string) map[string]struct{} {
+	visited := map[string]struct{}{}
+	purls := map[string]struct{}{}
+	queue := slices.Clone(keys)
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[key]; ok {
+			continue
+		}
+		visited[key] = struct{}{}
+
+		node, ok := g.nodes[key]
+		if !ok {
+			continue
+		}
+		purls[NewPackageUrl(node.name, node.version)] = struct{}{}
+		queue = append(queue, node.dependencies...)
+		queue = append(queue, node.optionalDependencies...)
+	}
+	return purls
+}
+
+// packages resolves all nodes into packages. Nodes of the same package are
+// merged.
+func (g *dependencyGraph) packages() []*Package {
+	var prodKeys, devKeys []string
+	// packages that are only referenced as optional dependencies are optional
+	required := map[string]struct{}{}
+	referencedOptional := map[string]struct{}{}
+	markRequired := func(keys []string) {
+		for _, key := range keys {
+			if node, ok := g.nodes[key]; ok {
+				required[NewPackageUrl(node.name, node.version)] = struct{}{}
+			}
+		}
+	}
+	markOptional := func(keys []string) {
+		for _, key := range keys {
+			if node, ok := g.nodes[key]; ok {
+				referencedOptional[NewPackageUrl(node.name, node.version)] = struct{}{}
+			}
+		}
+	}
+
+	for _, imp := range g.importers {
+		prodKeys = append(prodKeys, imp.dependencies...)
+		prodKeys = append(prodKeys, imp.optionalDependencies...)
+		devKeys = append(devKeys, imp.devDependencies...)
+		markRequired(imp.dependencies)
+		markRequired(imp.devDependencies)
+		markOptional(imp.optionalDependencies)
+	}
+	for _, node := range g.nodes {
+		markRequired(node.dependencies)
+		markOptional(node.optionalDependencies)
+	}
+
+	prod := g.reachable(prodKeys)
+	dev := g.reachable(devKeys)
+
+	pkgs := map[string]*Package{}
+	for _, node := range g.nodes {
+		purl := NewPackageUrl(node.name, node.version)
+		pkg, ok := pkgs[purl]
+		if !ok {
+			pkg = &Package{
+				Name:              node.name,
+				Version:           node.version,
+				Purl:              purl,
+				Cpes:              NewCpes(node.name, node.version),
+				EvidenceLocations: g.evidence,
+				Dependencies:      []string{},
+			}
+			if len(g.importers) > 0 {
+				_, isProd := prod[purl]
+				_, isDev := dev[purl]
+				pkg.Dev = isDev && !isProd
+			} else {
+				// without importers we can only rely on the lock file
+				pkg.Dev = node.dev
+			}
+			_, isRequired := required[purl]
+			_, isOptional := referencedOptional[purl]
+			pkg.Optional = node.optional || (isOptional && !isRequired)
+			pkgs[purl] = pkg
+		}
+
+		for _, key := range append(slices.Clone(node.dependencies), node.optionalDependencies...) {
+			dep, ok := g.nodes[key]
+			if !ok {
+				continue
+			}
+			depPurl := NewPackageUrl(dep.name, dep.version)
+			if !slices.Contains(pkg.Dependencies, depPurl) {
+				pkg.Dependencies = append(pkg.Dependencies, depPurl)
+			}
+		}
+	}
+
+	res := make([]*Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		slices.Sort(pkg.Dependencies)
+		res = append(res, pkg)
+	}
+	slices.SortFunc(res, comparePackages)
+	return res
+}
+
+// direct returns the packages that the importers depend on directly
+func (g *dependencyGraph) direct() []*Package {
+	directPurls := map[string]struct{}{}
+	for _, imp := range g.importers {
+		for _, keys := range [][]string{imp.dependencies, imp.devDependencies, imp.optionalDependencies} {
+			for _, key := range keys {
+				if node, ok := g.nodes[key]; ok {
+					directPurls[NewPackageUrl(node.name, node.version)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	res := []*Package{}
+	for _, pkg := range g.packages() {
+		if _, ok := directPurls[pkg.Purl]; ok {
+			res = append(res, pkg)
+		}
+	}
+	return res
+}
+
+func comparePackages(a, b *Package) int {
+	if n := cmp.Compare(a.Name, b.Name); n != 0 {
+		return n
+	}
+	return cmp.Compare(a.Version, b.Version)
+}
@@ -22,6 +22,15 @@ type NpmPackageInfo interface {
 	Transitive() []*Package
 }
 
+// WorkspaceInfo is implemented by lock files that resolve projects with
+// multiple workspace packages
+type WorkspaceInfo interface {
+	NpmPackageInfo
+	// Workspaces returns the directories of the workspace packages relative
+	// to the lock file, the root project is "."
+	Workspaces() []string
+}
+
 type Package struct {
 	Name              string
 	File              string
@@ -31,6 +40,12 @@ type Package struct {
 	Purl              string
 	Cpes              []string
 	EvidenceLocations []string
+	// Dev is set for packages that are only required for development
+	Dev bool
+	// Optional is set for packages that are only optional dependencies
+	Optional bool
+	// Dependencies are the package urls of the packages this package depends on
+	Dependencies []string
 }
 
 // NewPackageUrl creates a npm package url for a given package name and version
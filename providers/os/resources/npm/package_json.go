@@ -192,6 +192,9 @@ func (p *packageJson) Root() *Package {
 		Cpes:              NewCpes(p.Name, p.Version),
 		EvidenceLocations: p.evidence,
 	}
+	if p.License != nil {
+		root.License = p.License.Value
+	}
 
 	return root
 }
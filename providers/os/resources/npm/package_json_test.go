@@ -281,6 +281,7 @@ func TestPackageJsonParser(t *testing.T) {
 	assert.Equal(t, &Package{
 		Name:              "express",
 		Version:           "4.16.4",
+		License:           "MIT",
 		Purl:              "pkg:npm/express@4.16.4",
 		Cpes:              []string{"cpe:2.3:a:express:express:4.16.4:*:*:*:*:*:*:*"},
 		EvidenceLocations: []string{"path/package.json"},
@@ -294,6 +295,7 @@ func TestPackageJsonParser(t *testing.T) {
 	assert.Equal(t, &Package{
 		Name:              "express",
 		Version:           "4.16.4",
+		License:           "MIT",
 		Purl:              "pkg:npm/express@4.16.4",
 		Cpes:              []string{"cpe:2.3:a:express:express:4.16.4:*:*:*:*:*:*:*"},
 		EvidenceLocations: []string{"path/package.json"},
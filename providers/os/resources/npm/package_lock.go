@@ -5,6 +5,7 @@ package npm
 
 import (
 	"io"
+	"slices"
 	"strings"
 
 	"encoding/json"
@@ -70,6 +71,12 @@ func (l *packageLockLicense) UnmarshalJSON(data []byte) (err error) {
 	return nil
 }
 
+// String returns the license as SPDX expression, multiple licenses are
+// alternatives the user can choose from
+func (l packageLockLicense) String() string {
+	return strings.Join(l, " OR ")
+}
+
 // PackageLockParser is the parser for the package.lock file npm format.
 // see https://docs.npmjs.com/cli/v10/configuring-npm/package-lock-json
 type PackageLockParser struct {
@@ -97,6 +104,9 @@ func (p *packageLock) Root() *Package {
 		Cpes:              NewCpes(p.Name, p.Version),
 		EvidenceLocations: p.evidence,
 	}
+	if rootPkg, ok := p.Packages[""]; ok {
+		root.License = rootPkg.License.String()
+	}
 	return root
 }
 
@@ -142,12 +152,15 @@ func (p *packageLock) Transitive() []*Package {
 				name = v.Name
 			}
 
+			name = packageLockPackageName(name)
 			transitive = append(transitive, &Package{
-				Name:              packageLockPackageName(name),
+				Name:              name,
 				Version:           v.Version,
-				Purl:              NewPackageUrl(k, v.Version),
-				Cpes:              NewCpes(k, v.Version),
+				License:           v.License.String(),
+				Purl:              NewPackageUrl(name, v.Version),
+				Cpes:              NewCpes(name, v.Version),
 				EvidenceLocations: p.evidence,
+				Dependencies:      p.dependencies(k, v),
 			})
 		}
 	} else if p.Dependencies != nil {
@@ -164,6 +177,42 @@ func (p *packageLock) Transitive() []*Package {
 	return transitive
 }
 
+// dependencies returns the package urls of the dependencies of the package
+// installed at the given location. They are resolved like node does, by
+// searching the nested node_modules folders first and then the parent folders.
+func (p *packageLock) dependencies(location string, pkg packageLockPackage) []string {
+	res := []string{}
+	for name := range pkg.Dependencies {
+		dir := location
+		for {
+			key := "node_modules/" + name
+			if dir != "" {
+				key = dir + "/" + key
+			}
+			if dep, ok := p.Packages[key]; ok {
+				res = append(res, NewPackageUrl(name, dep.Version))
+				break
+			}
+			if dir == "" {
+				break
+			}
+			idx := strings.LastIndex(dir, "node_modules/")
+			if idx <= 0 {
+				dir = ""
+			} else {
+				dir = strings.TrimSuffix(dir[:idx], "/")
+			}
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// packageLockPackageName returns the package name for a package location,
+// nested packages are installed in the node_modules folder of their parent
 func packageLockPackageName(path string) string {
-	return strings.TrimPrefix(path, "node_modules/")
+	if idx := strings.LastIndex(path, "node_modules/"); idx >= 0 {
+		return path[idx+len("node_modules/"):]
+	}
+	return path
 }
@@ -172,6 +172,7 @@ func TestPackageJsonLockWithPackages(t *testing.T) {
 	assert.Equal(t, &Package{
 		Name:              "npm",
 		Version:           "7.0.0",
+		License:           "Artistic-2.0",
 		Purl:              "pkg:npm/npm@7.0.0",
 		Cpes:              []string{"cpe:2.3:a:npm:npm:7.0.0:*:*:*:*:*:*:*"},
 		EvidenceLocations: []string{"path/to/package-lock.json"},
@@ -184,9 +185,10 @@ func TestPackageJsonLockWithPackages(t *testing.T) {
 	assert.Equal(t, &Package{
 		Name:              "@babel/code-frame",
 		Version:           "7.10.4",
-		Purl:              "pkg:npm/node-modules/%40babel@7.10.4",
-		Cpes:              []string{"cpe:2.3:a:node_modules\\/\\@babel\\/code-frame:node_modules\\/\\@babel\\/code-frame:7.10.4:*:*:*:*:*:*:*"},
+		Purl:              "pkg:npm/%40babel/code-frame@7.10.4",
+		Cpes:              []string{"cpe:2.3:a:\\@babel\\/code-frame:\\@babel\\/code-frame:7.10.4:*:*:*:*:*:*:*"},
 		EvidenceLocations: []string{"path/to/package-lock.json"},
+		Dependencies:      []string{},
 	}, p)
 
 }
@@ -229,3 +231,46 @@ func TestPackageJsonLockWithDependencies(t *testing.T) {
 		EvidenceLocations: []string{"path/to/package-lock.json"},
 	}, p)
 }
+
+func TestPackageLockDependencies(t *testing.T) {
+	f, err := os.Open("./testdata/package-lock/lockfile-v3-dependencies.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := (&PackageLockParser{}).Parse(f, "path/to/package-lock.json")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", info.Root().License)
+
+	transitive := info.Transitive()
+	assert.Equal(t, 6, len(transitive))
+
+	p := findPkg(transitive, "express")
+	assert.Equal(t, "MIT", p.License)
+	// nested packages take precedence over the hoisted ones
+	assert.Equal(t, []string{"pkg:npm/debug@2.6.9"}, p.Dependencies)
+
+	for _, p := range transitive {
+		switch p.Purl {
+		case "pkg:npm/debug@2.6.9":
+			assert.Equal(t, []string{"pkg:npm/ms@2.0.0"}, p.Dependencies)
+		case "pkg:npm/debug@4.3.4":
+			assert.Equal(t, []string{"pkg:npm/ms@2.1.2"}, p.Dependencies)
+		case "pkg:npm/shop@1.0.0":
+			assert.Equal(t, []string{"pkg:npm/debug@4.3.4", "pkg:npm/express@4.18.2"}, p.Dependencies)
+		case "pkg:npm/ms@2.0.0", "pkg:npm/ms@2.1.2":
+			// leaf packages are part of the graph and have no dependencies
+			assert.NotNil(t, p.Dependencies)
+			assert.Empty(t, p.Dependencies)
+		}
+	}
+}
+
+func TestPackageLockLicenses(t *testing.T) {
+	f, err := os.Open("./testdata/package-lock/lockfile-v2-licenses.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := (&PackageLockParser{}).Parse(f, "path/to/package-lock.json")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT OR Apache2", info.Root().License)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	_ Parser        = (*PnpmLockParser)(nil)
+	_ WorkspaceInfo = (*pnpmLock)(nil)
+)
+
+// pnpmLock represents the pnpm-lock.yaml file
+// see https://github.com/pnpm/spec/blob/master/lockfile/9.0.md
+type pnpmLock struct {
+	// LockfileVersion is a number in version 5 and a string since version 6
+	LockfileVersion interface{} `json:"lockfileVersion"`
+	// Importers lists the root project and all workspace packages. Lock files
+	// of projects without workspaces before version 9 list the dependencies
+	// at the top level instead.
+	Importers map[string]pnpmImporter `json:"importers"`
+	pnpmImporter
+	// Packages contain the resolved packages, version 9 moved the
+	// dependencies between packages to snapshots
+	Packages  map[string]pnpmPackage  `json:"packages"`
+	Snapshots map[string]pnpmSnapshot `json:"snapshots"`
+
+	graph *dependencyGraph
+}
+
+type pnpmImporter struct {
+	Dependencies         map[string]pnpmDependency `json:"dependencies"`
+	DevDependencies      map[string]pnpmDependency `json:"devDependencies"`
+	OptionalDependencies map[string]pnpmDependency `json:"optionalDependencies"`
+}
+
+// pnpmDependency is the resolved version of a dependency, which is a plain
+// string in version 5 and an object with specifier and version since version 6
+type pnpmDependency struct {
+	Specifier string `json:"specifier"`
+	Version   string `json:"version"`
+}
+
+func (d *pnpmDependency) UnmarshalJSON(b []byte) error {
+	var version string
+	if err := json.Unmarshal(b, &version); err == nil {
+		d.Version = version
+		return nil
+	}
+
+	type dependency pnpmDependency
+	var dep dependency
+	if err := json.Unmarshal(b, &dep); err != nil {
+		return err
+	}
+	*d = pnpmDependency(dep)
+	return nil
+}
+
+type pnpmPackage struct {
+	// Name and Version are only set for packages that are not resolved from
+	// the registry, e.g. tarballs and git repositories
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Dependencies         map[string]string `json:"dependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	Dev                  bool              `json:"dev"`
+	Optional             bool              `json:"optional"`
+}
+
+type pnpmSnapshot struct {
+	Dependencies         map[string]string `json:"dependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	Optional             bool              `json:"optional"`
+}
+
+// PnpmLockParser is the parser for pnpm-lock.yaml files in version 5, 6 and
+// 9. It also parses the lock file that pnpm keeps in node_modules/.pnpm/lock.yaml.
+type PnpmLockParser struct{}
+
+func (p *PnpmLockParser) Parse(r io.Reader, filename string) (NpmPackageInfo, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock pnpmLock
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, err
+	}
+	lock.graph = lock.newGraph(filename)
+	return &lock, nil
+}
+
+func (p *pnpmLock) majorVersion() string {
+	major, _, _ := strings.Cut(fmt.Sprint(p.LockfileVersion), ".")
+	return major
+}
+
+func (p *pnpmLock) newGraph(filename string) *dependencyGraph {
+	g := newDependencyGraph(filename)
+	version := p.majorVersion()
+
+	if version == "9" {
+		// all packages are listed in snapshots, packages only hold metadata
+		for key, snapshot := range p.Snapshots {
+			name, pkgVersion := parsePnpmKey(key, version)
+			pkg := p.Packages[trimPnpmPeers(key)]
+			if pkg.Name != "" {
+				name = pkg.Name
+			}
+			if pkg.Version != "" {
+				pkgVersion = pkg.Version
+			}
+			g.nodes[key] = &graphNode{
+				name:                 name,
+				version:              pkgVersion,
+				dependencies:         p.resolveKeys(snapshot.Dependencies, version),
+				optionalDependencies: p.resolveKeys(snapshot.OptionalDependencies, version),
+				optional:             snapshot.Optional,
+			}
+		}
+	} else {
+		for key, pkg := range p.Packages {
+			name, pkgVersion := parsePnpmKey(key, version)
+			if pkg.Name != "" {
+				name = pkg.Name
+			}
+			if pkg.Version != "" {
+				pkgVersion = pkg.Version
+			}
+			g.nodes[key] = &graphNode{
+				name:                 name,
+				version:              pkgVersion,
+				dependencies:         p.resolveKeys(pkg.Dependencies, version),
+				optionalDependencies: p.resolveKeys(pkg.OptionalDependencies, version),
+				dev:                  pkg.Dev,
+				optional:             pkg.Optional,
+			}
+		}
+	}
+
+	importers := p.Importers
+	if len(importers) == 0 {
+		importers = map[string]pnpmImporter{".": p.pnpmImporter}
+	}
+	for path, imp := range importers {
+		g.importers = append(g.importers, &graphImporter{
+			path:                 path,
+			dependencies:         p.resolveImporterKeys(imp.Dependencies, version),
+			devDependencies:      p.resolveImporterKeys(imp.DevDependencies, version),
+			optionalDependencies: p.resolveImporterKeys(imp.OptionalDependencies, version),
+		})
+	}
+	return g
+}
+
+func (p *pnpmLock) resolveImporterKeys(deps map[string]pnpmDependency, version string) []string {
+	versions := make(map[string]string, len(deps))
+	for name, dep := range deps {
+		versions[name] = dep.Version
+	}
+	return p.resolveKeys(versions, version)
+}
+
+// resolveKeys returns the keys of the packages that dependencies resolve to
+func (p *pnpmLock) resolveKeys(deps map[string]string, version string) []string {
+	res := make([]string, 0, len(deps))
+	for name, ref := range deps {
+		if key := p.resolveKey(name, ref, version); key != "" {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+func (p *pnpmLock) resolveKey(name string, ref string, version string) string {
+	// workspace packages are linked and not part of the packages
+	if strings.HasPrefix(ref, "link:") {
+		return ""
+	}
+
+	candidates := []string{ref}
+	switch version {
+	case "9":
+		// aliases reference the package directly, e.g. string-width@4.2.3
+		if !strings.Contains(trimPnpmPeers(ref), "@") {
+			candidates = append(candidates, name+"@"+ref)
+		}
+	case "5":
+		candidates = append(candidates, "/"+name+"/"+ref)
+	default:
+		candidates = append(candidates, "/"+name+"@"+ref)
+	}
+
+	for _, key := range candidates {
+		if version == "9" {
+			if _, ok := p.Snapshots[key]; ok {
+				return key
+			}
+		} else if _, ok := p.Packages[key]; ok {
+			return key
+		}
+	}
+	return ""
+}
+
+// trimPnpmPeers removes the peer dependencies from a package key, e.g.
+// react-dom@18.2.0(react@18.2.0)
+func trimPnpmPeers(key string) string {
+	if i := strings.Index(key, "("); i > 0 {
+		return key[:i]
+	}
+	return key
+}
+
+// parsePnpmKey returns name and version of a package key, which is
+// /name/version_peers in version 5, /name@version(peers) in version 6 and
+// name@version(peers) in version 9
+func parsePnpmKey(key string, version string) (string, string) {
+	key = strings.TrimPrefix(trimPnpmPeers(key), "/")
+	if version == "5" {
+		i := strings.LastIndex(key, "/")
+		if i <= 0 {
+			return key, ""
+		}
+		pkgVersion, _, _ := strings.Cut(key[i+1:], "_")
+		return key[:i], pkgVersion
+	}
+
+	// the name of scoped packages starts with @
+	i := strings.LastIndex(key, "@")
+	if i <= 0 {
+		return key, ""
+	}
+	return key[:i], key[i+1:]
+}
+
+func (p *pnpmLock) Root() *Package {
+	// the root package is only defined in package.json
+	return nil
+}
+
+func (p *pnpmLock) Direct() []*Package {
+	return p.graph.direct()
+}
+
+func (p *pnpmLock) Transitive() []*Package {
+	return p.graph.packages()
+}
+
+func (p *pnpmLock) Workspaces() []string {
+	return p.graph.workspaces()
+}
@@ -0,0 +1,148 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parsePnpmLock(t *testing.T, fixture string) NpmPackageInfo {
+	f, err := os.Open(fixture)
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := (&PnpmLockParser{}).Parse(f, "/app/pnpm-lock.yaml")
+	require.NoError(t, err)
+	return info
+}
+
+func TestPnpmLockV5(t *testing.T) {
+	info := parsePnpmLock(t, "./testdata/pnpm-lock/v5.yaml")
+	assert.Nil(t, info.Root())
+
+	transitive := info.Transitive()
+	assert.Equal(t, 4, len(transitive))
+
+	p := findPkg(transitive, "react")
+	assert.Equal(t, &Package{
+		Name:              "react",
+		Version:           "17.0.2",
+		Purl:              "pkg:npm/react@17.0.2",
+		Cpes:              []string{"cpe:2.3:a:react:react:17.0.2:*:*:*:*:*:*:*"},
+		EvidenceLocations: []string{"/app/pnpm-lock.yaml"},
+		Dependencies:      []string{"pkg:npm/loose-envify@1.4.0", "pkg:npm/object-assign@4.1.1"},
+	}, p)
+
+	direct := info.Direct()
+	require.Equal(t, 1, len(direct))
+	assert.Equal(t, "react", direct[0].Name)
+}
+
+func TestPnpmLockV6(t *testing.T) {
+	info := parsePnpmLock(t, "./testdata/pnpm-lock/v6.yaml")
+
+	transitive := info.Transitive()
+	assert.Equal(t, 3, len(transitive))
+
+	p := findPkg(transitive, "@babel/runtime")
+	assert.Equal(t, "7.24.5", p.Version)
+	assert.Equal(t, "pkg:npm/%40babel/runtime@7.24.5", p.Purl)
+	assert.Equal(t, []string{"pkg:npm/regenerator-runtime@0.14.1"}, p.Dependencies)
+	assert.False(t, p.Dev)
+
+	p = findPkg(transitive, "typescript")
+	assert.True(t, p.Dev)
+	assert.False(t, p.Optional)
+
+	assert.Equal(t, 2, len(info.Direct()))
+	assert.Equal(t, []string{"."}, info.(WorkspaceInfo).Workspaces())
+}
+
+func TestPnpmLockV9Workspace(t *testing.T) {
+	info := parsePnpmLock(t, "./testdata/pnpm-lock/v9-workspace.yaml")
+
+	transitive := info.Transitive()
+	assert.Equal(t, 7, len(transitive))
+
+	// peer dependencies do not create separate packages
+	p := findPkg(transitive, "react-dom")
+	assert.Equal(t, "18.2.0", p.Version)
+	assert.Equal(t, []string{
+		"pkg:npm/loose-envify@1.4.0",
+		"pkg:npm/react@18.2.0",
+		"pkg:npm/scheduler@0.23.2",
+	}, p.Dependencies)
+	assert.False(t, p.Dev)
+	assert.False(t, p.Optional)
+
+	p = findPkg(transitive, "typescript")
+	assert.True(t, p.Dev)
+
+	p = findPkg(transitive, "fsevents")
+	assert.True(t, p.Optional)
+	assert.False(t, p.Dev)
+
+	// transitive dependencies of production packages are not dev dependencies
+	p = findPkg(transitive, "js-tokens")
+	assert.False(t, p.Dev)
+
+	direct := info.Direct()
+	names := []string{}
+	for i := range direct {
+		names = append(names, direct[i].Name)
+	}
+	assert.Equal(t, []string{"fsevents", "react", "react-dom", "typescript"}, names)
+
+	assert.Equal(t, []string{".", "packages/app", "packages/ui"}, info.(WorkspaceInfo).Workspaces())
+}
+
+func TestParsePnpmKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		version string
+		name    string
+		pkgVer  string
+	}{
+		{"/react/17.0.2", "5", "react", "17.0.2"},
+		{"/@babel/core/7.22.0_supports-color@5.5.0", "5", "@babel/core", "7.22.0"},
+		{"/@babel/runtime@7.24.5", "6", "@babel/runtime", "7.24.5"},
+		{"/react-dom@18.2.0(react@18.2.0)", "6", "react-dom", "18.2.0"},
+		{"@types/node@20.12.7", "9", "@types/node", "20.12.7"},
+	}
+	for _, test := range tests {
+		name, version := parsePnpmKey(test.key, test.version)
+		assert.Equal(t, test.name, name, test.key)
+		assert.Equal(t, test.pkgVer, version, test.key)
+	}
+}
+
+func TestPnpmVirtualStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, dir := range []string{
+		"/app/node_modules/.pnpm/react@18.2.0/node_modules/react",
+		"/app/node_modules/.pnpm/@babel+runtime@7.24.5/node_modules/@babel/runtime",
+		"/app/node_modules/.pnpm/react-dom@18.2.0_react@18.2.0/node_modules/react-dom",
+		"/app/node_modules/.pnpm/node_modules/loose-envify",
+	} {
+		require.NoError(t, fs.MkdirAll(dir, 0o755))
+	}
+
+	info, err := ParsePnpmVirtualStore(fs, "/app")
+	require.NoError(t, err)
+
+	transitive := info.Transitive()
+	assert.Equal(t, 3, len(transitive))
+
+	p := findPkg(transitive, "@babel/runtime")
+	assert.Equal(t, "7.24.5", p.Version)
+	assert.Equal(t, []string{"/app/node_modules/.pnpm/@babel+runtime@7.24.5/node_modules/@babel/runtime/package.json"}, p.EvidenceLocations)
+
+	p = findPkg(transitive, "react-dom")
+	assert.Equal(t, "18.2.0", p.Version)
+}
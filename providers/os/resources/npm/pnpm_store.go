@@ -0,0 +1,83 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// PnpmVirtualStore is the directory in which pnpm installs all packages of a
+// project, node_modules only links to it
+const PnpmVirtualStore = "node_modules/.pnpm"
+
+var _ NpmPackageInfo = (*pnpmVirtualStore)(nil)
+
+type pnpmVirtualStore struct {
+	packages []*Package
+}
+
+// ParsePnpmVirtualStore returns the packages that pnpm installed into the
+// node_modules/.pnpm directory of a project. It is used for installations
+// without lock file, e.g. if the lock file is not copied into container images.
+func ParsePnpmVirtualStore(fs afero.Fs, dir string) (NpmPackageInfo, error) {
+	storeDir := filepath.Join(dir, PnpmVirtualStore)
+	entries, err := afero.ReadDir(fs, storeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pnpmVirtualStore{}
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == "node_modules" {
+			continue
+		}
+		name, version := parsePnpmStoreEntry(entry.Name())
+		if name == "" || version == "" {
+			continue
+		}
+		res.packages = append(res.packages, &Package{
+			Name:              name,
+			Version:           version,
+			Purl:              NewPackageUrl(name, version),
+			Cpes:              NewCpes(name, version),
+			EvidenceLocations: []string{filepath.Join(storeDir, entry.Name(), "node_modules", name, "package.json")},
+		})
+	}
+	return res, nil
+}
+
+// parsePnpmStoreEntry returns name and version of a directory in the virtual
+// store, e.g. @babel+core@7.24.0 or react-dom@18.2.0_react@18.2.0
+func parsePnpmStoreEntry(entry string) (string, string) {
+	// the scope separator is replaced with + in directory names
+	if strings.HasPrefix(entry, "@") {
+		entry = strings.Replace(entry, "+", "/", 1)
+	}
+	i := strings.Index(entry[min(1, len(entry)):], "@")
+	if i < 0 {
+		return "", ""
+	}
+	i++
+	name, version := entry[:i], entry[i+1:]
+	// peer dependencies are appended to the version
+	if j := strings.IndexAny(version, "_("); j >= 0 {
+		version = version[:j]
+	}
+	return name, version
+}
+
+func (s *pnpmVirtualStore) Root() *Package {
+	return nil
+}
+
+func (s *pnpmVirtualStore) Direct() []*Package {
+	return nil
+}
+
+func (s *pnpmVirtualStore) Transitive() []*Package {
+	return s.packages
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	PnpmLockFile = "pnpm-lock.yaml"
+	YarnLockFile = "yarn.lock"
+	// PnpmHiddenLockFile is the copy of the lock file that pnpm keeps with the
+	// installed packages in node_modules/.pnpm
+	PnpmHiddenLockFile = "node_modules/.pnpm/lock.yaml"
+)
+
+var _ NpmPackageInfo = (*workspace)(nil)
+
+// workspace combines a lock file with the package.json files of the project
+// and its workspace packages
+type workspace struct {
+	root    *Package
+	lock    NpmPackageInfo
+	members []*Package
+}
+
+// ParseWorkspace parses a pnpm-lock.yaml or yarn.lock file together with the
+// package.json files of the project and all of its workspace packages. It
+// returns the packages and all files that were used to determine them.
+func ParseWorkspace(fs afero.Fs, lockfile string) (NpmPackageInfo, []string, error) {
+	afs := &afero.Afero{Fs: fs}
+	dir := WorkspaceDir(lockfile)
+
+	f, err := afs.Open(lockfile)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var parser Parser = &PnpmLockParser{}
+	if filepath.Base(lockfile) == YarnLockFile {
+		parser = &YarnLockParser{}
+	}
+	lock, err := parser.Parse(f, lockfile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &workspace{lock: lock}
+	evidence := []string{lockfile}
+
+	workspaces := []string{"."}
+	if info, ok := lock.(WorkspaceInfo); ok {
+		workspaces = info.Workspaces()
+		if !slices.Contains(workspaces, ".") {
+			workspaces = append([]string{"."}, workspaces...)
+		}
+	}
+
+	for _, path := range workspaces {
+		manifestPath := filepath.Join(dir, path, "package.json")
+		manifest, err := readPackageJson(afs, manifestPath)
+		if err != nil {
+			log.Debug().Err(err).Str("path", manifestPath).Msg("could not read package.json of workspace")
+			continue
+		}
+		evidence = append(evidence, manifestPath)
+
+		if berry, ok := lock.(*yarnBerryLock); ok {
+			berry.setDevDependencies(path, manifest.DevDependencies)
+		}
+
+		if manifest.Name == "" {
+			continue
+		}
+		if path == "." {
+			res.root = manifest.Root()
+		} else {
+			res.members = append(res.members, manifest.Root())
+		}
+	}
+
+	return res, evidence, nil
+}
+
+// WorkspaceDir returns the project directory of a lock file
+func WorkspaceDir(lockfile string) string {
+	dir := filepath.Dir(lockfile)
+	// the hidden lock file is in node_modules/.pnpm of the project
+	if filepath.Base(lockfile) == filepath.Base(PnpmHiddenLockFile) && filepath.Base(dir) == ".pnpm" {
+		return filepath.Dir(filepath.Dir(dir))
+	}
+	return dir
+}
+
+func readPackageJson(afs *afero.Afero, path string) (*packageJson, error) {
+	f, err := afs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := (&PackageJsonParser{}).Parse(f, path)
+	if err != nil {
+		return nil, err
+	}
+	return info.(*packageJson), nil
+}
+
+func (w *workspace) Root() *Package {
+	return w.root
+}
+
+func (w *workspace) Direct() []*Package {
+	return w.lock.Direct()
+}
+
+func (w *workspace) Transitive() []*Package {
+	transitive := w.lock.Transitive()
+	// workspace packages are linked and not listed as packages in lock files
+	for _, pkg := range append([]*Package{w.root}, w.members...) {
+		if pkg == nil {
+			continue
+		}
+		if !slices.ContainsFunc(transitive, func(p *Package) bool { return p.Purl == pkg.Purl }) {
+			transitive = append(transitive, pkg)
+		}
+	}
+	return transitive
+}
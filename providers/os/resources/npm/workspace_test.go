@@ -0,0 +1,76 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFs copies files from testdata into an in-memory filesystem
+func newTestFs(t *testing.T, files map[string]string) afero.Fs {
+	fs := afero.NewMemMapFs()
+	for target, fixture := range files {
+		data, err := os.ReadFile(fixture)
+		require.NoError(t, err)
+		require.NoError(t, fs.MkdirAll(filepath.Dir(target), 0o755))
+		require.NoError(t, afero.WriteFile(fs, target, data, 0o644))
+	}
+	return fs
+}
+
+func TestParseYarnBerryWorkspace(t *testing.T) {
+	fs := newTestFs(t, map[string]string{
+		"/app/yarn.lock":                 "./testdata/yarn-berry/yarn.lock",
+		"/app/package.json":              "./testdata/yarn-berry/package.json",
+		"/app/packages/app/package.json": "./testdata/yarn-berry/packages/app/package.json",
+	})
+
+	info, files, err := ParseWorkspace(fs, "/app/yarn.lock")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/app/yarn.lock", "/app/package.json", "/app/packages/app/package.json"}, files)
+
+	root := info.Root()
+	require.NotNil(t, root)
+	assert.Equal(t, "acme", root.Name)
+
+	transitive := info.Transitive()
+	// resolved packages, the root and the workspace package
+	assert.Equal(t, 7, len(transitive))
+
+	// dev dependencies are read from package.json
+	p := findPkg(transitive, "typescript")
+	assert.True(t, p.Dev)
+	p = findPkg(transitive, "react")
+	assert.False(t, p.Dev)
+	p = findPkg(transitive, "loose-envify")
+	assert.False(t, p.Dev)
+
+	p = findPkg(transitive, "@acme/app")
+	assert.Equal(t, "1.0.0", p.Version)
+	assert.Equal(t, []string{"/app/packages/app/package.json"}, p.EvidenceLocations)
+
+	assert.Equal(t, 3, len(info.Direct()))
+}
+
+func TestParsePnpmWorkspace(t *testing.T) {
+	fs := newTestFs(t, map[string]string{
+		"/src/node_modules/.pnpm/lock.yaml": "./testdata/pnpm-lock/v9-workspace.yaml",
+		"/src/package.json":                 "./testdata/yarn-berry/package.json",
+	})
+
+	assert.Equal(t, "/src", WorkspaceDir("/src/node_modules/.pnpm/lock.yaml"))
+	assert.Equal(t, "/src", WorkspaceDir("/src/pnpm-lock.yaml"))
+
+	info, files, err := ParseWorkspace(fs, "/src/node_modules/.pnpm/lock.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/src/node_modules/.pnpm/lock.yaml", "/src/package.json"}, files)
+	assert.Equal(t, "acme", info.Root().Name)
+	assert.Equal(t, 8, len(info.Transitive()))
+}
@@ -0,0 +1,166 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package npm
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+var _ WorkspaceInfo = (*yarnBerryLock)(nil)
+
+var yarnBerryMetadata = regexp.MustCompile(`(?m)^__metadata:`)
+
+// yarnBerryLock represents the yarn.lock file of yarn 2 and later, which is
+// yaml and lists the workspace packages next to the resolved packages
+// see https://yarnpkg.com/advanced/lexicon#lockfile
+type yarnBerryLock struct {
+	graph *dependencyGraph
+	// descriptors maps each dependency descriptor, e.g. react@npm:^18.0.0, to
+	// the resolution of the package, e.g. react@npm:18.2.0
+	descriptors map[string]string
+}
+
+type yarnBerryEntry struct {
+	Version              string                         `json:"version"`
+	Resolution           string                         `json:"resolution"`
+	Dependencies         map[string]string              `json:"dependencies"`
+	OptionalDependencies map[string]string              `json:"optionalDependencies"`
+	DependenciesMeta     map[string]yarnBerryDependency `json:"dependenciesMeta"`
+	LanguageName         string                         `json:"languageName"`
+	LinkType             string                         `json:"linkType"`
+}
+
+type yarnBerryDependency struct {
+	Optional bool `json:"optional"`
+}
+
+func parseYarnBerryLock(data []byte, filename string) (*yarnBerryLock, error) {
+	var raw map[string]json.RawMessage
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	entries := map[string]yarnBerryEntry{}
+	lock := &yarnBerryLock{
+		graph:       newDependencyGraph(filename),
+		descriptors: map[string]string{},
+	}
+	for key, value := range raw {
+		if key == "__metadata" {
+			continue
+		}
+		var entry yarnBerryEntry
+		if err := json.Unmarshal(value, &entry); err != nil {
+			return nil, err
+		}
+		entries[entry.Resolution] = entry
+		// one entry may be resolved for multiple descriptors
+		for _, descriptor := range strings.Split(key, ",") {
+			lock.descriptors[strings.TrimSpace(descriptor)] = entry.Resolution
+		}
+	}
+
+	for resolution, entry := range entries {
+		name, reference := splitYarnDescriptor(resolution)
+
+		var deps, optionalDeps []string
+		for depName, depRange := range entry.Dependencies {
+			key, ok := lock.descriptors[depName+"@"+depRange]
+			if !ok {
+				continue
+			}
+			if entry.DependenciesMeta[depName].Optional {
+				optionalDeps = append(optionalDeps, key)
+			} else {
+				deps = append(deps, key)
+			}
+		}
+		for depName, depRange := range entry.OptionalDependencies {
+			if key, ok := lock.descriptors[depName+"@"+depRange]; ok {
+				optionalDeps = append(optionalDeps, key)
+			}
+		}
+
+		// workspace packages are the importers of the project
+		if path, ok := strings.CutPrefix(reference, "workspace:"); ok {
+			lock.graph.importers = append(lock.graph.importers, &graphImporter{
+				path:                 path,
+				dependencies:         deps,
+				optionalDependencies: optionalDeps,
+			})
+			continue
+		}
+
+		lock.graph.nodes[resolution] = &graphNode{
+			name:                 name,
+			version:              entry.Version,
+			dependencies:         deps,
+			optionalDependencies: optionalDeps,
+		}
+	}
+	return lock, nil
+}
+
+// splitYarnDescriptor splits a descriptor or resolution into name and
+// reference, e.g. @babel/core@npm:7.24.0 into @babel/core and npm:7.24.0
+func splitYarnDescriptor(descriptor string) (string, string) {
+	// the name of scoped packages starts with @
+	i := strings.Index(descriptor[min(1, len(descriptor)):], "@")
+	if i < 0 {
+		return descriptor, ""
+	}
+	i++
+	return descriptor[:i], descriptor[i+1:]
+}
+
+// setDevDependencies moves the dependencies of a workspace to its dev
+// dependencies. yarn does not record dev dependencies in the lock file, they
+// are only defined in the package.json of the workspace.
+func (p *yarnBerryLock) setDevDependencies(path string, devDependencies map[string]string) {
+	for _, imp := range p.graph.importers {
+		if imp.path != path {
+			continue
+		}
+		devKeys := map[string]struct{}{}
+		for name, depRange := range devDependencies {
+			// package.json ranges omit the npm protocol that yarn adds
+			for _, descriptor := range []string{name + "@" + depRange, name + "@npm:" + depRange} {
+				if key, ok := p.descriptors[descriptor]; ok {
+					devKeys[key] = struct{}{}
+				}
+			}
+		}
+
+		deps := []string{}
+		for _, key := range imp.dependencies {
+			if _, ok := devKeys[key]; ok {
+				imp.devDependencies = append(imp.devDependencies, key)
+			} else {
+				deps = append(deps, key)
+			}
+		}
+		imp.dependencies = deps
+	}
+}
+
+func (p *yarnBerryLock) Root() *Package {
+	// the root package is only defined in package.json
+	return nil
+}
+
+func (p *yarnBerryLock) Direct() []*Package {
+	return p.graph.direct()
+}
+
+func (p *yarnBerryLock) Transitive() []*Package {
+	return p.graph.packages()
+}
+
+func (p *yarnBerryLock) Workspaces() []string {
+	return p.graph.workspaces()
+}
@@ -30,10 +30,20 @@ type yarnLockEntry struct {
 type YarnLockParser struct{}
 
 func (p *YarnLockParser) Parse(r io.Reader, filename string) (NpmPackageInfo, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// yarn 2+ (berry) writes lock files in yaml with a metadata entry
+	if yarnBerryMetadata.Match(data) {
+		return parseYarnBerryLock(data, filename)
+	}
+
 	var b bytes.Buffer
 
 	// iterate and convert the format to yaml on the fly
-	scanner := bufio.NewScanner(r)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 	for scanner.Scan() {
 		line := scanner.Text()
 
@@ -50,7 +60,7 @@ func (p *YarnLockParser) Parse(r io.Reader, filename string) (NpmPackageInfo, er
 
 	var yarnLock yarnLock
 
-	err := yaml.Unmarshal(b.Bytes(), &yarnLock)
+	err = yaml.Unmarshal(b.Bytes(), &yarnLock)
 	if err != nil {
 		return nil, err
 	}
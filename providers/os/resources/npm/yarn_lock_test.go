@@ -64,3 +64,35 @@ func TestParsePackagename(t *testing.T) {
 	assert.Equal(t, "has", name)
 	assert.Equal(t, "^1.0.1", version)
 }
+
+func TestYarnBerryParser(t *testing.T) {
+	f, err := os.Open("./testdata/yarn-berry/yarn.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := (&YarnLockParser{}).Parse(f, "/app/yarn.lock")
+	require.NoError(t, err)
+
+	// workspace packages are not part of the resolved packages
+	transitive := info.Transitive()
+	assert.Equal(t, 5, len(transitive))
+
+	p := findPkg(transitive, "react")
+	assert.Equal(t, &Package{
+		Name:              "react",
+		Version:           "18.2.0",
+		Purl:              "pkg:npm/react@18.2.0",
+		Cpes:              []string{"cpe:2.3:a:react:react:18.2.0:*:*:*:*:*:*:*"},
+		EvidenceLocations: []string{"/app/yarn.lock"},
+		Dependencies:      []string{"pkg:npm/loose-envify@1.4.0"},
+	}, p)
+
+	p = findPkg(transitive, "fsevents")
+	assert.True(t, p.Optional)
+
+	// the patched typescript resolves to the same package
+	p = findPkg(transitive, "typescript")
+	assert.Equal(t, "5.4.5", p.Version)
+
+	assert.Equal(t, []string{".", "packages/app"}, info.(WorkspaceInfo).Workspaces())
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/npm"
+)
+
+func TestLinkNpmDependencies(t *testing.T) {
+	pkgs := []*npm.Package{
+		{Purl: "pkg:npm/debug@4.3.4", Dependencies: []string{"pkg:npm/ms@2.1.2"}},
+		{Purl: "pkg:npm/ms@2.1.2", Dependencies: []string{}},
+		{Purl: "pkg:npm/express@4.18.2"},
+	}
+	mqlPkgs := map[string]*mqlNpmPackage{}
+	for _, pkg := range pkgs {
+		mqlPkgs[pkg.Purl] = &mqlNpmPackage{}
+	}
+
+	linkNpmDependencies(pkgs, mqlPkgs)
+
+	debug := mqlPkgs["pkg:npm/debug@4.3.4"].Dependencies
+	assert.Equal(t, plugin.StateIsSet, debug.State)
+	require.Len(t, debug.Data, 1)
+	assert.Same(t, mqlPkgs["pkg:npm/ms@2.1.2"], debug.Data[0])
+
+	// packages from a dependency graph without dependencies have an empty list
+	ms := mqlPkgs["pkg:npm/ms@2.1.2"].Dependencies
+	assert.Equal(t, plugin.StateIsSet, ms.State)
+	assert.NotNil(t, ms.Data)
+	assert.Empty(t, ms.Data)
+
+	// packages without a dependency graph are left to resolve to null
+	express := mqlPkgs["pkg:npm/express@4.18.2"]
+	assert.False(t, express.Dependencies.IsSet())
+	deps, err := express.dependencies()
+	require.NoError(t, err)
+	assert.Nil(t, deps)
+	assert.Equal(t, plugin.StateIsSet|plugin.StateIsNull, express.Dependencies.State)
+}
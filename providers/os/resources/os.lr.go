@@ -202,6 +202,10 @@ func init() {
 			// to override args, implement: initPkgFileInfo(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createPkgFileInfo,
 		},
+		"pkgFileSearch": {
+			// to override args, implement: initPkgFileSearch(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createPkgFileSearch,
+		},
 		"packages": {
 			// to override args, implement: initPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createPackages,
@@ -226,6 +230,22 @@ func init() {
 			// to override args, implement: initSshdConfigMatchBlock(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createSshdConfigMatchBlock,
 		},
+		"sudoers": {
+			Init: initSudoers,
+			Create: createSudoers,
+		},
+		"sudoers.alias": {
+			// to override args, implement: initSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSudoersAlias,
+		},
+		"sudoers.default": {
+			// to override args, implement: initSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSudoersDefault,
+		},
+		"sudoers.userSpec": {
+			// to override args, implement: initSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSudoersUserSpec,
+		},
 		"service": {
 			Init: initService,
 			Create: createService,
@@ -234,6 +254,58 @@ func init() {
 			// to override args, implement: initServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createServices,
 		},
+		"cron": {
+			// to override args, implement: initCron(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createCron,
+		},
+		"cron.entry": {
+			// to override args, implement: initCronEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createCronEntry,
+		},
+		"systemd.timers": {
+			// to override args, implement: initSystemdTimers(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSystemdTimers,
+		},
+		"systemd.timer": {
+			// to override args, implement: initSystemdTimer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSystemdTimer,
+		},
+		"systemd.units": {
+			// to override args, implement: initSystemdUnits(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSystemdUnits,
+		},
+		"systemd.unit": {
+			Init: initSystemdUnit,
+			Create: createSystemdUnit,
+		},
+		"selinux": {
+			Init: initSelinux,
+			Create: createSelinux,
+		},
+		"selinux.boolean": {
+			// to override args, implement: initSelinuxBoolean(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinuxBoolean,
+		},
+		"selinux.module": {
+			// to override args, implement: initSelinuxModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinuxModule,
+		},
+		"selinux.fileContext": {
+			Init: initSelinuxFileContext,
+			Create: createSelinuxFileContext,
+		},
+		"apparmor": {
+			// to override args, implement: initApparmor(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createApparmor,
+		},
+		"apparmor.profile": {
+			// to override args, implement: initApparmorProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createApparmorProfile,
+		},
+		"apparmor.process": {
+			// to override args, implement: initApparmorProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createApparmorProcess,
+		},
 		"kernel": {
 			Init: initKernel,
 			Create: createKernel,
@@ -322,6 +394,34 @@ func init() {
 			// to override args, implement: initAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createAuditpolEntry,
 		},
+		"auditd.config": {
+			Init: initAuditdConfig,
+			Create: createAuditdConfig,
+		},
+		"auditd.rules": {
+			Init: initAuditdRules,
+			Create: createAuditdRules,
+		},
+		"auditd.loadedRules": {
+			// to override args, implement: initAuditdLoadedRules(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createAuditdLoadedRules,
+		},
+		"auditd.rule.control": {
+			// to override args, implement: initAuditdRuleControl(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createAuditdRuleControl,
+		},
+		"auditd.rule.file": {
+			// to override args, implement: initAuditdRuleFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createAuditdRuleFile,
+		},
+		"auditd.rule.syscall": {
+			// to override args, implement: initAuditdRuleSyscall(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createAuditdRuleSyscall,
+		},
+		"auditd.rule.field": {
+			// to override args, implement: initAuditdRuleField(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createAuditdRuleField,
+		},
 		"secpol": {
 			// to override args, implement: initSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createSecpol,
@@ -382,6 +482,10 @@ func init() {
 			Init: initContainerImage,
 			Create: createContainerImage,
 		},
+		"container.image.layer": {
+			// to override args, implement: initContainerImageLayer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createContainerImageLayer,
+		},
 		"container.repository": {
 			// to override args, implement: initContainerRepository(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createContainerRepository,
@@ -406,6 +510,58 @@ func init() {
 			// to override args, implement: initNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createNpmPackage,
 		},
+		"java.packages": {
+			Init: initJavaPackages,
+			Create: createJavaPackages,
+		},
+		"java.package": {
+			// to override args, implement: initJavaPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createJavaPackage,
+		},
+		"golang.binaries": {
+			Init: initGolangBinaries,
+			Create: createGolangBinaries,
+		},
+		"golang.binary": {
+			// to override args, implement: initGolangBinary(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createGolangBinary,
+		},
+		"golang.package": {
+			// to override args, implement: initGolangPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createGolangPackage,
+		},
+		"rust.packages": {
+			Init: initRustPackages,
+			Create: createRustPackages,
+		},
+		"rust.package": {
+			// to override args, implement: initRustPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRustPackage,
+		},
+		"ruby.packages": {
+			Init: initRubyPackages,
+			Create: createRubyPackages,
+		},
+		"ruby.package": {
+			// to override args, implement: initRubyPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRubyPackage,
+		},
+		"php.packages": {
+			Init: initPhpPackages,
+			Create: createPhpPackages,
+		},
+		"php.package": {
+			// to override args, implement: initPhpPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createPhpPackage,
+		},
+		"dotnet.packages": {
+			Init: initDotnetPackages,
+			Create: createDotnetPackages,
+		},
+		"dotnet.package": {
+			// to override args, implement: initDotnetPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDotnetPackage,
+		},
 		"macos": {
 			// to override args, implement: initMacos(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createMacos,
@@ -573,6 +729,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"vulnmgmt.cves": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnmgmt).GetCves()).ToDataRes(types.Array(types.Resource("vuln.cve")))
 	},
+	"vulnmgmt.suppressedCves": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnmgmt).GetSuppressedCves()).ToDataRes(types.Array(types.Resource("vuln.cve")))
+	},
 	"vulnmgmt.advisories": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnmgmt).GetAdvisories()).ToDataRes(types.Array(types.Resource("vuln.advisory")))
 	},
@@ -606,6 +765,15 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"vuln.cve.worstScore": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnCve).GetWorstScore()).ToDataRes(types.Resource("audit.cvss"))
 	},
+	"vuln.cve.vexStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnCve).GetVexStatus()).ToDataRes(types.String)
+	},
+	"vuln.cve.vexJustification": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnCve).GetVexJustification()).ToDataRes(types.String)
+	},
+	"vuln.cve.vexImpactStatement": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnCve).GetVexImpactStatement()).ToDataRes(types.String)
+	},
 	"vuln.advisory.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnAdvisory).GetId()).ToDataRes(types.String)
 	},
@@ -1164,6 +1332,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"package.origin": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPackage).GetOrigin()).ToDataRes(types.String)
 	},
+	"package.license": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetLicense()).ToDataRes(types.String)
+	},
 	"package.available": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPackage).GetAvailable()).ToDataRes(types.String)
 	},
@@ -1176,6 +1347,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"package.files": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
 	},
+	"package.layer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPackage).GetLayer()).ToDataRes(types.Resource("container.image.layer"))
+	},
 	"pkgFileInfo.path": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPkgFileInfo).GetPath()).ToDataRes(types.String)
 	},
@@ -1248,6 +1422,87 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"sshd.config.matchBlock.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlSshdConfigMatchBlock).GetParams()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"sudoers.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoers).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"sudoers.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoers).GetFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
+	"sudoers.aliases": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoers).GetAliases()).ToDataRes(types.Array(types.Resource("sudoers.alias")))
+	},
+	"sudoers.defaults": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoers).GetDefaults()).ToDataRes(types.Array(types.Resource("sudoers.default")))
+	},
+	"sudoers.userSpecs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoers).GetUserSpecs()).ToDataRes(types.Array(types.Resource("sudoers.userSpec")))
+	},
+	"sudoers.alias.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersAlias).GetType()).ToDataRes(types.String)
+	},
+	"sudoers.alias.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersAlias).GetName()).ToDataRes(types.String)
+	},
+	"sudoers.alias.members": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersAlias).GetMembers()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.alias.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersAlias).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"sudoers.alias.lineNumber": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersAlias).GetLineNumber()).ToDataRes(types.Int)
+	},
+	"sudoers.default.binding": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetBinding()).ToDataRes(types.String)
+	},
+	"sudoers.default.targets": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetTargets()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.default.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetName()).ToDataRes(types.String)
+	},
+	"sudoers.default.operator": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetOperator()).ToDataRes(types.String)
+	},
+	"sudoers.default.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetValue()).ToDataRes(types.String)
+	},
+	"sudoers.default.negated": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetNegated()).ToDataRes(types.Bool)
+	},
+	"sudoers.default.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"sudoers.default.lineNumber": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersDefault).GetLineNumber()).ToDataRes(types.Int)
+	},
+	"sudoers.userSpec.users": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetUsers()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.hosts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetHosts()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.runasUsers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetRunasUsers()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.runasGroups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetRunasGroups()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.commands": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetCommands()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.tags": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetTags()).ToDataRes(types.Array(types.String))
+	},
+	"sudoers.userSpec.noPassword": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetNoPassword()).ToDataRes(types.Bool)
+	},
+	"sudoers.userSpec.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"sudoers.userSpec.lineNumber": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSudoersUserSpec).GetLineNumber()).ToDataRes(types.Int)
+	},
 	"service.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlService).GetName()).ToDataRes(types.String)
 	},
@@ -1272,6 +1527,252 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"services.list": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlServices).GetList()).ToDataRes(types.Array(types.Resource("service")))
 	},
+	"cron.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCron).GetList()).ToDataRes(types.Array(types.Resource("cron.entry")))
+	},
+	"cron.entry.schedule": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetSchedule()).ToDataRes(types.String)
+	},
+	"cron.entry.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetUser()).ToDataRes(types.String)
+	},
+	"cron.entry.command": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetCommand()).ToDataRes(types.String)
+	},
+	"cron.entry.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetType()).ToDataRes(types.String)
+	},
+	"cron.entry.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"cron.entry.lineNumber": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCronEntry).GetLineNumber()).ToDataRes(types.Int)
+	},
+	"systemd.timers.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimers).GetList()).ToDataRes(types.Array(types.Resource("systemd.timer")))
+	},
+	"systemd.timer.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetName()).ToDataRes(types.String)
+	},
+	"systemd.timer.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetDescription()).ToDataRes(types.String)
+	},
+	"systemd.timer.unit": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetUnit()).ToDataRes(types.String)
+	},
+	"systemd.timer.service": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetService()).ToDataRes(types.Resource("service"))
+	},
+	"systemd.timer.onCalendar": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetOnCalendar()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.timer.monotonic": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetMonotonic()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.timer.persistent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetPersistent()).ToDataRes(types.Bool)
+	},
+	"systemd.timer.installed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetInstalled()).ToDataRes(types.Bool)
+	},
+	"systemd.timer.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"systemd.timer.masked": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetMasked()).ToDataRes(types.Bool)
+	},
+	"systemd.timer.lastTrigger": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetLastTrigger()).ToDataRes(types.Time)
+	},
+	"systemd.timer.nextTrigger": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdTimer).GetNextTrigger()).ToDataRes(types.Time)
+	},
+	"systemd.units.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnits).GetList()).ToDataRes(types.Array(types.Resource("systemd.unit")))
+	},
+	"systemd.unit.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetName()).ToDataRes(types.String)
+	},
+	"systemd.unit.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetPath()).ToDataRes(types.String)
+	},
+	"systemd.unit.dropIns": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetDropIns()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.masked": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetMasked()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetDescription()).ToDataRes(types.String)
+	},
+	"systemd.unit.options": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetOptions()).ToDataRes(types.Dict)
+	},
+	"systemd.unit.execStart": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetExecStart()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetUser()).ToDataRes(types.String)
+	},
+	"systemd.unit.group": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetGroup()).ToDataRes(types.String)
+	},
+	"systemd.unit.dynamicUser": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetDynamicUser()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.supplementaryGroups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetSupplementaryGroups()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.protectSystem": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectSystem()).ToDataRes(types.String)
+	},
+	"systemd.unit.protectHome": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectHome()).ToDataRes(types.String)
+	},
+	"systemd.unit.privateTmp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetPrivateTmp()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.privateDevices": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetPrivateDevices()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.privateNetwork": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetPrivateNetwork()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.privateUsers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetPrivateUsers()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectKernelTunables": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectKernelTunables()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectKernelModules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectKernelModules()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectKernelLogs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectKernelLogs()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectControlGroups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectControlGroups()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectClock": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectClock()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.protectHostname": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetProtectHostname()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.noNewPrivileges": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetNoNewPrivileges()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.restrictRealtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetRestrictRealtime()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.restrictSUIDSGID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetRestrictSUIDSGID()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.lockPersonality": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetLockPersonality()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.memoryDenyWriteExecute": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetMemoryDenyWriteExecute()).ToDataRes(types.Bool)
+	},
+	"systemd.unit.capabilityBoundingSet": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetCapabilityBoundingSet()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.ambientCapabilities": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetAmbientCapabilities()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.restrictAddressFamilies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetRestrictAddressFamilies()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.restrictNamespaces": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetRestrictNamespaces()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.systemCallFilter": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetSystemCallFilter()).ToDataRes(types.Array(types.String))
+	},
+	"systemd.unit.exposure": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetExposure()).ToDataRes(types.Float)
+	},
+	"systemd.unit.exposureLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetExposureLevel()).ToDataRes(types.String)
+	},
+	"systemd.unit.securityChecks": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSystemdUnit).GetSecurityChecks()).ToDataRes(types.Array(types.Dict))
+	},
+	"selinux.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"selinux.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetMode()).ToDataRes(types.String)
+	},
+	"selinux.configMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetConfigMode()).ToDataRes(types.String)
+	},
+	"selinux.policyType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetPolicyType()).ToDataRes(types.String)
+	},
+	"selinux.policyVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetPolicyVersion()).ToDataRes(types.Int)
+	},
+	"selinux.booleans": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetBooleans()).ToDataRes(types.Array(types.Resource("selinux.boolean")))
+	},
+	"selinux.modules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetModules()).ToDataRes(types.Array(types.Resource("selinux.module")))
+	},
+	"selinux.boolean.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetName()).ToDataRes(types.String)
+	},
+	"selinux.boolean.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetValue()).ToDataRes(types.Bool)
+	},
+	"selinux.boolean.pending": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetPending()).ToDataRes(types.Bool)
+	},
+	"selinux.module.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetName()).ToDataRes(types.String)
+	},
+	"selinux.module.priority": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetPriority()).ToDataRes(types.Int)
+	},
+	"selinux.module.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"selinux.fileContext.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetPath()).ToDataRes(types.String)
+	},
+	"selinux.fileContext.context": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetContext()).ToDataRes(types.String)
+	},
+	"apparmor.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"apparmor.profiles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetProfiles()).ToDataRes(types.Array(types.Resource("apparmor.profile")))
+	},
+	"apparmor.processes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetProcesses()).ToDataRes(types.Array(types.Resource("apparmor.process")))
+	},
+	"apparmor.profile.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProfile).GetName()).ToDataRes(types.String)
+	},
+	"apparmor.profile.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProfile).GetMode()).ToDataRes(types.String)
+	},
+	"apparmor.process.pid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProcess).GetPid()).ToDataRes(types.Int)
+	},
+	"apparmor.process.command": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProcess).GetCommand()).ToDataRes(types.String)
+	},
+	"apparmor.process.profile": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProcess).GetProfile()).ToDataRes(types.String)
+	},
+	"apparmor.process.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProcess).GetMode()).ToDataRes(types.String)
+	},
+	"apparmor.process.process": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProcess).GetProcess()).ToDataRes(types.Resource("process"))
+	},
 	"kernel.info": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlKernel).GetInfo()).ToDataRes(types.Dict)
 	},
@@ -1557,6 +2058,75 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"auditpol.entry.exclusionsetting": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlAuditpolEntry).GetExclusionsetting()).ToDataRes(types.String)
 	},
+	"auditd.config.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdConfig).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"auditd.config.content": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdConfig).GetContent()).ToDataRes(types.String)
+	},
+	"auditd.config.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdConfig).GetParams()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"auditd.rules.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRules).GetPath()).ToDataRes(types.String)
+	},
+	"auditd.rules.controls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRules).GetControls()).ToDataRes(types.Array(types.Resource("auditd.rule.control")))
+	},
+	"auditd.rules.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRules).GetFiles()).ToDataRes(types.Array(types.Resource("auditd.rule.file")))
+	},
+	"auditd.rules.syscalls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRules).GetSyscalls()).ToDataRes(types.Array(types.Resource("auditd.rule.syscall")))
+	},
+	"auditd.loadedRules.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdLoadedRules).GetFiles()).ToDataRes(types.Array(types.Resource("auditd.rule.file")))
+	},
+	"auditd.loadedRules.syscalls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdLoadedRules).GetSyscalls()).ToDataRes(types.Array(types.Resource("auditd.rule.syscall")))
+	},
+	"auditd.rule.control.flag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleControl).GetFlag()).ToDataRes(types.String)
+	},
+	"auditd.rule.control.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleControl).GetValue()).ToDataRes(types.String)
+	},
+	"auditd.rule.file.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleFile).GetPath()).ToDataRes(types.String)
+	},
+	"auditd.rule.file.permissions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleFile).GetPermissions()).ToDataRes(types.String)
+	},
+	"auditd.rule.file.keys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleFile).GetKeys()).ToDataRes(types.Array(types.String))
+	},
+	"auditd.rule.syscall.action": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetAction()).ToDataRes(types.String)
+	},
+	"auditd.rule.syscall.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetList()).ToDataRes(types.String)
+	},
+	"auditd.rule.syscall.syscalls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetSyscalls()).ToDataRes(types.Array(types.String))
+	},
+	"auditd.rule.syscall.fields": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetFields()).ToDataRes(types.Array(types.Resource("auditd.rule.field")))
+	},
+	"auditd.rule.syscall.permissions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetPermissions()).ToDataRes(types.String)
+	},
+	"auditd.rule.syscall.keys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleSyscall).GetKeys()).ToDataRes(types.Array(types.String))
+	},
+	"auditd.rule.field.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleField).GetName()).ToDataRes(types.String)
+	},
+	"auditd.rule.field.op": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleField).GetOp()).ToDataRes(types.String)
+	},
+	"auditd.rule.field.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlAuditdRuleField).GetValue()).ToDataRes(types.String)
+	},
 	"secpol.systemaccess": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlSecpol).GetSystemaccess()).ToDataRes(types.Map(types.String, types.String))
 	},
@@ -1764,6 +2334,21 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"container.image.repository": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlContainerImage).GetRepository()).ToDataRes(types.Resource("container.repository"))
 	},
+	"container.image.layers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlContainerImage).GetLayers()).ToDataRes(types.Array(types.Resource("container.image.layer")))
+	},
+	"container.image.layer.index": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlContainerImageLayer).GetIndex()).ToDataRes(types.Int)
+	},
+	"container.image.layer.digest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlContainerImageLayer).GetDigest()).ToDataRes(types.String)
+	},
+	"container.image.layer.createdBy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlContainerImageLayer).GetCreatedBy()).ToDataRes(types.String)
+	},
+	"container.image.layer.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlContainerImageLayer).GetCreated()).ToDataRes(types.Time)
+	},
 	"container.repository.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlContainerRepository).GetName()).ToDataRes(types.String)
 	},
@@ -1827,6 +2412,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"python.package.dependencies": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPythonPackage).GetDependencies()).ToDataRes(types.Array(types.Resource("python.package")))
 	},
+	"python.package.layer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPythonPackage).GetLayer()).ToDataRes(types.Resource("container.image.layer"))
+	},
 	"npm.packages.path": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNpmPackages).GetPath()).ToDataRes(types.String)
 	},
@@ -1857,9 +2445,204 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"npm.package.cpes": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNpmPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
 	},
+	"npm.package.license": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetLicense()).ToDataRes(types.String)
+	},
 	"npm.package.files": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNpmPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
 	},
+	"npm.package.dev": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetDev()).ToDataRes(types.Bool)
+	},
+	"npm.package.optional": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetOptional()).ToDataRes(types.Bool)
+	},
+	"npm.package.dependencies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetDependencies()).ToDataRes(types.Array(types.Resource("npm.package")))
+	},
+	"npm.package.layer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNpmPackage).GetLayer()).ToDataRes(types.Resource("container.image.layer"))
+	},
+	"java.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetPath()).ToDataRes(types.String)
+	},
+	"java.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"java.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetList()).ToDataRes(types.Array(types.Resource("java.package")))
+	},
+	"java.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetId()).ToDataRes(types.String)
+	},
+	"java.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetName()).ToDataRes(types.String)
+	},
+	"java.package.groupId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetGroupId()).ToDataRes(types.String)
+	},
+	"java.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"java.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"java.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"java.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"golang.binaries.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinaries).GetPath()).ToDataRes(types.String)
+	},
+	"golang.binaries.packages": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinaries).GetPackages()).ToDataRes(types.Array(types.Resource("golang.package")))
+	},
+	"golang.binaries.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinaries).GetList()).ToDataRes(types.Array(types.Resource("golang.binary")))
+	},
+	"golang.binary.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinary).GetPath()).ToDataRes(types.String)
+	},
+	"golang.binary.goVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinary).GetGoVersion()).ToDataRes(types.String)
+	},
+	"golang.binary.mainModule": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinary).GetMainModule()).ToDataRes(types.Resource("golang.package"))
+	},
+	"golang.binary.dependencies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinary).GetDependencies()).ToDataRes(types.Array(types.Resource("golang.package")))
+	},
+	"golang.binary.settings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangBinary).GetSettings()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"golang.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetId()).ToDataRes(types.String)
+	},
+	"golang.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetName()).ToDataRes(types.String)
+	},
+	"golang.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"golang.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"golang.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"golang.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGolangPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"rust.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetPath()).ToDataRes(types.String)
+	},
+	"rust.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"rust.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetList()).ToDataRes(types.Array(types.Resource("rust.package")))
+	},
+	"rust.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetId()).ToDataRes(types.String)
+	},
+	"rust.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetName()).ToDataRes(types.String)
+	},
+	"rust.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"rust.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"rust.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"rust.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"ruby.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetPath()).ToDataRes(types.String)
+	},
+	"ruby.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"ruby.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetList()).ToDataRes(types.Array(types.Resource("ruby.package")))
+	},
+	"ruby.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetId()).ToDataRes(types.String)
+	},
+	"ruby.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetName()).ToDataRes(types.String)
+	},
+	"ruby.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"ruby.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"ruby.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"ruby.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"php.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetPath()).ToDataRes(types.String)
+	},
+	"php.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"php.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetList()).ToDataRes(types.Array(types.Resource("php.package")))
+	},
+	"php.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetId()).ToDataRes(types.String)
+	},
+	"php.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetName()).ToDataRes(types.String)
+	},
+	"php.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"php.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"php.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"php.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"dotnet.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackages).GetPath()).ToDataRes(types.String)
+	},
+	"dotnet.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"dotnet.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackages).GetList()).ToDataRes(types.Array(types.Resource("dotnet.package")))
+	},
+	"dotnet.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetId()).ToDataRes(types.String)
+	},
+	"dotnet.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetName()).ToDataRes(types.String)
+	},
+	"dotnet.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"dotnet.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"dotnet.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"dotnet.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDotnetPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
 	"macos.userPreferences": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlMacos).GetUserPreferences()).ToDataRes(types.Map(types.String, types.Dict))
 	},
@@ -2278,6 +3061,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlVulnmgmt).Cves, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"vulnmgmt.suppressedCves": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnmgmt).SuppressedCves, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
 	"vulnmgmt.advisories": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlVulnmgmt).Advisories, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
@@ -2326,6 +3113,18 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlVulnCve).WorstScore, ok = plugin.RawToTValue[*mqlAuditCvss](v.Value, v.Error)
 		return
 	},
+	"vuln.cve.vexStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnCve).VexStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vuln.cve.vexJustification": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnCve).VexJustification, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vuln.cve.vexImpactStatement": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnCve).VexImpactStatement, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"vuln.advisory.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlVulnAdvisory).__id, ok = v.Value.(string)
 			return
@@ -3222,6 +4021,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlPackage).Origin, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"package.license": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).License, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"package.available": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlPackage).Available, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
@@ -3238,6 +4041,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"package.layer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPackage).Layer, ok = plugin.RawToTValue[*mqlContainerImageLayer](v.Value, v.Error)
+		return
+	},
 	"pkgFileInfo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlPkgFileInfo).__id, ok = v.Value.(string)
 			return
@@ -3246,6 +4053,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlPkgFileInfo).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"pkgFileSearch.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPkgFileSearch).__id, ok = v.Value.(string)
+			return
+		},
 	"packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlPackages).__id, ok = v.Value.(string)
 			return
@@ -3362,1550 +4173,5933 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlSshdConfigMatchBlock).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"service.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlService).__id, ok = v.Value.(string)
+	"sudoers.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSudoers).__id, ok = v.Value.(string)
 			return
 		},
-	"service.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"service.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"service.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"sudoers.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoers).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"service.running": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Running, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"sudoers.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoers).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"service.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"sudoers.aliases": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoers).Aliases, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"service.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"sudoers.defaults": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoers).Defaults, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"service.masked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlService).Masked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"sudoers.userSpecs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoers).UserSpecs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"services.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlServices).__id, ok = v.Value.(string)
+	"sudoers.alias.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSudoersAlias).__id, ok = v.Value.(string)
 			return
 		},
-	"services.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlServices).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.alias.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersAlias).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"kernel.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlKernel).__id, ok = v.Value.(string)
-			return
-		},
-	"kernel.info": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernel).Info, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"sudoers.alias.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersAlias).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"kernel.parameters": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernel).Parameters, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"sudoers.alias.members": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersAlias).Members, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"kernel.modules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernel).Modules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.alias.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersAlias).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"kernel.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernel).Installed, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.alias.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersAlias).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"kernel.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlKernelModule).__id, ok = v.Value.(string)
+	"sudoers.default.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSudoersDefault).__id, ok = v.Value.(string)
 			return
 		},
-	"kernel.module.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernelModule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"sudoers.default.binding": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Binding, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"kernel.module.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernelModule).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"sudoers.default.targets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Targets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"kernel.module.loaded": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKernelModule).Loaded, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"sudoers.default.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDocker).__id, ok = v.Value.(string)
-			return
-		},
-	"docker.images": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDocker).Images, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.default.operator": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Operator, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDocker).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.default.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFile).__id, ok = v.Value.(string)
-			return
-		},
-	"docker.file.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFile).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"sudoers.default.negated": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).Negated, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.file.instructions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFile).Instructions, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"sudoers.default.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"docker.file.stages": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFile).Stages, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.default.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersDefault).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileStage).__id, ok = v.Value.(string)
+	"sudoers.userSpec.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSudoersUserSpec).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.file.stage.from": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).From, ok = plugin.RawToTValue[*mqlDockerFileFrom](v.Value, v.Error)
+	"sudoers.userSpec.users": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).Users, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).File, ok = plugin.RawToTValue[*mqlDockerFile](v.Value, v.Error)
+	"sudoers.userSpec.hosts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).Hosts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Env, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"sudoers.userSpec.runasUsers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).RunasUsers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"sudoers.userSpec.runasGroups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).RunasGroups, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.run": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Run, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.userSpec.commands": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).Commands, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.cmd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Cmd, ok = plugin.RawToTValue[*mqlDockerFileRun](v.Value, v.Error)
+	"sudoers.userSpec.tags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).Tags, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).User, ok = plugin.RawToTValue[*mqlDockerFileUser](v.Value, v.Error)
+	"sudoers.userSpec.noPassword": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).NoPassword, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.entrypoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Entrypoint, ok = plugin.RawToTValue[*mqlDockerFileRun](v.Value, v.Error)
+	"sudoers.userSpec.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.add": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Add, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"sudoers.userSpec.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSudoersUserSpec).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.copy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Copy, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"service.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlService).__id, ok = v.Value.(string)
+			return
+		},
+	"service.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.stage.expose": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileStage).Expose, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"service.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.user.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileUser).__id, ok = v.Value.(string)
-			return
-		},
-	"docker.file.user.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileUser).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"service.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.file.user.group": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileUser).Group, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"service.running": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Running, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.file.expose.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileExpose).__id, ok = v.Value.(string)
+	"service.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"service.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"service.masked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlService).Masked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"services.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlServices).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.file.expose.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileExpose).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"services.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlServices).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.expose.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileExpose).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCron).__id, ok = v.Value.(string)
+			return
+		},
+	"cron.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCron).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.from.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileFrom).__id, ok = v.Value.(string)
+	"cron.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCronEntry).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.file.from.platform": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileFrom).Platform, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.entry.schedule": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).Schedule, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.from.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileFrom).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.from.tag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileFrom).Tag, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.entry.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.from.digest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileFrom).Digest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.entry.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.from.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileFrom).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"cron.entry.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"docker.file.run.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileRun).__id, ok = v.Value.(string)
+	"cron.entry.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCronEntry).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"systemd.timers.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSystemdTimers).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.file.run.script": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileRun).Script, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timers.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimers).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.add.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileAdd).__id, ok = v.Value.(string)
+	"systemd.timer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSystemdTimer).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.file.add.src": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileAdd).Src, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.timer.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.add.dst": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileAdd).Dst, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.add.chown": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileAdd).Chown, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.unit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Unit, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.file.add.chmod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileAdd).Chmod, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.service": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Service, ok = plugin.RawToTValue[*mqlService](v.Value, v.Error)
 		return
 	},
-	"docker.file.copy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerFileCopy).__id, ok = v.Value.(string)
-			return
-		},
-	"docker.file.copy.src": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileCopy).Src, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.timer.onCalendar": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).OnCalendar, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.copy.dst": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileCopy).Dst, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.monotonic": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Monotonic, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.file.copy.chown": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileCopy).Chown, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.persistent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Persistent, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.file.copy.chmod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerFileCopy).Chmod, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerImage).__id, ok = v.Value.(string)
-			return
-		},
-	"docker.image.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerImage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.timer.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.image.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerImage).Size, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.timer.masked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).Masked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.image.virtualsize": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerImage).Virtualsize, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.timer.lastTrigger": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).LastTrigger, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"docker.image.tags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerImage).Tags, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.timer.nextTrigger": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdTimer).NextTrigger, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"docker.image.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerImage).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"systemd.units.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSystemdUnits).__id, ok = v.Value.(string)
+			return
+		},
+	"systemd.units.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnits).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.container.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDockerContainer).__id, ok = v.Value.(string)
+	"systemd.unit.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSystemdUnit).__id, ok = v.Value.(string)
 			return
 		},
-	"docker.container.os": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Os, ok = plugin.RawToTValue[*mqlOsLinux](v.Value, v.Error)
+	"systemd.unit.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.container.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.container.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.dropIns": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).DropIns, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.container.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.masked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Masked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"docker.container.imageid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Imageid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.container.names": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Names, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Options, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.container.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.execStart": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ExecStart, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"docker.container.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"docker.container.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDockerContainer).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"systemd.unit.group": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Group, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"iptables.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlIptables).__id, ok = v.Value.(string)
-			return
-		},
-	"iptables.input": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptables).Input, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.dynamicUser": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).DynamicUser, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.output": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptables).Output, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.supplementaryGroups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).SupplementaryGroups, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"ip6tables.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlIp6tables).__id, ok = v.Value.(string)
-			return
-		},
-	"ip6tables.input": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIp6tables).Input, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.protectSystem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectSystem, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"ip6tables.output": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIp6tables).Output, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.protectHome": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectHome, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlIptablesEntry).__id, ok = v.Value.(string)
-			return
-		},
-	"iptables.entry.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.unit.privateTmp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).PrivateTmp, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.packets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Packets, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.unit.privateDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).PrivateDevices, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.bytes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Bytes, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.unit.privateNetwork": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).PrivateNetwork, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.target": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Target, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.privateUsers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).PrivateUsers, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectKernelTunables": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectKernelTunables, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.opt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Opt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectKernelModules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectKernelModules, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.in": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).In, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectKernelLogs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectKernelLogs, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.out": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Out, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectControlGroups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectControlGroups, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectClock": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectClock, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.destination": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Destination, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.protectHostname": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ProtectHostname, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Options, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.noNewPrivileges": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).NoNewPrivileges, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"iptables.entry.chain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlIptablesEntry).Chain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.restrictRealtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).RestrictRealtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"process.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlProcess).__id, ok = v.Value.(string)
-			return
-		},
-	"process.pid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcess).Pid, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.unit.restrictSUIDSGID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).RestrictSUIDSGID, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"process.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcess).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.lockPersonality": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).LockPersonality, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"process.executable": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcess).Executable, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.memoryDenyWriteExecute": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).MemoryDenyWriteExecute, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"process.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcess).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.capabilityBoundingSet": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).CapabilityBoundingSet, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"process.flags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcess).Flags, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"systemd.unit.ambientCapabilities": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).AmbientCapabilities, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"processes.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlProcesses).__id, ok = v.Value.(string)
-			return
-		},
-	"processes.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlProcesses).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"systemd.unit.restrictAddressFamilies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).RestrictAddressFamilies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"port.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPort).__id, ok = v.Value.(string)
-			return
-		},
-	"port.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.restrictNamespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).RestrictNamespaces, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"port.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"systemd.unit.systemCallFilter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).SystemCallFilter, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"port.address": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).Address, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"systemd.unit.exposure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).Exposure, ok = plugin.RawToTValue[float64](v.Value, v.Error)
 		return
 	},
-	"port.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).User, ok = plugin.RawToTValue[*mqlUser](v.Value, v.Error)
+	"systemd.unit.exposureLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).ExposureLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"port.process": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).Process, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
+	"systemd.unit.securityChecks": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSystemdUnit).SecurityChecks, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"port.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSelinux).__id, ok = v.Value.(string)
+			return
+		},
+	"selinux.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"port.remoteAddress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).RemoteAddress, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"port.remotePort": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).RemotePort, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"selinux.configMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).ConfigMode, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"port.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPort).Tls, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+	"selinux.policyType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).PolicyType, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"ports.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPorts).__id, ok = v.Value.(string)
-			return
-		},
-	"ports.listening": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPorts).Listening, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"selinux.policyVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).PolicyVersion, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"ports.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPorts).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"selinux.booleans": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Booleans, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"auditpol.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlAuditpol).__id, ok = v.Value.(string)
-			return
-		},
-	"auditpol.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpol).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"selinux.modules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Modules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlAuditpolEntry).__id, ok = v.Value.(string)
+	"selinux.boolean.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSelinuxBoolean).__id, ok = v.Value.(string)
 			return
 		},
-	"auditpol.entry.machinename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Machinename, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.boolean.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.policytarget": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Policytarget, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.boolean.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Value, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.subcategory": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Subcategory, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.boolean.pending": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Pending, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.subcategoryguid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Subcategoryguid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSelinuxModule).__id, ok = v.Value.(string)
+			return
+		},
+	"selinux.module.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.inclusionsetting": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Inclusionsetting, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.module.priority": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Priority, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"auditpol.entry.exclusionsetting": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlAuditpolEntry).Exclusionsetting, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"selinux.module.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"secpol.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlSecpol).__id, ok = v.Value.(string)
+	"selinux.fileContext.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSelinuxFileContext).__id, ok = v.Value.(string)
 			return
 		},
-	"secpol.systemaccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlSecpol).Systemaccess, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
-	},
-	"secpol.eventaudit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlSecpol).Eventaudit, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
-	},
-	"secpol.registryvalues": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlSecpol).Registryvalues, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"selinux.fileContext.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"secpol.privilegerights": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlSecpol).Privilegerights, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"selinux.fileContext.context": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).Context, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlNtpConf).__id, ok = v.Value.(string)
+	"apparmor.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlApparmor).__id, ok = v.Value.(string)
 			return
 		},
-	"ntp.conf.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
-		return
-	},
-	"ntp.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"apparmor.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.profiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Profiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Servers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.processes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Processes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.restrict": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Restrict, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.profile.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlApparmorProfile).__id, ok = v.Value.(string)
+			return
+		},
+	"apparmor.profile.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"ntp.conf.fudge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNtpConf).Fudge, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.profile.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlRsyslogConf).__id, ok = v.Value.(string)
+	"apparmor.process.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlApparmorProcess).__id, ok = v.Value.(string)
 			return
 		},
-	"rsyslog.conf.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"apparmor.process.pid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProcess).Pid, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.process.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProcess).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"apparmor.process.profile": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProcess).Profile, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"rsyslog.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRsyslogConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"apparmor.process.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProcess).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"logindefs.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLogindefs).__id, ok = v.Value.(string)
+	"apparmor.process.process": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProcess).Process, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
+		return
+	},
+	"kernel.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlKernel).__id, ok = v.Value.(string)
 			return
 		},
-	"logindefs.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"kernel.info": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernel).Info, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"logindefs.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kernel.parameters": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernel).Parameters, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"logindefs.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLogindefs).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"kernel.modules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernel).Modules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"lsblk.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLsblk).__id, ok = v.Value.(string)
-			return
-		},
-	"lsblk.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblk).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"kernel.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernel).Installed, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlLsblkEntry).__id, ok = v.Value.(string)
+	"kernel.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlKernelModule).__id, ok = v.Value.(string)
 			return
 		},
-	"lsblk.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kernel.module.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernelModule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kernel.module.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernelModule).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.label": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Label, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kernel.module.loaded": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKernelModule).Loaded, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.uuid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Uuid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDocker).__id, ok = v.Value.(string)
+			return
+		},
+	"docker.images": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDocker).Images, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"lsblk.entry.mountpoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlLsblkEntry).Mountpoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDocker).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"mount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMount).__id, ok = v.Value.(string)
+	"docker.file.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFile).__id, ok = v.Value.(string)
 			return
 		},
-	"mount.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMount).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFile).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"mount.point.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMountPoint).__id, ok = v.Value.(string)
-			return
-		},
-	"mount.point.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.instructions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFile).Instructions, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"mount.point.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.stages": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFile).Stages, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"mount.point.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.stage.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileStage).__id, ok = v.Value.(string)
+			return
+		},
+	"docker.file.stage.from": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).From, ok = plugin.RawToTValue[*mqlDockerFileFrom](v.Value, v.Error)
 		return
 	},
-	"mount.point.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Options, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"docker.file.stage.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).File, ok = plugin.RawToTValue[*mqlDockerFile](v.Value, v.Error)
 		return
 	},
-	"mount.point.mounted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMountPoint).Mounted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"docker.file.stage.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Env, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlShadow).__id, ok = v.Value.(string)
-			return
-		},
-	"shadow.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadow).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.stage.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlShadowEntry).__id, ok = v.Value.(string)
-			return
-		},
-	"shadow.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.stage.run": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Run, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.password": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Password, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.stage.cmd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Cmd, ok = plugin.RawToTValue[*mqlDockerFileRun](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.lastchanged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Lastchanged, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"docker.file.stage.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).User, ok = plugin.RawToTValue[*mqlDockerFileUser](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.mindays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Mindays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"docker.file.stage.entrypoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Entrypoint, ok = plugin.RawToTValue[*mqlDockerFileRun](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.maxdays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Maxdays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"docker.file.stage.add": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Add, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.warndays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Warndays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"docker.file.stage.copy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Copy, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.inactivedays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Inactivedays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"docker.file.stage.expose": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileStage).Expose, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.expirydates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Expirydates, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.user.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileUser).__id, ok = v.Value.(string)
+			return
+		},
+	"docker.file.user.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileUser).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"shadow.entry.reserved": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlShadowEntry).Reserved, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.user.group": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileUser).Group, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlYum).__id, ok = v.Value.(string)
+	"docker.file.expose.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileExpose).__id, ok = v.Value.(string)
 			return
 		},
-	"yum.vars": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYum).Vars, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"docker.file.expose.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileExpose).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"yum.repos": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYum).Repos, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.expose.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileExpose).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlYumRepo).__id, ok = v.Value.(string)
+	"docker.file.from.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileFrom).__id, ok = v.Value.(string)
 			return
 		},
-	"yum.repo.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"yum.repo.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"yum.repo.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.from.platform": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileFrom).Platform, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.baseurl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Baseurl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.from.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileFrom).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.expire": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Expire, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.from.tag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileFrom).Tag, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.filename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Filename, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.from.digest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileFrom).Digest, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"docker.file.from.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileFrom).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.revision": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Revision, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.run.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileRun).__id, ok = v.Value.(string)
+			return
+		},
+	"docker.file.run.script": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileRun).Script, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.pkgs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Pkgs, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.add.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileAdd).__id, ok = v.Value.(string)
+			return
+		},
+	"docker.file.add.src": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileAdd).Src, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"yum.repo.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.add.dst": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileAdd).Dst, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.mirrors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Mirrors, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.file.add.chown": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileAdd).Chown, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"yum.repo.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlYumRepo).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"docker.file.add.chmod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileAdd).Chmod, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"registrykey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlRegistrykey).__id, ok = v.Value.(string)
+	"docker.file.copy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerFileCopy).__id, ok = v.Value.(string)
 			return
 		},
-	"registrykey.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykey).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"registrykey.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykey).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"docker.file.copy.src": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileCopy).Src, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"registrykey.properties": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykey).Properties, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"docker.file.copy.dst": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileCopy).Dst, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"registrykey.items": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykey).Items, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.copy.chown": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileCopy).Chown, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"registrykey.children": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykey).Children, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"docker.file.copy.chmod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerFileCopy).Chmod, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"registrykey.property.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlRegistrykeyProperty).__id, ok = v.Value.(string)
+	"docker.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerImage).__id, ok = v.Value.(string)
 			return
 		},
-	"registrykey.property.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"registrykey.property.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.image.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerImage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"registrykey.property.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"docker.image.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerImage).Size, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"registrykey.property.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.image.virtualsize": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerImage).Virtualsize, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"registrykey.property.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.image.tags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerImage).Tags, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"registrykey.property.data": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlRegistrykeyProperty).Data, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"docker.image.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerImage).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"container.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlContainerImage).__id, ok = v.Value.(string)
+	"docker.container.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDockerContainer).__id, ok = v.Value.(string)
 			return
 		},
-	"container.image.reference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Reference, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.os": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Os, ok = plugin.RawToTValue[*mqlOsLinux](v.Value, v.Error)
 		return
 	},
-	"container.image.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.image.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.image.identifierType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).IdentifierType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.image.repository": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerImage).Repository, ok = plugin.RawToTValue[*mqlContainerRepository](v.Value, v.Error)
+	"docker.container.imageid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Imageid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.repository.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlContainerRepository).__id, ok = v.Value.(string)
-			return
-		},
-	"container.repository.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.names": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Names, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"container.repository.scheme": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Scheme, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.repository.fullName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).FullName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"container.repository.registry": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlContainerRepository).Registry, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"docker.container.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDockerContainer).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"kubelet.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlKubelet).__id, ok = v.Value.(string)
+	"iptables.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlIptables).__id, ok = v.Value.(string)
 			return
 		},
-	"kubelet.configFile": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKubelet).ConfigFile, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
-		return
-	},
-	"kubelet.process": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKubelet).Process, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
+	"iptables.input": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptables).Input, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"kubelet.configuration": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlKubelet).Configuration, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"iptables.output": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptables).Output, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"python.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPython).__id, ok = v.Value.(string)
+	"ip6tables.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlIp6tables).__id, ok = v.Value.(string)
 			return
 		},
-	"python.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPython).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"python.packages": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPython).Packages, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ip6tables.input": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIp6tables).Input, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"python.toplevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPython).Toplevel, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ip6tables.output": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIp6tables).Output, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"python.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPythonPackage).__id, ok = v.Value.(string)
+	"iptables.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlIptablesEntry).__id, ok = v.Value.(string)
 			return
 		},
-	"python.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.lineNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).LineNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"python.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.packets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Packets, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"python.package.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"iptables.entry.bytes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Bytes, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"python.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.target": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Target, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.license": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).License, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.author": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Author, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.opt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Opt, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.authorEmail": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).AuthorEmail, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.in": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).In, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.summary": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Summary, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.out": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Out, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"iptables.entry.destination": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Destination, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"python.package.dependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPythonPackage).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"iptables.entry.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Options, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlNpmPackages).__id, ok = v.Value.(string)
-			return
-		},
-	"npm.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"iptables.entry.chain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlIptablesEntry).Chain, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.packages.root": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackages).Root, ok = plugin.RawToTValue[*mqlNpmPackage](v.Value, v.Error)
+	"process.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlProcess).__id, ok = v.Value.(string)
+			return
+		},
+	"process.pid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Pid, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"npm.packages.directDependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackages).DirectDependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"process.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"process.executable": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Executable, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"process.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Command, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlNpmPackage).__id, ok = v.Value.(string)
+	"process.flags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcess).Flags, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"processes.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlProcesses).__id, ok = v.Value.(string)
 			return
 		},
-	"npm.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"processes.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlProcesses).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"npm.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"port.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPort).__id, ok = v.Value.(string)
+			return
+		},
+	"port.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"port.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"npm.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"port.address": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).Address, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"npm.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"port.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).User, ok = plugin.RawToTValue[*mqlUser](v.Value, v.Error)
 		return
 	},
-	"npm.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlNpmPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"port.process": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).Process, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
 		return
 	},
-	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMacos).__id, ok = v.Value.(string)
-			return
-		},
-	"macos.userPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).UserPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"port.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).State, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.userHostPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).UserHostPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"port.remoteAddress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).RemoteAddress, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.globalAccountPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).GlobalAccountPolicies, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"port.remotePort": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).RemotePort, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"macos.alf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMacosAlf).__id, ok = v.Value.(string)
+	"port.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPort).Tls, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+		return
+	},
+	"ports.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPorts).__id, ok = v.Value.(string)
 			return
 		},
-	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).AllowDownloadSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"ports.listening": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPorts).Listening, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.alf.allowSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).AllowSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"ports.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPorts).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.alf.firewallUnload": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).FirewallUnload, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"auditpol.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditpol).__id, ok = v.Value.(string)
+			return
+		},
+	"auditpol.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpol).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.alf.globalState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).GlobalState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"auditpol.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditpolEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"auditpol.entry.machinename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Machinename, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.loggingEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).LoggingEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"auditpol.entry.policytarget": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Policytarget, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.loggingOption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).LoggingOption, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"auditpol.entry.subcategory": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Subcategory, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.stealthEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).StealthEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"auditpol.entry.subcategoryguid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Subcategoryguid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditpol.entry.inclusionsetting": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Inclusionsetting, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.exceptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Exceptions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"auditpol.entry.exclusionsetting": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditpolEntry).Exclusionsetting, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.explicitAuths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).ExplicitAuths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"auditd.config.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdConfig).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.config.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdConfig).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"macos.alf.applications": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Applications, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"auditd.config.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdConfig).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.timemachine.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMacosTimemachine).__id, ok = v.Value.(string)
-			return
-		},
-	"macos.timemachine.preferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosTimemachine).Preferences, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"auditd.config.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdConfig).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlMacosSystemsetup).__id, ok = v.Value.(string)
+	"auditd.rules.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdRules).__id, ok = v.Value.(string)
 			return
 		},
-	"macos.systemsetup.date": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Date, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rules.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRules).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.time": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Time, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rules.controls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRules).Controls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.timeZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).TimeZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rules.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRules).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).UsingNetworkTime, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rules.syscalls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRules).Syscalls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.networkTimeServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).NetworkTimeServer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.loadedRules.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdLoadedRules).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.loadedRules.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdLoadedRules).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.sleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Sleep, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"auditd.loadedRules.syscalls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdLoadedRules).Syscalls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.displaySleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).DisplaySleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.control.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdRuleControl).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.rule.control.flag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleControl).Flag, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.harddiskSleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).HarddiskSleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.control.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleControl).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.wakeOnModem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WakeOnModem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.file.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdRuleFile).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.rule.file.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleFile).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WakeOnNetworkAccess, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.file.permissions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleFile).Permissions, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RestartPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.file.keys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleFile).Keys, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.restartFreeze": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RestartFreeze, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdRuleSyscall).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.rule.syscall.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).Action, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).AllowPowerButtonToSleepComputer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).List, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.remoteLogin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RemoteLogin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.syscalls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).Syscalls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RemoteAppleEvents, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.fields": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).Fields, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.permissions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).Permissions, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.localSubnetName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).LocalSubnetName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.syscall.keys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleSyscall).Keys, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.startupDisk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).StartupDisk, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.field.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlAuditdRuleField).__id, ok = v.Value.(string)
+			return
+		},
+	"auditd.rule.field.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleField).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WaitForStartupAfterPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.field.op": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleField).Op, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).DisableKeyboardWhenEnclosureLockIsEngaged, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"auditd.rule.field.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlAuditdRuleField).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindows).__id, ok = v.Value.(string)
+	"secpol.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSecpol).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.computerInfo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindows).ComputerInfo, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"secpol.systemaccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSecpol).Systemaccess, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.hotfixes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindows).Hotfixes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"secpol.eventaudit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSecpol).Eventaudit, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.features": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindows).Features, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"secpol.registryvalues": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSecpol).Registryvalues, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.hotfix.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsHotfix).__id, ok = v.Value.(string)
+	"secpol.privilegerights": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSecpol).Privilegerights, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"ntp.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNtpConf).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.hotfix.hotfixId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsHotfix).HotfixId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ntp.conf.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"windows.hotfix.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsHotfix).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ntp.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.hotfix.caption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsHotfix).Caption, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ntp.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.hotfix.installedOn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsHotfix).InstalledOn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"ntp.conf.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Servers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.hotfix.installedBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsHotfix).InstalledBy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ntp.conf.restrict": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Restrict, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.feature.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsFeature).__id, ok = v.Value.(string)
+	"ntp.conf.fudge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNtpConf).Fudge, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rsyslog.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRsyslogConf).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.feature.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"rsyslog.conf.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.feature.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"rsyslog.conf.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.feature.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"rsyslog.conf.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.feature.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"rsyslog.conf.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRsyslogConf).Settings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.feature.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"logindefs.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLogindefs).__id, ok = v.Value.(string)
+			return
+		},
+	"logindefs.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"windows.feature.installState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFeature).InstallState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"logindefs.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsFirewall).__id, ok = v.Value.(string)
+	"logindefs.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLogindefs).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"lsblk.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLsblk).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.firewall.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewall).Settings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"lsblk.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblk).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewall).Profiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"lsblk.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLsblkEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"lsblk.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewall).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"lsblk.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsFirewallProfile).__id, ok = v.Value.(string)
+	"lsblk.entry.label": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Label, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.uuid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Uuid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"lsblk.entry.mountpoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLsblkEntry).Mountpoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"mount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMount).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.firewall.profile.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"mount.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMount).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"mount.point.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMountPoint).__id, ok = v.Value.(string)
+			return
+		},
+	"mount.point.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"mount.point.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.defaultInboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).DefaultInboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"mount.point.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.defaultOutboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).DefaultOutboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"mount.point.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Options, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowInboundRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowInboundRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"mount.point.mounted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMountPoint).Mounted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowLocalFirewallRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowLocalFirewallRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlShadow).__id, ok = v.Value.(string)
+			return
+		},
+	"shadow.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadow).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowLocalIPsecRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowLocalIPsecRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlShadowEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"shadow.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowUserApps": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowUserApps, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.password": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Password, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowUserPorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowUserPorts, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.lastchanged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Lastchanged, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.allowUnicastResponseToMulticast": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).AllowUnicastResponseToMulticast, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.mindays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Mindays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.notifyOnListen": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).NotifyOnListen, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.maxdays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Maxdays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.enableStealthModeForIPsec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).EnableStealthModeForIPsec, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.warndays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Warndays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.logMaxSizeKilobytes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).LogMaxSizeKilobytes, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.inactivedays": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Inactivedays, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.logAllowed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).LogAllowed, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.expirydates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Expirydates, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.logBlocked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).LogBlocked, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"shadow.entry.reserved": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlShadowEntry).Reserved, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.logIgnored": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).LogIgnored, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlYum).__id, ok = v.Value.(string)
+			return
+		},
+	"yum.vars": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYum).Vars, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.profile.logFileName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallProfile).LogFileName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repos": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYum).Repos, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsFirewallRule).__id, ok = v.Value.(string)
+	"yum.repo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlYumRepo).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.firewall.rule.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repo.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repo.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repo.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repo.baseurl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Baseurl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.displayGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).DisplayGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"yum.repo.expire": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Expire, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.repo.filename": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Filename, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.direction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Direction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.repo.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Action, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.repo.revision": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Revision, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.edgeTraversalPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).EdgeTraversalPolicy, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.repo.pkgs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Pkgs, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.looseSourceMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).LooseSourceMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"yum.repo.size": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Size, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.localOnlyMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).LocalOnlyMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"yum.repo.mirrors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Mirrors, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.primaryStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).PrimaryStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"yum.repo.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlYumRepo).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRegistrykey).__id, ok = v.Value.(string)
+			return
+		},
+	"registrykey.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.enforcementStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).EnforcementStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.policyStoreSource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).PolicyStoreSource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.properties": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Properties, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.firewall.rule.policyStoreSourceType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsFirewallRule).PolicyStoreSourceType, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"registrykey.items": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Items, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsBitlocker).__id, ok = v.Value.(string)
-			return
-		},
-	"windows.bitlocker.volumes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlocker).Volumes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"registrykey.children": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykey).Children, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsBitlockerVolume).__id, ok = v.Value.(string)
+	"registrykey.property.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRegistrykeyProperty).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.bitlocker.volume.deviceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).DeviceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"windows.bitlocker.volume.driveLetter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).DriveLetter, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.property.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.conversionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).ConversionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"registrykey.property.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.encryptionMethod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).EncryptionMethod, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"registrykey.property.exists": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Exists, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.lockStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).LockStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"registrykey.property.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.persistentVolumeID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).PersistentVolumeID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"registrykey.property.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.protectionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).ProtectionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"registrykey.property.data": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRegistrykeyProperty).Data, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.bitlocker.volume.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsBitlockerVolume).Version, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"container.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlContainerImage).__id, ok = v.Value.(string)
+			return
+		},
+	"container.image.reference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Reference, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsSecurity).__id, ok = v.Value.(string)
+	"container.image.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.identifierType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).IdentifierType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.repository": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Repository, ok = plugin.RawToTValue[*mqlContainerRepository](v.Value, v.Error)
+		return
+	},
+	"container.image.layers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImage).Layers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"container.image.layer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlContainerImageLayer).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.security.products": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurity).Products, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"container.image.layer.index": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImageLayer).Index, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsSecurityProduct).__id, ok = v.Value.(string)
+	"container.image.layer.digest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImageLayer).Digest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.layer.createdBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImageLayer).CreatedBy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"container.image.layer.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerImageLayer).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"container.repository.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlContainerRepository).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.security.product.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"container.repository.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.guid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).Guid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"container.repository.scheme": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Scheme, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"container.repository.fullName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).FullName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).State, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"container.repository.registry": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlContainerRepository).Registry, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.productState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).ProductState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kubelet.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlKubelet).__id, ok = v.Value.(string)
+			return
+		},
+	"kubelet.configFile": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKubelet).ConfigFile, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.signatureState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).SignatureState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"kubelet.process": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKubelet).Process, ok = plugin.RawToTValue[*mqlProcess](v.Value, v.Error)
 		return
 	},
-	"windows.security.product.timestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityProduct).Timestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"kubelet.configuration": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlKubelet).Configuration, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlWindowsSecurityHealth).__id, ok = v.Value.(string)
+	"python.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPython).__id, ok = v.Value.(string)
 			return
 		},
-	"windows.security.health.firewall": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).Firewall, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPython).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.autoUpdate": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).AutoUpdate, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.packages": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPython).Packages, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.antiVirus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).AntiVirus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.toplevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPython).Toplevel, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.antiSpyware": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).AntiSpyware, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPythonPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"python.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.internetSettings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).InternetSettings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.uac": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).Uac, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.package.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"windows.security.health.securityCenterService": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlWindowsSecurityHealth).SecurityCenterService, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"python.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-}
-
-func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
-	f, ok := setDataFields[resource.MqlName() + "." + field]
-	if !ok {
-		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
-	}
-
-	if ok := f(resource, val); !ok {
-		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
-	}
-	return nil
-}
-
-func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
-	var err error
-	for k, v := range args {
-		if err = SetData(resource, k, v); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// mqlAsset for the asset resource
-type mqlAsset struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlAssetInternal it will be used here
-	Cpes plugin.TValue[[]interface{}]
-	VulnerabilityReport plugin.TValue[interface{}]
-}
-
-// createAsset creates a new instance of this resource
-func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAsset{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
+	"python.package.license": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).License, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"python.package.author": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Author, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"python.package.authorEmail": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).AuthorEmail, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"python.package.summary": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Summary, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"python.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"python.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"python.package.dependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"python.package.layer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPythonPackage).Layer, ok = plugin.RawToTValue[*mqlContainerImageLayer](v.Value, v.Error)
+		return
+	},
+	"npm.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNpmPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"npm.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.packages.root": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).Root, ok = plugin.RawToTValue[*mqlNpmPackage](v.Value, v.Error)
+		return
+	},
+	"npm.packages.directDependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).DirectDependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlNpmPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"npm.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.package.license": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).License, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"npm.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.package.dev": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Dev, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"npm.package.optional": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Optional, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"npm.package.dependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"npm.package.layer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNpmPackage).Layer, ok = plugin.RawToTValue[*mqlContainerImageLayer](v.Value, v.Error)
+		return
+	},
+	"java.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJavaPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"java.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"java.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"java.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJavaPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"java.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.package.groupId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).GroupId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"java.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"java.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.binaries.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGolangBinaries).__id, ok = v.Value.(string)
+			return
+		},
+	"golang.binaries.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinaries).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.binaries.packages": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinaries).Packages, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.binaries.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinaries).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.binary.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGolangBinary).__id, ok = v.Value.(string)
+			return
+		},
+	"golang.binary.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinary).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.binary.goVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinary).GoVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.binary.mainModule": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinary).MainModule, ok = plugin.RawToTValue[*mqlGolangPackage](v.Value, v.Error)
+		return
+	},
+	"golang.binary.dependencies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinary).Dependencies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.binary.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangBinary).Settings, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlGolangPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"golang.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"golang.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"golang.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGolangPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rust.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRustPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"rust.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rust.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rust.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rust.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRustPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"rust.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rust.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rust.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rust.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"rust.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"rust.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRubyPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"ruby.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRubyPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"ruby.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ruby.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ruby.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"php.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPhpPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"php.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"php.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"php.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPhpPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"php.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"php.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dotnet.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDotnetPackages).__id, ok = v.Value.(string)
+			return
+		},
+	"dotnet.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dotnet.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackages).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dotnet.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackages).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDotnetPackage).__id, ok = v.Value.(string)
+			return
+		},
+	"dotnet.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Cpes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dotnet.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDotnetPackage).Files, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacos).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.userPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.userHostPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserHostPreferences, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.globalAccountPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).GlobalAccountPolicies, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.alf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosAlf).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowDownloadSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.allowSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.firewallUnload": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).FirewallUnload, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.globalState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).GlobalState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingOption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingOption, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.stealthEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).StealthEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.alf.exceptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Exceptions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.alf.explicitAuths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).ExplicitAuths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.alf.applications": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Applications, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.timemachine.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosTimemachine).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.timemachine.preferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosTimemachine).Preferences, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlMacosSystemsetup).__id, ok = v.Value.(string)
+			return
+		},
+	"macos.systemsetup.date": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Date, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.time": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Time, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.timeZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).TimeZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).UsingNetworkTime, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.networkTimeServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).NetworkTimeServer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.sleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Sleep, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.displaySleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisplaySleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.harddiskSleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).HarddiskSleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnModem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnModem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnNetworkAccess, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartFreeze": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartFreeze, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).AllowPowerButtonToSleepComputer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteLogin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteLogin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteAppleEvents, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.localSubnetName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).LocalSubnetName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.startupDisk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).StartupDisk, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WaitForStartupAfterPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisableKeyboardWhenEnclosureLockIsEngaged, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindows).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.computerInfo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).ComputerInfo, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.hotfixes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).Hotfixes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.features": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindows).Features, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.hotfix.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsHotfix).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.hotfix.hotfixId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).HotfixId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.hotfix.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.hotfix.caption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).Caption, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.hotfix.installedOn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).InstalledOn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"windows.hotfix.installedBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsHotfix).InstalledBy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.feature.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFeature).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.feature.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.feature.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.feature.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.feature.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.feature.installed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).Installed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"windows.feature.installState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFeature).InstallState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewall).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.firewall.settings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Settings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Profiles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewall).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewallProfile).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.firewall.profile.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.defaultInboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).DefaultInboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.defaultOutboundAction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).DefaultOutboundAction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowInboundRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowInboundRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowLocalFirewallRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowLocalFirewallRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowLocalIPsecRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowLocalIPsecRules, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUserApps": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUserApps, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUserPorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUserPorts, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.allowUnicastResponseToMulticast": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).AllowUnicastResponseToMulticast, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.notifyOnListen": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).NotifyOnListen, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.enableStealthModeForIPsec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).EnableStealthModeForIPsec, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logMaxSizeKilobytes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogMaxSizeKilobytes, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logAllowed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogAllowed, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logBlocked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogBlocked, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logIgnored": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogIgnored, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.profile.logFileName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallProfile).LogFileName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsFirewallRule).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.firewall.rule.instanceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).InstanceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.displayName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).DisplayName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.displayGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).DisplayGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Enabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.direction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Direction, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Action, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.edgeTraversalPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).EdgeTraversalPolicy, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.looseSourceMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).LooseSourceMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.localOnlyMapping": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).LocalOnlyMapping, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.primaryStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PrimaryStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.enforcementStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).EnforcementStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.policyStoreSource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PolicyStoreSource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.firewall.rule.policyStoreSourceType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsFirewallRule).PolicyStoreSourceType, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsBitlocker).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.bitlocker.volumes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlocker).Volumes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsBitlockerVolume).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.bitlocker.volume.deviceID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).DeviceID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.driveLetter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).DriveLetter, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.conversionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).ConversionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.encryptionMethod": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).EncryptionMethod, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.lockStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).LockStatus, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.persistentVolumeID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).PersistentVolumeID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.protectionStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).ProtectionStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.bitlocker.volume.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsBitlockerVolume).Version, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurity).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.security.products": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurity).Products, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurityProduct).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.security.product.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.guid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Guid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.state": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).State, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.productState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).ProductState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.signatureState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).SignatureState, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"windows.security.product.timestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityProduct).Timestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlWindowsSecurityHealth).__id, ok = v.Value.(string)
+			return
+		},
+	"windows.security.health.firewall": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).Firewall, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.autoUpdate": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AutoUpdate, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.antiVirus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AntiVirus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.antiSpyware": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).AntiSpyware, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.internetSettings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).InternetSettings, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.uac": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).Uac, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"windows.security.health.securityCenterService": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlWindowsSecurityHealth).SecurityCenterService, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+}
+
+func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
+	f, ok := setDataFields[resource.MqlName() + "." + field]
+	if !ok {
+		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
+	}
+
+	if ok := f(resource, val); !ok {
+		return errors.New("[os] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
+	}
+	return nil
+}
+
+func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
+	var err error
+	for k, v := range args {
+		if err = SetData(resource, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mqlAsset for the asset resource
+type mqlAsset struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAssetInternal it will be used here
+	Cpes plugin.TValue[[]interface{}]
+	VulnerabilityReport plugin.TValue[interface{}]
+}
+
+// createAsset creates a new instance of this resource
+func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAsset{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("asset", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAsset) MqlName() string {
+	return "asset"
+}
+
+func (c *mqlAsset) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAsset) GetCpes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("asset", c.__id, "cpes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.cpes()
+	})
+}
+
+func (c *mqlAsset) GetVulnerabilityReport() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
+		return c.vulnerabilityReport()
+	})
+}
+
+// mqlAssetEol for the asset.eol resource
+type mqlAssetEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAssetEolInternal it will be used here
+	DocsUrl plugin.TValue[string]
+	ProductUrl plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createAssetEol creates a new instance of this resource
+func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAssetEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("asset.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAssetEol) MqlName() string {
+	return "asset.eol"
+}
+
+func (c *mqlAssetEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAssetEol) GetDocsUrl() *plugin.TValue[string] {
+	return &c.DocsUrl
+}
+
+func (c *mqlAssetEol) GetProductUrl() *plugin.TValue[string] {
+	return &c.ProductUrl
+}
+
+func (c *mqlAssetEol) GetDate() *plugin.TValue[*time.Time] {
+	return &c.Date
+}
+
+// mqlMondooEol for the mondoo.eol resource
+type mqlMondooEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMondooEolInternal it will be used here
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createMondooEol creates a new instance of this resource
+func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMondooEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("mondoo.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMondooEol) MqlName() string {
+	return "mondoo.eol"
+}
+
+func (c *mqlMondooEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMondooEol) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMondooEol) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMondooEol) GetDate() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Date, func() (*time.Time, error) {
+		return c.date()
+	})
+}
+
+// mqlPlatformEol for the platform.eol resource
+type mqlPlatformEol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformEolInternal it will be used here
+	DocsUrl plugin.TValue[string]
+	ProductUrl plugin.TValue[string]
+	Date plugin.TValue[*time.Time]
+}
+
+// createPlatformEol creates a new instance of this resource
+func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformEol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.eol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformEol) MqlName() string {
+	return "platform.eol"
+}
+
+func (c *mqlPlatformEol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformEol) GetDocsUrl() *plugin.TValue[string] {
+	return &c.DocsUrl
+}
+
+func (c *mqlPlatformEol) GetProductUrl() *plugin.TValue[string] {
+	return &c.ProductUrl
+}
+
+func (c *mqlPlatformEol) GetDate() *plugin.TValue[*time.Time] {
+	return &c.Date
+}
+
+// mqlPlatform for the platform resource
+type mqlPlatform struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformInternal it will be used here
+	VulnerabilityReport plugin.TValue[interface{}]
+}
+
+// createPlatform creates a new instance of this resource
+func createPlatform(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatform{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatform) MqlName() string {
+	return "platform"
+}
+
+func (c *mqlPlatform) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatform) GetVulnerabilityReport() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
+		return c.vulnerabilityReport()
+	})
+}
+
+// mqlVulnmgmt for the vulnmgmt resource
+type mqlVulnmgmt struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlVulnmgmtInternal
+	Cves plugin.TValue[[]interface{}]
+	SuppressedCves plugin.TValue[[]interface{}]
+	Advisories plugin.TValue[[]interface{}]
+	Packages plugin.TValue[[]interface{}]
+	LastAssessment plugin.TValue[*time.Time]
+	Stats plugin.TValue[*mqlAuditCvss]
+}
+
+// createVulnmgmt creates a new instance of this resource
+func createVulnmgmt(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlVulnmgmt{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("vulnmgmt", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlVulnmgmt) MqlName() string {
+	return "vulnmgmt"
+}
+
+func (c *mqlVulnmgmt) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlVulnmgmt) GetCves() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cves, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "cves")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.cves()
+	})
+}
+
+func (c *mqlVulnmgmt) GetSuppressedCves() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.SuppressedCves, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "suppressedCves")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.suppressedCves()
+	})
+}
+
+func (c *mqlVulnmgmt) GetAdvisories() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Advisories, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "advisories")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.advisories()
+	})
+}
+
+func (c *mqlVulnmgmt) GetPackages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Packages, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "packages")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.packages()
+	})
+}
+
+func (c *mqlVulnmgmt) GetLastAssessment() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.LastAssessment, func() (*time.Time, error) {
+		return c.lastAssessment()
+	})
+}
+
+func (c *mqlVulnmgmt) GetStats() *plugin.TValue[*mqlAuditCvss] {
+	return plugin.GetOrCompute[*mqlAuditCvss](&c.Stats, func() (*mqlAuditCvss, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "stats")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuditCvss), nil
+			}
+		}
+
+		return c.stats()
+	})
+}
+
+// mqlVulnCve for the vuln.cve resource
+type mqlVulnCve struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlVulnCveInternal it will be used here
+	Id plugin.TValue[string]
+	State plugin.TValue[string]
+	Summary plugin.TValue[string]
+	Unscored plugin.TValue[bool]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+	VexStatus plugin.TValue[string]
+	VexJustification plugin.TValue[string]
+	VexImpactStatement plugin.TValue[string]
+}
+
+// createVulnCve creates a new instance of this resource
+func createVulnCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlVulnCve{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("vuln.cve", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlVulnCve) MqlName() string {
+	return "vuln.cve"
+}
+
+func (c *mqlVulnCve) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlVulnCve) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlVulnCve) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlVulnCve) GetSummary() *plugin.TValue[string] {
+	return &c.Summary
+}
+
+func (c *mqlVulnCve) GetUnscored() *plugin.TValue[bool] {
+	return &c.Unscored
+}
+
+func (c *mqlVulnCve) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlVulnCve) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlVulnCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+func (c *mqlVulnCve) GetVexStatus() *plugin.TValue[string] {
+	return &c.VexStatus
+}
+
+func (c *mqlVulnCve) GetVexJustification() *plugin.TValue[string] {
+	return &c.VexJustification
+}
+
+func (c *mqlVulnCve) GetVexImpactStatement() *plugin.TValue[string] {
+	return &c.VexImpactStatement
+}
+
+// mqlVulnAdvisory for the vuln.advisory resource
+type mqlVulnAdvisory struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlVulnAdvisoryInternal it will be used here
+	Id plugin.TValue[string]
+	Title plugin.TValue[string]
+	Description plugin.TValue[string]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+}
+
+// createVulnAdvisory creates a new instance of this resource
+func createVulnAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlVulnAdvisory{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("vuln.advisory", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlVulnAdvisory) MqlName() string {
+	return "vuln.advisory"
+}
+
+func (c *mqlVulnAdvisory) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlVulnAdvisory) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlVulnAdvisory) GetTitle() *plugin.TValue[string] {
+	return &c.Title
+}
+
+func (c *mqlVulnAdvisory) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlVulnAdvisory) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlVulnAdvisory) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlVulnAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+// mqlVulnPackage for the vuln.package resource
+type mqlVulnPackage struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlVulnPackageInternal it will be used here
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Available plugin.TValue[string]
+	Arch plugin.TValue[string]
+}
+
+// createVulnPackage creates a new instance of this resource
+func createVulnPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlVulnPackage{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("vuln.package", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlVulnPackage) MqlName() string {
+	return "vuln.package"
+}
+
+func (c *mqlVulnPackage) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlVulnPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlVulnPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlVulnPackage) GetAvailable() *plugin.TValue[string] {
+	return &c.Available
+}
+
+func (c *mqlVulnPackage) GetArch() *plugin.TValue[string] {
+	return &c.Arch
+}
+
+// mqlPlatformAdvisories for the platform.advisories resource
+type mqlPlatformAdvisories struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlPlatformAdvisoriesInternal
+	Cvss plugin.TValue[*mqlAuditCvss]
+	Stats plugin.TValue[interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createPlatformAdvisories creates a new instance of this resource
+func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformAdvisories{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.advisories", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformAdvisories) MqlName() string {
+	return "platform.advisories"
+}
+
+func (c *mqlPlatformAdvisories) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformAdvisories) GetCvss() *plugin.TValue[*mqlAuditCvss] {
+	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "cvss")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuditCvss), nil
+			}
+		}
+
+		return c.cvss()
+	})
+}
+
+func (c *mqlPlatformAdvisories) GetStats() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
+		return c.stats()
+	})
+}
+
+func (c *mqlPlatformAdvisories) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlPlatformCves for the platform.cves resource
+type mqlPlatformCves struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPlatformCvesInternal it will be used here
+	Cvss plugin.TValue[*mqlAuditCvss]
+	Stats plugin.TValue[interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createPlatformCves creates a new instance of this resource
+func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPlatformCves{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("platform.cves", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPlatformCves) MqlName() string {
+	return "platform.cves"
+}
+
+func (c *mqlPlatformCves) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPlatformCves) GetCvss() *plugin.TValue[*mqlAuditCvss] {
+	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "cvss")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuditCvss), nil
+			}
+		}
+
+		return c.cvss()
+	})
+}
+
+func (c *mqlPlatformCves) GetStats() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
+		return c.stats()
+	})
+}
+
+func (c *mqlPlatformCves) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlAuditCvss for the audit.cvss resource
+type mqlAuditCvss struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditCvssInternal it will be used here
+	Score plugin.TValue[float64]
+	Vector plugin.TValue[string]
+}
+
+// createAuditCvss creates a new instance of this resource
+func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditCvss{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.cvss", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditCvss) MqlName() string {
+	return "audit.cvss"
+}
+
+func (c *mqlAuditCvss) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditCvss) GetScore() *plugin.TValue[float64] {
+	return &c.Score
+}
+
+func (c *mqlAuditCvss) GetVector() *plugin.TValue[string] {
+	return &c.Vector
+}
+
+// mqlAuditAdvisory for the audit.advisory resource
+type mqlAuditAdvisory struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditAdvisoryInternal it will be used here
+	Id plugin.TValue[string]
+	Mrn plugin.TValue[string]
+	Title plugin.TValue[string]
+	Description plugin.TValue[string]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+}
+
+// createAuditAdvisory creates a new instance of this resource
+func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditAdvisory{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.advisory", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditAdvisory) MqlName() string {
+	return "audit.advisory"
+}
+
+func (c *mqlAuditAdvisory) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditAdvisory) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlAuditAdvisory) GetMrn() *plugin.TValue[string] {
+	return &c.Mrn
+}
+
+func (c *mqlAuditAdvisory) GetTitle() *plugin.TValue[string] {
+	return &c.Title
+}
+
+func (c *mqlAuditAdvisory) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlAuditAdvisory) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlAuditAdvisory) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlAuditAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+// mqlAuditCve for the audit.cve resource
+type mqlAuditCve struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditCveInternal it will be used here
+	Id plugin.TValue[string]
+	Mrn plugin.TValue[string]
+	State plugin.TValue[string]
+	Summary plugin.TValue[string]
+	Unscored plugin.TValue[bool]
+	Published plugin.TValue[*time.Time]
+	Modified plugin.TValue[*time.Time]
+	WorstScore plugin.TValue[*mqlAuditCvss]
+}
+
+// createAuditCve creates a new instance of this resource
+func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditCve{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("audit.cve", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditCve) MqlName() string {
+	return "audit.cve"
+}
+
+func (c *mqlAuditCve) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditCve) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlAuditCve) GetMrn() *plugin.TValue[string] {
+	return &c.Mrn
+}
+
+func (c *mqlAuditCve) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlAuditCve) GetSummary() *plugin.TValue[string] {
+	return &c.Summary
+}
+
+func (c *mqlAuditCve) GetUnscored() *plugin.TValue[bool] {
+	return &c.Unscored
+}
+
+func (c *mqlAuditCve) GetPublished() *plugin.TValue[*time.Time] {
+	return &c.Published
+}
+
+func (c *mqlAuditCve) GetModified() *plugin.TValue[*time.Time] {
+	return &c.Modified
+}
+
+func (c *mqlAuditCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
+	return &c.WorstScore
+}
+
+// mqlMachine for the machine resource
+type mqlMachine struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlMachineInternal
+}
+
+// createMachine creates a new instance of this resource
+func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachine{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachine) MqlName() string {
+	return "machine"
+}
+
+func (c *mqlMachine) MqlID() string {
+	return c.__id
+}
+
+// mqlMachineBios for the machine.bios resource
+type mqlMachineBios struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineBiosInternal it will be used here
+	Vendor plugin.TValue[string]
+	Version plugin.TValue[string]
+	ReleaseDate plugin.TValue[string]
+}
+
+// createMachineBios creates a new instance of this resource
+func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineBios{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.bios", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineBios) MqlName() string {
+	return "machine.bios"
+}
+
+func (c *mqlMachineBios) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineBios) GetVendor() *plugin.TValue[string] {
+	return &c.Vendor
+}
+
+func (c *mqlMachineBios) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineBios) GetReleaseDate() *plugin.TValue[string] {
+	return &c.ReleaseDate
+}
+
+// mqlMachineSystem for the machine.system resource
+type mqlMachineSystem struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineSystemInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	Uuid plugin.TValue[string]
+	Sku plugin.TValue[string]
+	Family plugin.TValue[string]
+}
+
+// createMachineSystem creates a new instance of this resource
+func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineSystem{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.system", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineSystem) MqlName() string {
+	return "machine.system"
+}
+
+func (c *mqlMachineSystem) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineSystem) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineSystem) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMachineSystem) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineSystem) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineSystem) GetUuid() *plugin.TValue[string] {
+	return &c.Uuid
+}
+
+func (c *mqlMachineSystem) GetSku() *plugin.TValue[string] {
+	return &c.Sku
+}
+
+func (c *mqlMachineSystem) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+// mqlMachineBaseboard for the machine.baseboard resource
+type mqlMachineBaseboard struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineBaseboardInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Product plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	AssetTag plugin.TValue[string]
+}
+
+// createMachineBaseboard creates a new instance of this resource
+func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineBaseboard{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.baseboard", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineBaseboard) MqlName() string {
+	return "machine.baseboard"
+}
+
+func (c *mqlMachineBaseboard) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineBaseboard) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineBaseboard) GetProduct() *plugin.TValue[string] {
+	return &c.Product
+}
+
+func (c *mqlMachineBaseboard) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineBaseboard) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineBaseboard) GetAssetTag() *plugin.TValue[string] {
+	return &c.AssetTag
+}
+
+// mqlMachineChassis for the machine.chassis resource
+type mqlMachineChassis struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlMachineChassisInternal it will be used here
+	Manufacturer plugin.TValue[string]
+	Version plugin.TValue[string]
+	Serial plugin.TValue[string]
+	AssetTag plugin.TValue[string]
+}
+
+// createMachineChassis creates a new instance of this resource
+func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMachineChassis{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("machine.chassis", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlMachineChassis) MqlName() string {
+	return "machine.chassis"
+}
+
+func (c *mqlMachineChassis) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlMachineChassis) GetManufacturer() *plugin.TValue[string] {
+	return &c.Manufacturer
+}
+
+func (c *mqlMachineChassis) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlMachineChassis) GetSerial() *plugin.TValue[string] {
+	return &c.Serial
+}
+
+func (c *mqlMachineChassis) GetAssetTag() *plugin.TValue[string] {
+	return &c.AssetTag
+}
+
+// mqlOs for the os resource
+type mqlOs struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsInternal it will be used here
+	Name plugin.TValue[string]
+	Env plugin.TValue[map[string]interface{}]
+	Path plugin.TValue[[]interface{}]
+	Uptime plugin.TValue[*time.Time]
+	Updates plugin.TValue[[]interface{}]
+	Rebootpending plugin.TValue[bool]
+	Hostname plugin.TValue[string]
+	Machineid plugin.TValue[string]
+}
+
+// createOs creates a new instance of this resource
+func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOs{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOs) MqlName() string {
+	return "os"
+}
+
+func (c *mqlOs) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOs) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
+}
+
+func (c *mqlOs) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
+		return c.env()
+	})
+}
+
+func (c *mqlOs) GetPath() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
+		vargEnv := c.GetEnv()
+		if vargEnv.Error != nil {
+			return nil, vargEnv.Error
+		}
+
+		return c.path(vargEnv.Data)
+	})
+}
+
+func (c *mqlOs) GetUptime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
+		return c.uptime()
+	})
+}
+
+func (c *mqlOs) GetUpdates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os", c.__id, "updates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.updates()
+	})
+}
+
+func (c *mqlOs) GetRebootpending() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
+		return c.rebootpending()
+	})
+}
+
+func (c *mqlOs) GetHostname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
+		return c.hostname()
+	})
+}
+
+func (c *mqlOs) GetMachineid() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Machineid, func() (string, error) {
+		return c.machineid()
+	})
+}
+
+// mqlOsUpdate for the os.update resource
+type mqlOsUpdate struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsUpdateInternal it will be used here
+	Name plugin.TValue[string]
+	Category plugin.TValue[string]
+	Severity plugin.TValue[string]
+	Restart plugin.TValue[bool]
+	Format plugin.TValue[string]
+}
+
+// createOsUpdate creates a new instance of this resource
+func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsUpdate{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.update", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsUpdate) MqlName() string {
+	return "os.update"
+}
+
+func (c *mqlOsUpdate) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsUpdate) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlOsUpdate) GetCategory() *plugin.TValue[string] {
+	return &c.Category
+}
+
+func (c *mqlOsUpdate) GetSeverity() *plugin.TValue[string] {
+	return &c.Severity
+}
+
+func (c *mqlOsUpdate) GetRestart() *plugin.TValue[bool] {
+	return &c.Restart
+}
+
+func (c *mqlOsUpdate) GetFormat() *plugin.TValue[string] {
+	return &c.Format
+}
+
+// mqlOsBase for the os.base resource
+type mqlOsBase struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsBaseInternal it will be used here
+	Machine plugin.TValue[*mqlMachine]
+	Name plugin.TValue[string]
+	Env plugin.TValue[map[string]interface{}]
+	Path plugin.TValue[[]interface{}]
+	Uptime plugin.TValue[*time.Time]
+	Updates plugin.TValue[[]interface{}]
+	Rebootpending plugin.TValue[bool]
+	Hostname plugin.TValue[string]
+	Groups plugin.TValue[*mqlGroups]
+	Users plugin.TValue[*mqlUsers]
+}
+
+// createOsBase creates a new instance of this resource
+func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsBase{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.base", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsBase) MqlName() string {
+	return "os.base"
+}
+
+func (c *mqlOsBase) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsBase) GetMachine() *plugin.TValue[*mqlMachine] {
+	return plugin.GetOrCompute[*mqlMachine](&c.Machine, func() (*mqlMachine, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "machine")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlMachine), nil
+			}
+		}
+
+		return c.machine()
+	})
+}
+
+func (c *mqlOsBase) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
+}
+
+func (c *mqlOsBase) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
+		return c.env()
+	})
+}
+
+func (c *mqlOsBase) GetPath() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
+		vargEnv := c.GetEnv()
+		if vargEnv.Error != nil {
+			return nil, vargEnv.Error
+		}
+
+		return c.path(vargEnv.Data)
+	})
+}
+
+func (c *mqlOsBase) GetUptime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
+		return c.uptime()
+	})
+}
+
+func (c *mqlOsBase) GetUpdates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "updates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.updates()
+	})
+}
+
+func (c *mqlOsBase) GetRebootpending() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
+		return c.rebootpending()
+	})
+}
+
+func (c *mqlOsBase) GetHostname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
+		return c.hostname()
+	})
+}
+
+func (c *mqlOsBase) GetGroups() *plugin.TValue[*mqlGroups] {
+	return plugin.GetOrCompute[*mqlGroups](&c.Groups, func() (*mqlGroups, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "groups")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlGroups), nil
+			}
+		}
+
+		return c.groups()
+	})
+}
+
+func (c *mqlOsBase) GetUsers() *plugin.TValue[*mqlUsers] {
+	return plugin.GetOrCompute[*mqlUsers](&c.Users, func() (*mqlUsers, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "users")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlUsers), nil
+			}
+		}
+
+		return c.users()
+	})
+}
+
+// mqlOsUnix for the os.unix resource
+type mqlOsUnix struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsUnixInternal it will be used here
+	Base plugin.TValue[*mqlOsBase]
+}
+
+// createOsUnix creates a new instance of this resource
+func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsUnix{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.unix", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsUnix) MqlName() string {
+	return "os.unix"
+}
+
+func (c *mqlOsUnix) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsUnix) GetBase() *plugin.TValue[*mqlOsBase] {
+	return plugin.GetOrCompute[*mqlOsBase](&c.Base, func() (*mqlOsBase, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.unix", c.__id, "base")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlOsBase), nil
+			}
+		}
+
+		return c.base()
+	})
+}
+
+// mqlOsLinux for the os.linux resource
+type mqlOsLinux struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsLinuxInternal it will be used here
+	Unix plugin.TValue[*mqlOsUnix]
+	Iptables plugin.TValue[*mqlIptables]
+	Ip6tables plugin.TValue[*mqlIp6tables]
+}
+
+// createOsLinux creates a new instance of this resource
+func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsLinux{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.linux", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsLinux) MqlName() string {
+	return "os.linux"
+}
+
+func (c *mqlOsLinux) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsLinux) GetUnix() *plugin.TValue[*mqlOsUnix] {
+	return plugin.GetOrCompute[*mqlOsUnix](&c.Unix, func() (*mqlOsUnix, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "unix")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlOsUnix), nil
+			}
+		}
+
+		return c.unix()
+	})
+}
+
+func (c *mqlOsLinux) GetIptables() *plugin.TValue[*mqlIptables] {
+	return plugin.GetOrCompute[*mqlIptables](&c.Iptables, func() (*mqlIptables, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "iptables")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlIptables), nil
+			}
+		}
+
+		return c.iptables()
+	})
+}
+
+func (c *mqlOsLinux) GetIp6tables() *plugin.TValue[*mqlIp6tables] {
+	return plugin.GetOrCompute[*mqlIp6tables](&c.Ip6tables, func() (*mqlIp6tables, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "ip6tables")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlIp6tables), nil
+			}
+		}
+
+		return c.ip6tables()
+	})
+}
+
+// mqlOsRootCertificates for the os.rootCertificates resource
+type mqlOsRootCertificates struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsRootCertificatesInternal it will be used here
+	Files plugin.TValue[[]interface{}]
+	Content plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createOsRootCertificates creates a new instance of this resource
+func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsRootCertificates{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.rootCertificates", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsRootCertificates) MqlName() string {
+	return "os.rootCertificates"
+}
+
+func (c *mqlOsRootCertificates) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsRootCertificates) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
+}
+
+func (c *mqlOsRootCertificates) GetContent() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Content, func() ([]interface{}, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.content(vargFiles.Data)
+	})
+}
+
+func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.rootCertificates", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargContent.Data)
+	})
+}
+
+// mqlCommand for the command resource
+type mqlCommand struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlCommandInternal
+	Command plugin.TValue[string]
+	Stdout plugin.TValue[string]
+	Stderr plugin.TValue[string]
+	Exitcode plugin.TValue[int64]
+}
+
+// createCommand creates a new instance of this resource
+func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCommand{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("command", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlCommand) MqlName() string {
+	return "command"
+}
+
+func (c *mqlCommand) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlCommand) GetCommand() *plugin.TValue[string] {
+	return &c.Command
+}
+
+func (c *mqlCommand) GetStdout() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return "", vargCommand.Error
+		}
+
+		return c.stdout(vargCommand.Data)
+	})
+}
+
+func (c *mqlCommand) GetStderr() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return "", vargCommand.Error
+		}
+
+		return c.stderr(vargCommand.Data)
+	})
+}
+
+func (c *mqlCommand) GetExitcode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
+		vargCommand := c.GetCommand()
+		if vargCommand.Error != nil {
+			return 0, vargCommand.Error
+		}
+
+		return c.exitcode(vargCommand.Data)
+	})
+}
+
+// mqlPowershell for the powershell resource
+type mqlPowershell struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlPowershellInternal
+	Script plugin.TValue[string]
+	Stdout plugin.TValue[string]
+	Stderr plugin.TValue[string]
+	Exitcode plugin.TValue[int64]
+}
+
+// createPowershell creates a new instance of this resource
+func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPowershell{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("powershell", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPowershell) MqlName() string {
+	return "powershell"
+}
+
+func (c *mqlPowershell) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPowershell) GetScript() *plugin.TValue[string] {
+	return &c.Script
+}
+
+func (c *mqlPowershell) GetStdout() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
+		return c.stdout()
+	})
+}
+
+func (c *mqlPowershell) GetStderr() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
+		return c.stderr()
+	})
+}
+
+func (c *mqlPowershell) GetExitcode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
+		return c.exitcode()
+	})
+}
+
+// mqlFile for the file resource
+type mqlFile struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlFileInternal it will be used here
+	Path plugin.TValue[string]
+	Basename plugin.TValue[string]
+	Dirname plugin.TValue[string]
+	Content plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Permissions plugin.TValue[*mqlFilePermissions]
+	Size plugin.TValue[int64]
+	User plugin.TValue[*mqlUser]
+	Group plugin.TValue[*mqlGroup]
+	Empty plugin.TValue[bool]
+}
+
+// createFile creates a new instance of this resource
+func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFile{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("file", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFile) MqlName() string {
+	return "file"
+}
+
+func (c *mqlFile) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFile) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlFile) GetBasename() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Basename, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		return c.basename(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetDirname() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Dirname, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		return c.dirname(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return "", vargPath.Error
+		}
+
+		vargExists := c.GetExists()
+		if vargExists.Error != nil {
+			return "", vargExists.Error
+		}
+
+		return c.content(vargPath.Data, vargExists.Data)
+	})
+}
+
+func (c *mqlFile) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return false, vargPath.Error
+		}
+
+		return c.exists(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetPermissions() *plugin.TValue[*mqlFilePermissions] {
+	return plugin.GetOrCompute[*mqlFilePermissions](&c.Permissions, func() (*mqlFilePermissions, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "permissions")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFilePermissions), nil
+			}
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.permissions(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetSize() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Size, func() (int64, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return 0, vargPath.Error
+		}
+
+		return c.size(vargPath.Data)
+	})
+}
+
+func (c *mqlFile) GetUser() *plugin.TValue[*mqlUser] {
+	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "user")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlUser), nil
+			}
+		}
+
+		return c.user()
+	})
+}
+
+func (c *mqlFile) GetGroup() *plugin.TValue[*mqlGroup] {
+	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "group")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlGroup), nil
+			}
+		}
+
+		return c.group()
+	})
+}
+
+func (c *mqlFile) GetEmpty() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Empty, func() (bool, error) {
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return false, vargPath.Error
+		}
+
+		return c.empty(vargPath.Data)
+	})
+}
+
+// mqlFilePermissions for the file.permissions resource
+type mqlFilePermissions struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlFilePermissionsInternal it will be used here
+	Mode plugin.TValue[int64]
+	User_readable plugin.TValue[bool]
+	User_writeable plugin.TValue[bool]
+	User_executable plugin.TValue[bool]
+	Group_readable plugin.TValue[bool]
+	Group_writeable plugin.TValue[bool]
+	Group_executable plugin.TValue[bool]
+	Other_readable plugin.TValue[bool]
+	Other_writeable plugin.TValue[bool]
+	Other_executable plugin.TValue[bool]
+	Suid plugin.TValue[bool]
+	Sgid plugin.TValue[bool]
+	Sticky plugin.TValue[bool]
+	IsDirectory plugin.TValue[bool]
+	IsFile plugin.TValue[bool]
+	IsSymlink plugin.TValue[bool]
+	String plugin.TValue[string]
+}
+
+// createFilePermissions creates a new instance of this resource
+func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFilePermissions{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("file.permissions", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFilePermissions) MqlName() string {
+	return "file.permissions"
+}
+
+func (c *mqlFilePermissions) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFilePermissions) GetMode() *plugin.TValue[int64] {
+	return &c.Mode
+}
+
+func (c *mqlFilePermissions) GetUser_readable() *plugin.TValue[bool] {
+	return &c.User_readable
+}
+
+func (c *mqlFilePermissions) GetUser_writeable() *plugin.TValue[bool] {
+	return &c.User_writeable
+}
+
+func (c *mqlFilePermissions) GetUser_executable() *plugin.TValue[bool] {
+	return &c.User_executable
+}
+
+func (c *mqlFilePermissions) GetGroup_readable() *plugin.TValue[bool] {
+	return &c.Group_readable
+}
+
+func (c *mqlFilePermissions) GetGroup_writeable() *plugin.TValue[bool] {
+	return &c.Group_writeable
+}
+
+func (c *mqlFilePermissions) GetGroup_executable() *plugin.TValue[bool] {
+	return &c.Group_executable
+}
+
+func (c *mqlFilePermissions) GetOther_readable() *plugin.TValue[bool] {
+	return &c.Other_readable
+}
+
+func (c *mqlFilePermissions) GetOther_writeable() *plugin.TValue[bool] {
+	return &c.Other_writeable
+}
+
+func (c *mqlFilePermissions) GetOther_executable() *plugin.TValue[bool] {
+	return &c.Other_executable
+}
+
+func (c *mqlFilePermissions) GetSuid() *plugin.TValue[bool] {
+	return &c.Suid
+}
+
+func (c *mqlFilePermissions) GetSgid() *plugin.TValue[bool] {
+	return &c.Sgid
+}
+
+func (c *mqlFilePermissions) GetSticky() *plugin.TValue[bool] {
+	return &c.Sticky
+}
+
+func (c *mqlFilePermissions) GetIsDirectory() *plugin.TValue[bool] {
+	return &c.IsDirectory
+}
+
+func (c *mqlFilePermissions) GetIsFile() *plugin.TValue[bool] {
+	return &c.IsFile
+}
+
+func (c *mqlFilePermissions) GetIsSymlink() *plugin.TValue[bool] {
+	return &c.IsSymlink
+}
+
+func (c *mqlFilePermissions) GetString() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
+		return c.string()
+	})
+}
+
+// mqlFiles for the files resource
+type mqlFiles struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlFilesInternal it will be used here
+}
+
+// createFiles creates a new instance of this resource
+func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFiles{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("files", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFiles) MqlName() string {
+	return "files"
+}
+
+func (c *mqlFiles) MqlID() string {
+	return c.__id
+}
+
+// mqlFilesFind for the files.find resource
+type mqlFilesFind struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlFilesFindInternal it will be used here
+	From plugin.TValue[string]
+	Xdev plugin.TValue[bool]
+	Type plugin.TValue[string]
+	Regex plugin.TValue[string]
+	Permissions plugin.TValue[int64]
+	Name plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createFilesFind creates a new instance of this resource
+func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFilesFind{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("files.find", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFilesFind) MqlName() string {
+	return "files.find"
+}
+
+func (c *mqlFilesFind) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFilesFind) GetFrom() *plugin.TValue[string] {
+	return &c.From
+}
+
+func (c *mqlFilesFind) GetXdev() *plugin.TValue[bool] {
+	return &c.Xdev
+}
+
+func (c *mqlFilesFind) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlFilesFind) GetRegex() *plugin.TValue[string] {
+	return &c.Regex
+}
+
+func (c *mqlFilesFind) GetPermissions() *plugin.TValue[int64] {
+	return &c.Permissions
+}
+
+func (c *mqlFilesFind) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("files.find", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlParseIni for the parse.ini resource
+type mqlParseIni struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParseIniInternal it will be used here
+	Delimiter plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Sections plugin.TValue[map[string]interface{}]
+	Params plugin.TValue[map[string]interface{}]
+}
+
+// createParseIni creates a new instance of this resource
+func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseIni{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.ini", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseIni) MqlName() string {
+	return "parse.ini"
+}
+
+func (c *mqlParseIni) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseIni) GetDelimiter() *plugin.TValue[string] {
+	return &c.Delimiter
+}
+
+func (c *mqlParseIni) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlParseIni) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParseIni) GetSections() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Sections, func() (map[string]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		vargDelimiter := c.GetDelimiter()
+		if vargDelimiter.Error != nil {
+			return nil, vargDelimiter.Error
+		}
+
+		return c.sections(vargContent.Data, vargDelimiter.Data)
+	})
+}
+
+func (c *mqlParseIni) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
+		vargSections := c.GetSections()
+		if vargSections.Error != nil {
+			return nil, vargSections.Error
+		}
+
+		return c.params(vargSections.Data)
+	})
+}
+
+// mqlParseJson for the parse.json resource
+type mqlParseJson struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParseJsonInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
+}
+
+// createParseJson creates a new instance of this resource
+func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseJson{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.json", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseJson) MqlName() string {
+	return "parse.json"
+}
+
+func (c *mqlParseJson) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseJson) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlParseJson) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParseJson) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
+}
+
+// mqlParsePlist for the parse.plist resource
+type mqlParsePlist struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParsePlistInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
+}
+
+// createParsePlist creates a new instance of this resource
+func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParsePlist{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.plist", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParsePlist) MqlName() string {
+	return "parse.plist"
+}
+
+func (c *mqlParsePlist) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParsePlist) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlParsePlist) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParsePlist) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
+}
+
+// mqlParseYaml for the parse.yaml resource
+type mqlParseYaml struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParseYamlInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[interface{}]
+}
+
+// createParseYaml creates a new instance of this resource
+func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseYaml{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.yaml", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseYaml) MqlName() string {
+	return "parse.yaml"
+}
+
+func (c *mqlParseYaml) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseYaml) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlParseYaml) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParseYaml) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
+}
+
+// mqlParseCertificates for the parse.certificates resource
+type mqlParseCertificates struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParseCertificatesInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createParseCertificates creates a new instance of this resource
+func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseCertificates{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.certificates", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseCertificates) MqlName() string {
+	return "parse.certificates"
+}
+
+func (c *mqlParseCertificates) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseCertificates) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlParseCertificates) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
+}
+
+func (c *mqlParseCertificates) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParseCertificates) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.list(vargContent.Data, vargPath.Data)
+	})
+}
+
+// mqlParseOpenpgp for the parse.openpgp resource
+type mqlParseOpenpgp struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlParseOpenpgpInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createParseOpenpgp creates a new instance of this resource
+func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseOpenpgp{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.openpgp", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseOpenpgp) MqlName() string {
+	return "parse.openpgp"
+}
+
+func (c *mqlParseOpenpgp) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseOpenpgp) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlParseOpenpgp) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlParseOpenpgp) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlParseOpenpgp) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.openpgp", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargContent.Data)
+	})
+}
+
+// mqlUser for the user resource
+type mqlUser struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlUserInternal it will be used here
+	Uid plugin.TValue[int64]
+	Gid plugin.TValue[int64]
+	Sid plugin.TValue[string]
+	Name plugin.TValue[string]
+	Home plugin.TValue[string]
+	Shell plugin.TValue[string]
+	Enabled plugin.TValue[bool]
+	Authorizedkeys plugin.TValue[*mqlAuthorizedkeys]
+	Sshkeys plugin.TValue[[]interface{}]
+	Group plugin.TValue[*mqlGroup]
+}
+
+// createUser creates a new instance of this resource
+func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUser{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("user", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlUser) MqlName() string {
+	return "user"
+}
+
+func (c *mqlUser) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlUser) GetUid() *plugin.TValue[int64] {
+	return &c.Uid
+}
+
+func (c *mqlUser) GetGid() *plugin.TValue[int64] {
+	return &c.Gid
+}
+
+func (c *mqlUser) GetSid() *plugin.TValue[string] {
+	return &c.Sid
+}
+
+func (c *mqlUser) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlUser) GetHome() *plugin.TValue[string] {
+	return &c.Home
+}
+
+func (c *mqlUser) GetShell() *plugin.TValue[string] {
+	return &c.Shell
+}
+
+func (c *mqlUser) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
+
+func (c *mqlUser) GetAuthorizedkeys() *plugin.TValue[*mqlAuthorizedkeys] {
+	return plugin.GetOrCompute[*mqlAuthorizedkeys](&c.Authorizedkeys, func() (*mqlAuthorizedkeys, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "authorizedkeys")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlAuthorizedkeys), nil
+			}
+		}
+
+		vargHome := c.GetHome()
+		if vargHome.Error != nil {
+			return nil, vargHome.Error
+		}
+
+		return c.authorizedkeys(vargHome.Data)
+	})
+}
+
+func (c *mqlUser) GetSshkeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Sshkeys, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "sshkeys")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.sshkeys()
+	})
+}
+
+func (c *mqlUser) GetGroup() *plugin.TValue[*mqlGroup] {
+	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "group")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlGroup), nil
+			}
+		}
+
+		vargGid := c.GetGid()
+		if vargGid.Error != nil {
+			return nil, vargGid.Error
+		}
+
+		return c.group(vargGid.Data)
+	})
+}
+
+// mqlPrivatekey for the privatekey resource
+type mqlPrivatekey struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlPrivatekeyInternal it will be used here
+	Pem plugin.TValue[string]
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Encrypted plugin.TValue[bool]
+}
+
+// createPrivatekey creates a new instance of this resource
+func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPrivatekey{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("privatekey", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPrivatekey) MqlName() string {
+	return "privatekey"
+}
+
+func (c *mqlPrivatekey) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPrivatekey) GetPem() *plugin.TValue[string] {
+	return &c.Pem
+}
+
+func (c *mqlPrivatekey) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlPrivatekey) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlPrivatekey) GetEncrypted() *plugin.TValue[bool] {
+	return &c.Encrypted
+}
+
+// mqlUsers for the users resource
+type mqlUsers struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlUsersInternal
+	List plugin.TValue[[]interface{}]
+}
+
+// createUsers creates a new instance of this resource
+func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUsers{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("users", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlUsers) MqlName() string {
+	return "users"
+}
+
+func (c *mqlUsers) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlUsers) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("users", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlAuthorizedkeys for the authorizedkeys resource
+type mqlAuthorizedkeys struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuthorizedkeysInternal it will be used here
+	Path plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// createAuthorizedkeys creates a new instance of this resource
+func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuthorizedkeys{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("authorizedkeys", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuthorizedkeys) MqlName() string {
+	return "authorizedkeys"
+}
+
+func (c *mqlAuthorizedkeys) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuthorizedkeys) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlAuthorizedkeys) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlAuthorizedkeys) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlAuthorizedkeys) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("authorizedkeys", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargFile.Data, vargContent.Data)
+	})
+}
+
+// mqlAuthorizedkeysEntry for the authorizedkeys.entry resource
+type mqlAuthorizedkeysEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuthorizedkeysEntryInternal it will be used here
+	Line plugin.TValue[int64]
+	Type plugin.TValue[string]
+	Key plugin.TValue[string]
+	Label plugin.TValue[string]
+	Options plugin.TValue[[]interface{}]
+	File plugin.TValue[*mqlFile]
+}
+
+// createAuthorizedkeysEntry creates a new instance of this resource
+func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuthorizedkeysEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("authorizedkeys.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuthorizedkeysEntry) MqlName() string {
+	return "authorizedkeys.entry"
+}
+
+func (c *mqlAuthorizedkeysEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuthorizedkeysEntry) GetLine() *plugin.TValue[int64] {
+	return &c.Line
+}
+
+func (c *mqlAuthorizedkeysEntry) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlAuthorizedkeysEntry) GetKey() *plugin.TValue[string] {
+	return &c.Key
+}
+
+func (c *mqlAuthorizedkeysEntry) GetLabel() *plugin.TValue[string] {
+	return &c.Label
+}
+
+func (c *mqlAuthorizedkeysEntry) GetOptions() *plugin.TValue[[]interface{}] {
+	return &c.Options
+}
+
+func (c *mqlAuthorizedkeysEntry) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+// mqlGroup for the group resource
+type mqlGroup struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlGroupInternal
+	Gid plugin.TValue[int64]
+	Sid plugin.TValue[string]
+	Name plugin.TValue[string]
+	Members plugin.TValue[[]interface{}]
+}
+
+// createGroup creates a new instance of this resource
+func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGroup{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// to override __id implement: id() (string, error)
-
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("asset", res.__id)
+		args, err = runtime.ResourceFromRecording("group", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4915,18 +10109,30 @@ func createAsset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlAsset) MqlName() string {
-	return "asset"
+func (c *mqlGroup) MqlName() string {
+	return "group"
 }
 
-func (c *mqlAsset) MqlID() string {
+func (c *mqlGroup) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAsset) GetCpes() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
+func (c *mqlGroup) GetGid() *plugin.TValue[int64] {
+	return &c.Gid
+}
+
+func (c *mqlGroup) GetSid() *plugin.TValue[string] {
+	return &c.Sid
+}
+
+func (c *mqlGroup) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Members, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("asset", c.__id, "cpes")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("group", c.__id, "members")
 			if err != nil {
 				return nil, err
 			}
@@ -4935,29 +10141,21 @@ func (c *mqlAsset) GetCpes() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.cpes()
-	})
-}
-
-func (c *mqlAsset) GetVulnerabilityReport() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
-		return c.vulnerabilityReport()
+		return c.members()
 	})
 }
 
-// mqlAssetEol for the asset.eol resource
-type mqlAssetEol struct {
+// mqlGroups for the groups resource
+type mqlGroups struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAssetEolInternal it will be used here
-	DocsUrl plugin.TValue[string]
-	ProductUrl plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	mqlGroupsInternal
+	List plugin.TValue[[]interface{}]
 }
 
-// createAssetEol creates a new instance of this resource
-func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAssetEol{
+// createGroups creates a new instance of this resource
+func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGroups{
 		MqlRuntime: runtime,
 	}
 
@@ -4969,7 +10167,7 @@ func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("asset.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("groups", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4979,39 +10177,56 @@ func createAssetEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAssetEol) MqlName() string {
-	return "asset.eol"
+func (c *mqlGroups) MqlName() string {
+	return "groups"
 }
 
-func (c *mqlAssetEol) MqlID() string {
+func (c *mqlGroups) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAssetEol) GetDocsUrl() *plugin.TValue[string] {
-	return &c.DocsUrl
-}
-
-func (c *mqlAssetEol) GetProductUrl() *plugin.TValue[string] {
-	return &c.ProductUrl
-}
+func (c *mqlGroups) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("groups", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAssetEol) GetDate() *plugin.TValue[*time.Time] {
-	return &c.Date
+		return c.list()
+	})
 }
 
-// mqlMondooEol for the mondoo.eol resource
-type mqlMondooEol struct {
+// mqlPackage for the package resource
+type mqlPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMondooEolInternal it will be used here
-	Product plugin.TValue[string]
+	mqlPackageInternal
+	Name plugin.TValue[string]
+	Description plugin.TValue[string]
 	Version plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	Arch plugin.TValue[string]
+	Epoch plugin.TValue[string]
+	Format plugin.TValue[string]
+	Status plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Origin plugin.TValue[string]
+	License plugin.TValue[string]
+	Available plugin.TValue[string]
+	Installed plugin.TValue[bool]
+	Outdated plugin.TValue[bool]
+	Files plugin.TValue[[]interface{}]
+	Layer plugin.TValue[*mqlContainerImageLayer]
 }
 
-// createMondooEol creates a new instance of this resource
-func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMondooEol{
+// createPackage creates a new instance of this resource
+func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -5028,7 +10243,7 @@ func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mondoo.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5038,41 +10253,121 @@ func createMondooEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlMondooEol) MqlName() string {
-	return "mondoo.eol"
+func (c *mqlPackage) MqlName() string {
+	return "package"
 }
 
-func (c *mqlMondooEol) MqlID() string {
+func (c *mqlPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMondooEol) GetProduct() *plugin.TValue[string] {
-	return &c.Product
+func (c *mqlPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlMondooEol) GetVersion() *plugin.TValue[string] {
+func (c *mqlPackage) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlPackage) GetVersion() *plugin.TValue[string] {
 	return &c.Version
 }
 
-func (c *mqlMondooEol) GetDate() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Date, func() (*time.Time, error) {
-		return c.date()
+func (c *mqlPackage) GetArch() *plugin.TValue[string] {
+	return &c.Arch
+}
+
+func (c *mqlPackage) GetEpoch() *plugin.TValue[string] {
+	return &c.Epoch
+}
+
+func (c *mqlPackage) GetFormat() *plugin.TValue[string] {
+	return &c.Format
+}
+
+func (c *mqlPackage) GetStatus() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
+		return c.status()
 	})
 }
 
-// mqlPlatformEol for the platform.eol resource
-type mqlPlatformEol struct {
+func (c *mqlPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
+}
+
+func (c *mqlPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
+}
+
+func (c *mqlPackage) GetOrigin() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Origin, func() (string, error) {
+		return c.origin()
+	})
+}
+
+func (c *mqlPackage) GetLicense() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.License, func() (string, error) {
+		return c.license()
+	})
+}
+
+func (c *mqlPackage) GetAvailable() *plugin.TValue[string] {
+	return &c.Available
+}
+
+func (c *mqlPackage) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
+}
+
+func (c *mqlPackage) GetOutdated() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Outdated, func() (bool, error) {
+		return c.outdated()
+	})
+}
+
+func (c *mqlPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("package", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.files()
+	})
+}
+
+func (c *mqlPackage) GetLayer() *plugin.TValue[*mqlContainerImageLayer] {
+	return plugin.GetOrCompute[*mqlContainerImageLayer](&c.Layer, func() (*mqlContainerImageLayer, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("package", c.__id, "layer")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlContainerImageLayer), nil
+			}
+		}
+
+		return c.layer()
+	})
+}
+
+// mqlPkgFileInfo for the pkgFileInfo resource
+type mqlPkgFileInfo struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformEolInternal it will be used here
-	DocsUrl plugin.TValue[string]
-	ProductUrl plugin.TValue[string]
-	Date plugin.TValue[*time.Time]
+	// optional: if you define mqlPkgFileInfoInternal it will be used here
+	Path plugin.TValue[string]
 }
 
-// createPlatformEol creates a new instance of this resource
-func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformEol{
+// createPkgFileInfo creates a new instance of this resource
+func createPkgFileInfo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPkgFileInfo{
 		MqlRuntime: runtime,
 	}
 
@@ -5081,10 +10376,15 @@ func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.eol", res.__id)
+		args, err = runtime.ResourceFromRecording("pkgFileInfo", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5094,37 +10394,28 @@ func createPlatformEol(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlPlatformEol) MqlName() string {
-	return "platform.eol"
+func (c *mqlPkgFileInfo) MqlName() string {
+	return "pkgFileInfo"
 }
 
-func (c *mqlPlatformEol) MqlID() string {
+func (c *mqlPkgFileInfo) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformEol) GetDocsUrl() *plugin.TValue[string] {
-	return &c.DocsUrl
-}
-
-func (c *mqlPlatformEol) GetProductUrl() *plugin.TValue[string] {
-	return &c.ProductUrl
-}
-
-func (c *mqlPlatformEol) GetDate() *plugin.TValue[*time.Time] {
-	return &c.Date
+func (c *mqlPkgFileInfo) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-// mqlPlatform for the platform resource
-type mqlPlatform struct {
+// mqlPkgFileSearch for the pkgFileSearch resource
+type mqlPkgFileSearch struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformInternal it will be used here
-	VulnerabilityReport plugin.TValue[interface{}]
+	mqlPkgFileSearchInternal
 }
 
-// createPlatform creates a new instance of this resource
-func createPlatform(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatform{
+// createPkgFileSearch creates a new instance of this resource
+func createPkgFileSearch(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPkgFileSearch{
 		MqlRuntime: runtime,
 	}
 
@@ -5133,10 +10424,15 @@ func createPlatform(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform", res.__id)
+		args, err = runtime.ResourceFromRecording("pkgFileSearch", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5146,35 +10442,25 @@ func createPlatform(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlPlatform) MqlName() string {
-	return "platform"
+func (c *mqlPkgFileSearch) MqlName() string {
+	return "pkgFileSearch"
 }
 
-func (c *mqlPlatform) MqlID() string {
+func (c *mqlPkgFileSearch) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatform) GetVulnerabilityReport() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.VulnerabilityReport, func() (interface{}, error) {
-		return c.vulnerabilityReport()
-	})
-}
-
-// mqlVulnmgmt for the vulnmgmt resource
-type mqlVulnmgmt struct {
+// mqlPackages for the packages resource
+type mqlPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlVulnmgmtInternal
-	Cves plugin.TValue[[]interface{}]
-	Advisories plugin.TValue[[]interface{}]
-	Packages plugin.TValue[[]interface{}]
-	LastAssessment plugin.TValue[*time.Time]
-	Stats plugin.TValue[*mqlAuditCvss]
+	mqlPackagesInternal
+	List plugin.TValue[[]interface{}]
 }
 
-// createVulnmgmt creates a new instance of this resource
-func createVulnmgmt(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlVulnmgmt{
+// createPackages creates a new instance of this resource
+func createPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -5186,7 +10472,7 @@ func createVulnmgmt(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("vulnmgmt", res.__id)
+		args, err = runtime.ResourceFromRecording("packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5196,50 +10482,18 @@ func createVulnmgmt(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlVulnmgmt) MqlName() string {
-	return "vulnmgmt"
+func (c *mqlPackages) MqlName() string {
+	return "packages"
 }
 
-func (c *mqlVulnmgmt) MqlID() string {
+func (c *mqlPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlVulnmgmt) GetCves() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Cves, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "cves")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.cves()
-	})
-}
-
-func (c *mqlVulnmgmt) GetAdvisories() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Advisories, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "advisories")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.advisories()
-	})
-}
-
-func (c *mqlVulnmgmt) GetPackages() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Packages, func() ([]interface{}, error) {
+func (c *mqlPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "packages")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -5248,49 +10502,24 @@ func (c *mqlVulnmgmt) GetPackages() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.packages()
-	})
-}
-
-func (c *mqlVulnmgmt) GetLastAssessment() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.LastAssessment, func() (*time.Time, error) {
-		return c.lastAssessment()
-	})
-}
-
-func (c *mqlVulnmgmt) GetStats() *plugin.TValue[*mqlAuditCvss] {
-	return plugin.GetOrCompute[*mqlAuditCvss](&c.Stats, func() (*mqlAuditCvss, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "stats")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlAuditCvss), nil
-			}
-		}
-
-		return c.stats()
+		return c.list()
 	})
 }
 
-// mqlVulnCve for the vuln.cve resource
-type mqlVulnCve struct {
+// mqlPamConf for the pam.conf resource
+type mqlPamConf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlVulnCveInternal it will be used here
-	Id plugin.TValue[string]
-	State plugin.TValue[string]
-	Summary plugin.TValue[string]
-	Unscored plugin.TValue[bool]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+	// optional: if you define mqlPamConfInternal it will be used here
+	Files plugin.TValue[[]interface{}]
+	Content plugin.TValue[string]
+	Services plugin.TValue[map[string]interface{}]
+	Entries plugin.TValue[map[string]interface{}]
 }
 
-// createVulnCve creates a new instance of this resource
-func createVulnCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlVulnCve{
+// createPamConf creates a new instance of this resource
+func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPamConf{
 		MqlRuntime: runtime,
 	}
 
@@ -5307,7 +10536,7 @@ func createVulnCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("vuln.cve", res.__id)
+		args, err = runtime.ResourceFromRecording("pam.conf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5317,58 +10546,89 @@ func createVulnCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlVulnCve) MqlName() string {
-	return "vuln.cve"
+func (c *mqlPamConf) MqlName() string {
+	return "pam.conf"
 }
 
-func (c *mqlVulnCve) MqlID() string {
+func (c *mqlPamConf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlVulnCve) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
+func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlVulnCve) GetState() *plugin.TValue[string] {
-	return &c.State
+		return c.files()
+	})
 }
 
-func (c *mqlVulnCve) GetSummary() *plugin.TValue[string] {
-	return &c.Summary
-}
+func (c *mqlPamConf) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
+		}
 
-func (c *mqlVulnCve) GetUnscored() *plugin.TValue[bool] {
-	return &c.Unscored
+		return c.content(vargFiles.Data)
+	})
 }
 
-func (c *mqlVulnCve) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
-}
+func (c *mqlPamConf) GetServices() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Services, func() (map[string]interface{}, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
 
-func (c *mqlVulnCve) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
+		return c.services(vargFiles.Data)
+	})
 }
 
-func (c *mqlVulnCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
+func (c *mqlPamConf) GetEntries() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Entries, func() (map[string]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(map[string]interface{}), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.entries(vargFiles.Data)
+	})
 }
 
-// mqlVulnAdvisory for the vuln.advisory resource
-type mqlVulnAdvisory struct {
+// mqlPamConfServiceEntry for the pam.conf.serviceEntry resource
+type mqlPamConfServiceEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlVulnAdvisoryInternal it will be used here
-	Id plugin.TValue[string]
-	Title plugin.TValue[string]
-	Description plugin.TValue[string]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+	// optional: if you define mqlPamConfServiceEntryInternal it will be used here
+	Service plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	PamType plugin.TValue[string]
+	Control plugin.TValue[string]
+	Module plugin.TValue[string]
+	Options plugin.TValue[[]interface{}]
 }
 
-// createVulnAdvisory creates a new instance of this resource
-func createVulnAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlVulnAdvisory{
+// createPamConfServiceEntry creates a new instance of this resource
+func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPamConfServiceEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -5385,7 +10645,7 @@ func createVulnAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("vuln.advisory", res.__id)
+		args, err = runtime.ResourceFromRecording("pam.conf.serviceEntry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5395,52 +10655,48 @@ func createVulnAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlVulnAdvisory) MqlName() string {
-	return "vuln.advisory"
+func (c *mqlPamConfServiceEntry) MqlName() string {
+	return "pam.conf.serviceEntry"
 }
 
-func (c *mqlVulnAdvisory) MqlID() string {
+func (c *mqlPamConfServiceEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlVulnAdvisory) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlPamConfServiceEntry) GetService() *plugin.TValue[string] {
+	return &c.Service
 }
 
-func (c *mqlVulnAdvisory) GetTitle() *plugin.TValue[string] {
-	return &c.Title
+func (c *mqlPamConfServiceEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-func (c *mqlVulnAdvisory) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlPamConfServiceEntry) GetPamType() *plugin.TValue[string] {
+	return &c.PamType
 }
 
-func (c *mqlVulnAdvisory) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
+func (c *mqlPamConfServiceEntry) GetControl() *plugin.TValue[string] {
+	return &c.Control
 }
 
-func (c *mqlVulnAdvisory) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
+func (c *mqlPamConfServiceEntry) GetModule() *plugin.TValue[string] {
+	return &c.Module
 }
 
-func (c *mqlVulnAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
+func (c *mqlPamConfServiceEntry) GetOptions() *plugin.TValue[[]interface{}] {
+	return &c.Options
 }
 
-// mqlVulnPackage for the vuln.package resource
-type mqlVulnPackage struct {
+// mqlSshd for the sshd resource
+type mqlSshd struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlVulnPackageInternal it will be used here
-	Name plugin.TValue[string]
-	Version plugin.TValue[string]
-	Available plugin.TValue[string]
-	Arch plugin.TValue[string]
+	// optional: if you define mqlSshdInternal it will be used here
 }
 
-// createVulnPackage creates a new instance of this resource
-func createVulnPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlVulnPackage{
+// createSshd creates a new instance of this resource
+func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshd{
 		MqlRuntime: runtime,
 	}
 
@@ -5449,15 +10705,10 @@ func createVulnPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("vuln.package", res.__id)
+		args, err = runtime.ResourceFromRecording("sshd", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5467,43 +10718,34 @@ func createVulnPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlVulnPackage) MqlName() string {
-	return "vuln.package"
+func (c *mqlSshd) MqlName() string {
+	return "sshd"
 }
 
-func (c *mqlVulnPackage) MqlID() string {
+func (c *mqlSshd) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlVulnPackage) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlVulnPackage) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
-
-func (c *mqlVulnPackage) GetAvailable() *plugin.TValue[string] {
-	return &c.Available
-}
-
-func (c *mqlVulnPackage) GetArch() *plugin.TValue[string] {
-	return &c.Arch
-}
-
-// mqlPlatformAdvisories for the platform.advisories resource
-type mqlPlatformAdvisories struct {
+// mqlSshdConfig for the sshd.config resource
+type mqlSshdConfig struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformAdvisoriesInternal it will be used here
-	Cvss plugin.TValue[*mqlAuditCvss]
-	Stats plugin.TValue[interface{}]
-	List plugin.TValue[[]interface{}]
+	mqlSshdConfigInternal
+	File plugin.TValue[*mqlFile]
+	Files plugin.TValue[[]interface{}]
+	Content plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
+	Blocks plugin.TValue[[]interface{}]
+	Ciphers plugin.TValue[[]interface{}]
+	Macs plugin.TValue[[]interface{}]
+	Kexs plugin.TValue[[]interface{}]
+	Hostkeys plugin.TValue[[]interface{}]
+	PermitRootLogin plugin.TValue[[]interface{}]
 }
 
-// createPlatformAdvisories creates a new instance of this resource
-func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformAdvisories{
+// createSshdConfig creates a new instance of this resource
+func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshdConfig{
 		MqlRuntime: runtime,
 	}
 
@@ -5520,7 +10762,7 @@ func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawD
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.advisories", res.__id)
+		args, err = runtime.ResourceFromRecording("sshd.config", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5530,40 +10772,77 @@ func createPlatformAdvisories(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlPlatformAdvisories) MqlName() string {
-	return "platform.advisories"
+func (c *mqlSshdConfig) MqlName() string {
+	return "sshd.config"
 }
 
-func (c *mqlPlatformAdvisories) MqlID() string {
+func (c *mqlSshdConfig) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformAdvisories) GetCvss() *plugin.TValue[*mqlAuditCvss] {
-	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+func (c *mqlSshdConfig) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "cvss")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "file")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlAuditCvss), nil
+				return d.Value.(*mqlFile), nil
 			}
 		}
 
-		return c.cvss()
+		return c.file()
 	})
 }
 
-func (c *mqlPlatformAdvisories) GetStats() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
-		return c.stats()
+func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.files(vargFile.Data)
 	})
 }
 
-func (c *mqlPlatformAdvisories) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlSshdConfig) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.params(vargFile.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetBlocks() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Blocks, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.advisories", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "blocks")
 			if err != nil {
 				return nil, err
 			}
@@ -5572,23 +10851,134 @@ func (c *mqlPlatformAdvisories) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.blocks(vargFile.Data)
 	})
 }
 
-// mqlPlatformCves for the platform.cves resource
-type mqlPlatformCves struct {
+func (c *mqlSshdConfig) GetCiphers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.ciphers(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetMacs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.macs(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetKexs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Kexs, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.kexs(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetHostkeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Hostkeys, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.hostkeys(vargParams.Data)
+	})
+}
+
+func (c *mqlSshdConfig) GetPermitRootLogin() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PermitRootLogin, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.permitRootLogin(vargParams.Data)
+	})
+}
+
+// mqlSshdConfigMatchBlock for the sshd.config.matchBlock resource
+type mqlSshdConfigMatchBlock struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPlatformCvesInternal it will be used here
-	Cvss plugin.TValue[*mqlAuditCvss]
-	Stats plugin.TValue[interface{}]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlSshdConfigMatchBlockInternal it will be used here
+	Criteria plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
+}
+
+// createSshdConfigMatchBlock creates a new instance of this resource
+func createSshdConfigMatchBlock(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshdConfigMatchBlock{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("sshd.config.matchBlock", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSshdConfigMatchBlock) MqlName() string {
+	return "sshd.config.matchBlock"
+}
+
+func (c *mqlSshdConfigMatchBlock) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSshdConfigMatchBlock) GetCriteria() *plugin.TValue[string] {
+	return &c.Criteria
+}
+
+func (c *mqlSshdConfigMatchBlock) GetParams() *plugin.TValue[map[string]interface{}] {
+	return &c.Params
+}
+
+// mqlSudoers for the sudoers resource
+type mqlSudoers struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlSudoersInternal
+	File plugin.TValue[*mqlFile]
+	Files plugin.TValue[[]interface{}]
+	Aliases plugin.TValue[[]interface{}]
+	Defaults plugin.TValue[[]interface{}]
+	UserSpecs plugin.TValue[[]interface{}]
 }
 
-// createPlatformCves creates a new instance of this resource
-func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPlatformCves{
+// createSudoers creates a new instance of this resource
+func createSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoers{
 		MqlRuntime: runtime,
 	}
 
@@ -5605,7 +10995,7 @@ func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("platform.cves", res.__id)
+		args, err = runtime.ResourceFromRecording("sudoers", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5615,40 +11005,34 @@ func createPlatformCves(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlPlatformCves) MqlName() string {
-	return "platform.cves"
+func (c *mqlSudoers) MqlName() string {
+	return "sudoers"
 }
 
-func (c *mqlPlatformCves) MqlID() string {
+func (c *mqlSudoers) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPlatformCves) GetCvss() *plugin.TValue[*mqlAuditCvss] {
-	return plugin.GetOrCompute[*mqlAuditCvss](&c.Cvss, func() (*mqlAuditCvss, error) {
+func (c *mqlSudoers) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "cvss")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "file")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlAuditCvss), nil
+				return d.Value.(*mqlFile), nil
 			}
 		}
 
-		return c.cvss()
-	})
-}
-
-func (c *mqlPlatformCves) GetStats() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Stats, func() (interface{}, error) {
-		return c.stats()
+		return c.file()
 	})
 }
 
-func (c *mqlPlatformCves) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlSudoers) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("platform.cves", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -5657,76 +11041,93 @@ func (c *mqlPlatformCves) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
-	})
-}
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
 
-// mqlAuditCvss for the audit.cvss resource
-type mqlAuditCvss struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlAuditCvssInternal it will be used here
-	Score plugin.TValue[float64]
-	Vector plugin.TValue[string]
+		return c.files(vargFile.Data)
+	})
 }
 
-// createAuditCvss creates a new instance of this resource
-func createAuditCvss(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditCvss{
-		MqlRuntime: runtime,
-	}
+func (c *mqlSudoers) GetAliases() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Aliases, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "aliases")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
 
-	// to override __id implement: id() (string, error)
+		return c.aliases(vargFile.Data)
+	})
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.cvss", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlSudoers) GetDefaults() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Defaults, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "defaults")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
-}
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
 
-func (c *mqlAuditCvss) MqlName() string {
-	return "audit.cvss"
+		return c.defaults(vargFile.Data)
+	})
 }
 
-func (c *mqlAuditCvss) MqlID() string {
-	return c.__id
-}
+func (c *mqlSudoers) GetUserSpecs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.UserSpecs, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "userSpecs")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAuditCvss) GetScore() *plugin.TValue[float64] {
-	return &c.Score
-}
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
 
-func (c *mqlAuditCvss) GetVector() *plugin.TValue[string] {
-	return &c.Vector
+		return c.userSpecs(vargFile.Data)
+	})
 }
 
-// mqlAuditAdvisory for the audit.advisory resource
-type mqlAuditAdvisory struct {
+// mqlSudoersAlias for the sudoers.alias resource
+type mqlSudoersAlias struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditAdvisoryInternal it will be used here
-	Id plugin.TValue[string]
-	Mrn plugin.TValue[string]
-	Title plugin.TValue[string]
-	Description plugin.TValue[string]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+	// optional: if you define mqlSudoersAliasInternal it will be used here
+	Type plugin.TValue[string]
+	Name plugin.TValue[string]
+	Members plugin.TValue[[]interface{}]
+	File plugin.TValue[*mqlFile]
+	LineNumber plugin.TValue[int64]
 }
 
-// createAuditAdvisory creates a new instance of this resource
-func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditAdvisory{
+// createSudoersAlias creates a new instance of this resource
+func createSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersAlias{
 		MqlRuntime: runtime,
 	}
 
@@ -5738,7 +11139,7 @@ func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.advisory", res.__id)
+		args, err = runtime.ResourceFromRecording("sudoers.alias", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5748,60 +11149,52 @@ func createAuditAdvisory(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlAuditAdvisory) MqlName() string {
-	return "audit.advisory"
+func (c *mqlSudoersAlias) MqlName() string {
+	return "sudoers.alias"
 }
 
-func (c *mqlAuditAdvisory) MqlID() string {
+func (c *mqlSudoersAlias) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditAdvisory) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlAuditAdvisory) GetMrn() *plugin.TValue[string] {
-	return &c.Mrn
-}
-
-func (c *mqlAuditAdvisory) GetTitle() *plugin.TValue[string] {
-	return &c.Title
+func (c *mqlSudoersAlias) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlAuditAdvisory) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlSudoersAlias) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlAuditAdvisory) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
+func (c *mqlSudoersAlias) GetMembers() *plugin.TValue[[]interface{}] {
+	return &c.Members
 }
 
-func (c *mqlAuditAdvisory) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
+func (c *mqlSudoersAlias) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlAuditAdvisory) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
+func (c *mqlSudoersAlias) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-// mqlAuditCve for the audit.cve resource
-type mqlAuditCve struct {
+// mqlSudoersDefault for the sudoers.default resource
+type mqlSudoersDefault struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditCveInternal it will be used here
-	Id plugin.TValue[string]
-	Mrn plugin.TValue[string]
-	State plugin.TValue[string]
-	Summary plugin.TValue[string]
-	Unscored plugin.TValue[bool]
-	Published plugin.TValue[*time.Time]
-	Modified plugin.TValue[*time.Time]
-	WorstScore plugin.TValue[*mqlAuditCvss]
+	// optional: if you define mqlSudoersDefaultInternal it will be used here
+	Binding plugin.TValue[string]
+	Targets plugin.TValue[[]interface{}]
+	Name plugin.TValue[string]
+	Operator plugin.TValue[string]
+	Value plugin.TValue[string]
+	Negated plugin.TValue[bool]
+	File plugin.TValue[*mqlFile]
+	LineNumber plugin.TValue[int64]
 }
 
-// createAuditCve creates a new instance of this resource
-func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditCve{
+// createSudoersDefault creates a new instance of this resource
+func createSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersDefault{
 		MqlRuntime: runtime,
 	}
 
@@ -5813,7 +11206,7 @@ func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("audit.cve", res.__id)
+		args, err = runtime.ResourceFromRecording("sudoers.default", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5823,56 +11216,65 @@ func createAuditCve(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAuditCve) MqlName() string {
-	return "audit.cve"
+func (c *mqlSudoersDefault) MqlName() string {
+	return "sudoers.default"
 }
 
-func (c *mqlAuditCve) MqlID() string {
+func (c *mqlSudoersDefault) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditCve) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlSudoersDefault) GetBinding() *plugin.TValue[string] {
+	return &c.Binding
 }
 
-func (c *mqlAuditCve) GetMrn() *plugin.TValue[string] {
-	return &c.Mrn
+func (c *mqlSudoersDefault) GetTargets() *plugin.TValue[[]interface{}] {
+	return &c.Targets
 }
 
-func (c *mqlAuditCve) GetState() *plugin.TValue[string] {
-	return &c.State
+func (c *mqlSudoersDefault) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlAuditCve) GetSummary() *plugin.TValue[string] {
-	return &c.Summary
+func (c *mqlSudoersDefault) GetOperator() *plugin.TValue[string] {
+	return &c.Operator
 }
 
-func (c *mqlAuditCve) GetUnscored() *plugin.TValue[bool] {
-	return &c.Unscored
+func (c *mqlSudoersDefault) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-func (c *mqlAuditCve) GetPublished() *plugin.TValue[*time.Time] {
-	return &c.Published
+func (c *mqlSudoersDefault) GetNegated() *plugin.TValue[bool] {
+	return &c.Negated
 }
 
-func (c *mqlAuditCve) GetModified() *plugin.TValue[*time.Time] {
-	return &c.Modified
+func (c *mqlSudoersDefault) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlAuditCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
-	return &c.WorstScore
+func (c *mqlSudoersDefault) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-// mqlMachine for the machine resource
-type mqlMachine struct {
+// mqlSudoersUserSpec for the sudoers.userSpec resource
+type mqlSudoersUserSpec struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlMachineInternal
+	// optional: if you define mqlSudoersUserSpecInternal it will be used here
+	Users plugin.TValue[[]interface{}]
+	Hosts plugin.TValue[[]interface{}]
+	RunasUsers plugin.TValue[[]interface{}]
+	RunasGroups plugin.TValue[[]interface{}]
+	Commands plugin.TValue[[]interface{}]
+	Tags plugin.TValue[[]interface{}]
+	NoPassword plugin.TValue[bool]
+	File plugin.TValue[*mqlFile]
+	LineNumber plugin.TValue[int64]
 }
 
-// createMachine creates a new instance of this resource
-func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachine{
+// createSudoersUserSpec creates a new instance of this resource
+func createSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersUserSpec{
 		MqlRuntime: runtime,
 	}
 
@@ -5884,7 +11286,7 @@ func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine", res.__id)
+		args, err = runtime.ResourceFromRecording("sudoers.userSpec", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5894,85 +11296,67 @@ func createMachine(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlMachine) MqlName() string {
-	return "machine"
+func (c *mqlSudoersUserSpec) MqlName() string {
+	return "sudoers.userSpec"
 }
 
-func (c *mqlMachine) MqlID() string {
+func (c *mqlSudoersUserSpec) MqlID() string {
 	return c.__id
 }
 
-// mqlMachineBios for the machine.bios resource
-type mqlMachineBios struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlMachineBiosInternal it will be used here
-	Vendor plugin.TValue[string]
-	Version plugin.TValue[string]
-	ReleaseDate plugin.TValue[string]
+func (c *mqlSudoersUserSpec) GetUsers() *plugin.TValue[[]interface{}] {
+	return &c.Users
 }
 
-// createMachineBios creates a new instance of this resource
-func createMachineBios(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineBios{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
+func (c *mqlSudoersUserSpec) GetHosts() *plugin.TValue[[]interface{}] {
+	return &c.Hosts
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.bios", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlSudoersUserSpec) GetRunasUsers() *plugin.TValue[[]interface{}] {
+	return &c.RunasUsers
+}
 
-	return res, nil
+func (c *mqlSudoersUserSpec) GetRunasGroups() *plugin.TValue[[]interface{}] {
+	return &c.RunasGroups
 }
 
-func (c *mqlMachineBios) MqlName() string {
-	return "machine.bios"
+func (c *mqlSudoersUserSpec) GetCommands() *plugin.TValue[[]interface{}] {
+	return &c.Commands
 }
 
-func (c *mqlMachineBios) MqlID() string {
-	return c.__id
+func (c *mqlSudoersUserSpec) GetTags() *plugin.TValue[[]interface{}] {
+	return &c.Tags
 }
 
-func (c *mqlMachineBios) GetVendor() *plugin.TValue[string] {
-	return &c.Vendor
+func (c *mqlSudoersUserSpec) GetNoPassword() *plugin.TValue[bool] {
+	return &c.NoPassword
 }
 
-func (c *mqlMachineBios) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlSudoersUserSpec) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMachineBios) GetReleaseDate() *plugin.TValue[string] {
-	return &c.ReleaseDate
+func (c *mqlSudoersUserSpec) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-// mqlMachineSystem for the machine.system resource
-type mqlMachineSystem struct {
+// mqlService for the service resource
+type mqlService struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineSystemInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Product plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	Uuid plugin.TValue[string]
-	Sku plugin.TValue[string]
-	Family plugin.TValue[string]
+	// optional: if you define mqlServiceInternal it will be used here
+	Name plugin.TValue[string]
+	Description plugin.TValue[string]
+	Installed plugin.TValue[bool]
+	Running plugin.TValue[bool]
+	Enabled plugin.TValue[bool]
+	Type plugin.TValue[string]
+	Masked plugin.TValue[bool]
 }
 
-// createMachineSystem creates a new instance of this resource
-func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineSystem{
+// createService creates a new instance of this resource
+func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlService{
 		MqlRuntime: runtime,
 	}
 
@@ -5981,10 +11365,15 @@ func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.system", res.__id)
+		args, err = runtime.ResourceFromRecording("service", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5994,57 +11383,53 @@ func createMachineSystem(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlMachineSystem) MqlName() string {
-	return "machine.system"
+func (c *mqlService) MqlName() string {
+	return "service"
 }
 
-func (c *mqlMachineSystem) MqlID() string {
+func (c *mqlService) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineSystem) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
+func (c *mqlService) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlMachineSystem) GetProduct() *plugin.TValue[string] {
-	return &c.Product
+func (c *mqlService) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlMachineSystem) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlMachineSystem) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
+func (c *mqlService) GetRunning() *plugin.TValue[bool] {
+	return &c.Running
 }
 
-func (c *mqlMachineSystem) GetUuid() *plugin.TValue[string] {
-	return &c.Uuid
+func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
 }
 
-func (c *mqlMachineSystem) GetSku() *plugin.TValue[string] {
-	return &c.Sku
+func (c *mqlService) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlMachineSystem) GetFamily() *plugin.TValue[string] {
-	return &c.Family
+func (c *mqlService) GetMasked() *plugin.TValue[bool] {
+	return &c.Masked
 }
 
-// mqlMachineBaseboard for the machine.baseboard resource
-type mqlMachineBaseboard struct {
+// mqlServices for the services resource
+type mqlServices struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineBaseboardInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Product plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	AssetTag plugin.TValue[string]
+	mqlServicesInternal
+	List plugin.TValue[[]interface{}]
 }
 
-// createMachineBaseboard creates a new instance of this resource
-func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineBaseboard{
+// createServices creates a new instance of this resource
+func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlServices{
 		MqlRuntime: runtime,
 	}
 
@@ -6056,7 +11441,7 @@ func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.baseboard", res.__id)
+		args, err = runtime.ResourceFromRecording("services", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6066,48 +11451,41 @@ func createMachineBaseboard(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlMachineBaseboard) MqlName() string {
-	return "machine.baseboard"
+func (c *mqlServices) MqlName() string {
+	return "services"
 }
 
-func (c *mqlMachineBaseboard) MqlID() string {
+func (c *mqlServices) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineBaseboard) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
-}
-
-func (c *mqlMachineBaseboard) GetProduct() *plugin.TValue[string] {
-	return &c.Product
-}
-
-func (c *mqlMachineBaseboard) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
-
-func (c *mqlMachineBaseboard) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
-}
+func (c *mqlServices) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlMachineBaseboard) GetAssetTag() *plugin.TValue[string] {
-	return &c.AssetTag
+		return c.list()
+	})
 }
 
-// mqlMachineChassis for the machine.chassis resource
-type mqlMachineChassis struct {
+// mqlCron for the cron resource
+type mqlCron struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMachineChassisInternal it will be used here
-	Manufacturer plugin.TValue[string]
-	Version plugin.TValue[string]
-	Serial plugin.TValue[string]
-	AssetTag plugin.TValue[string]
+	// optional: if you define mqlCronInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createMachineChassis creates a new instance of this resource
-func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMachineChassis{
+// createCron creates a new instance of this resource
+func createCron(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCron{
 		MqlRuntime: runtime,
 	}
 
@@ -6116,10 +11494,15 @@ func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("machine.chassis", res.__id)
+		args, err = runtime.ResourceFromRecording("cron", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6129,48 +11512,46 @@ func createMachineChassis(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlMachineChassis) MqlName() string {
-	return "machine.chassis"
+func (c *mqlCron) MqlName() string {
+	return "cron"
 }
 
-func (c *mqlMachineChassis) MqlID() string {
+func (c *mqlCron) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMachineChassis) GetManufacturer() *plugin.TValue[string] {
-	return &c.Manufacturer
-}
-
-func (c *mqlMachineChassis) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
-
-func (c *mqlMachineChassis) GetSerial() *plugin.TValue[string] {
-	return &c.Serial
-}
+func (c *mqlCron) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("cron", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlMachineChassis) GetAssetTag() *plugin.TValue[string] {
-	return &c.AssetTag
+		return c.list()
+	})
 }
 
-// mqlOs for the os resource
-type mqlOs struct {
+// mqlCronEntry for the cron.entry resource
+type mqlCronEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsInternal it will be used here
-	Name plugin.TValue[string]
-	Env plugin.TValue[map[string]interface{}]
-	Path plugin.TValue[[]interface{}]
-	Uptime plugin.TValue[*time.Time]
-	Updates plugin.TValue[[]interface{}]
-	Rebootpending plugin.TValue[bool]
-	Hostname plugin.TValue[string]
-	Machineid plugin.TValue[string]
+	// optional: if you define mqlCronEntryInternal it will be used here
+	Schedule plugin.TValue[string]
+	User plugin.TValue[string]
+	Command plugin.TValue[string]
+	Type plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	LineNumber plugin.TValue[int64]
 }
 
-// createOs creates a new instance of this resource
-func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOs{
+// createCronEntry creates a new instance of this resource
+func createCronEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCronEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -6182,7 +11563,7 @@ func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Res
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os", res.__id)
+		args, err = runtime.ResourceFromRecording("cron.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6192,92 +11573,49 @@ func createOs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Res
 	return res, nil
 }
 
-func (c *mqlOs) MqlName() string {
-	return "os"
+func (c *mqlCronEntry) MqlName() string {
+	return "cron.entry"
 }
 
-func (c *mqlOs) MqlID() string {
+func (c *mqlCronEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOs) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
-}
-
-func (c *mqlOs) GetEnv() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
-		return c.env()
-	})
-}
-
-func (c *mqlOs) GetPath() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
-		vargEnv := c.GetEnv()
-		if vargEnv.Error != nil {
-			return nil, vargEnv.Error
-		}
-
-		return c.path(vargEnv.Data)
-	})
+func (c *mqlCronEntry) GetSchedule() *plugin.TValue[string] {
+	return &c.Schedule
 }
 
-func (c *mqlOs) GetUptime() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
-		return c.uptime()
-	})
+func (c *mqlCronEntry) GetUser() *plugin.TValue[string] {
+	return &c.User
 }
 
-func (c *mqlOs) GetUpdates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os", c.__id, "updates")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.updates()
-	})
+func (c *mqlCronEntry) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-func (c *mqlOs) GetRebootpending() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
-		return c.rebootpending()
-	})
+func (c *mqlCronEntry) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlOs) GetHostname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
-		return c.hostname()
-	})
+func (c *mqlCronEntry) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlOs) GetMachineid() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Machineid, func() (string, error) {
-		return c.machineid()
-	})
+func (c *mqlCronEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-// mqlOsUpdate for the os.update resource
-type mqlOsUpdate struct {
+// mqlSystemdTimers for the systemd.timers resource
+type mqlSystemdTimers struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsUpdateInternal it will be used here
-	Name plugin.TValue[string]
-	Category plugin.TValue[string]
-	Severity plugin.TValue[string]
-	Restart plugin.TValue[bool]
-	Format plugin.TValue[string]
+	// optional: if you define mqlSystemdTimersInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createOsUpdate creates a new instance of this resource
-func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsUpdate{
+// createSystemdTimers creates a new instance of this resource
+func createSystemdTimers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSystemdTimers{
 		MqlRuntime: runtime,
 	}
 
@@ -6294,7 +11632,7 @@ func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.update", res.__id)
+		args, err = runtime.ResourceFromRecording("systemd.timers", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6304,54 +11642,52 @@ func createOsUpdate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlOsUpdate) MqlName() string {
-	return "os.update"
+func (c *mqlSystemdTimers) MqlName() string {
+	return "systemd.timers"
 }
 
-func (c *mqlOsUpdate) MqlID() string {
+func (c *mqlSystemdTimers) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsUpdate) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlOsUpdate) GetCategory() *plugin.TValue[string] {
-	return &c.Category
-}
-
-func (c *mqlOsUpdate) GetSeverity() *plugin.TValue[string] {
-	return &c.Severity
-}
-
-func (c *mqlOsUpdate) GetRestart() *plugin.TValue[bool] {
-	return &c.Restart
-}
+func (c *mqlSystemdTimers) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("systemd.timers", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlOsUpdate) GetFormat() *plugin.TValue[string] {
-	return &c.Format
+		return c.list()
+	})
 }
 
-// mqlOsBase for the os.base resource
-type mqlOsBase struct {
+// mqlSystemdTimer for the systemd.timer resource
+type mqlSystemdTimer struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsBaseInternal it will be used here
-	Machine plugin.TValue[*mqlMachine]
+	// optional: if you define mqlSystemdTimerInternal it will be used here
 	Name plugin.TValue[string]
-	Env plugin.TValue[map[string]interface{}]
-	Path plugin.TValue[[]interface{}]
-	Uptime plugin.TValue[*time.Time]
-	Updates plugin.TValue[[]interface{}]
-	Rebootpending plugin.TValue[bool]
-	Hostname plugin.TValue[string]
-	Groups plugin.TValue[*mqlGroups]
-	Users plugin.TValue[*mqlUsers]
+	Description plugin.TValue[string]
+	Unit plugin.TValue[string]
+	Service plugin.TValue[*mqlService]
+	OnCalendar plugin.TValue[[]interface{}]
+	Monotonic plugin.TValue[[]interface{}]
+	Persistent plugin.TValue[bool]
+	Installed plugin.TValue[bool]
+	Enabled plugin.TValue[bool]
+	Masked plugin.TValue[bool]
+	LastTrigger plugin.TValue[*time.Time]
+	NextTrigger plugin.TValue[*time.Time]
 }
 
-// createOsBase creates a new instance of this resource
-func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsBase{
+// createSystemdTimer creates a new instance of this resource
+func createSystemdTimer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSystemdTimer{
 		MqlRuntime: runtime,
 	}
 
@@ -6368,7 +11704,7 @@ func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.base", res.__id)
+		args, err = runtime.ResourceFromRecording("systemd.timer", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6378,130 +11714,85 @@ func createOsBase(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlOsBase) MqlName() string {
-	return "os.base"
+func (c *mqlSystemdTimer) MqlName() string {
+	return "systemd.timer"
 }
 
-func (c *mqlOsBase) MqlID() string {
+func (c *mqlSystemdTimer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsBase) GetMachine() *plugin.TValue[*mqlMachine] {
-	return plugin.GetOrCompute[*mqlMachine](&c.Machine, func() (*mqlMachine, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "machine")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlMachine), nil
-			}
-		}
-
-		return c.machine()
-	})
-}
-
-func (c *mqlOsBase) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
-}
-
-func (c *mqlOsBase) GetEnv() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Env, func() (map[string]interface{}, error) {
-		return c.env()
-	})
+func (c *mqlSystemdTimer) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlOsBase) GetPath() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Path, func() ([]interface{}, error) {
-		vargEnv := c.GetEnv()
-		if vargEnv.Error != nil {
-			return nil, vargEnv.Error
-		}
-
-		return c.path(vargEnv.Data)
-	})
+func (c *mqlSystemdTimer) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlOsBase) GetUptime() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.Uptime, func() (*time.Time, error) {
-		return c.uptime()
-	})
+func (c *mqlSystemdTimer) GetUnit() *plugin.TValue[string] {
+	return &c.Unit
 }
 
-func (c *mqlOsBase) GetUpdates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Updates, func() ([]interface{}, error) {
+func (c *mqlSystemdTimer) GetService() *plugin.TValue[*mqlService] {
+	return plugin.GetOrCompute[*mqlService](&c.Service, func() (*mqlService, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "updates")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("systemd.timer", c.__id, "service")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlService), nil
 			}
 		}
 
-		return c.updates()
+		return c.service()
 	})
 }
 
-func (c *mqlOsBase) GetRebootpending() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Rebootpending, func() (bool, error) {
-		return c.rebootpending()
-	})
+func (c *mqlSystemdTimer) GetOnCalendar() *plugin.TValue[[]interface{}] {
+	return &c.OnCalendar
 }
 
-func (c *mqlOsBase) GetHostname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Hostname, func() (string, error) {
-		return c.hostname()
-	})
+func (c *mqlSystemdTimer) GetMonotonic() *plugin.TValue[[]interface{}] {
+	return &c.Monotonic
 }
 
-func (c *mqlOsBase) GetGroups() *plugin.TValue[*mqlGroups] {
-	return plugin.GetOrCompute[*mqlGroups](&c.Groups, func() (*mqlGroups, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "groups")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlGroups), nil
-			}
-		}
+func (c *mqlSystemdTimer) GetPersistent() *plugin.TValue[bool] {
+	return &c.Persistent
+}
 
-		return c.groups()
-	})
+func (c *mqlSystemdTimer) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlOsBase) GetUsers() *plugin.TValue[*mqlUsers] {
-	return plugin.GetOrCompute[*mqlUsers](&c.Users, func() (*mqlUsers, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.base", c.__id, "users")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlUsers), nil
-			}
-		}
+func (c *mqlSystemdTimer) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
 
-		return c.users()
-	})
+func (c *mqlSystemdTimer) GetMasked() *plugin.TValue[bool] {
+	return &c.Masked
 }
 
-// mqlOsUnix for the os.unix resource
-type mqlOsUnix struct {
+func (c *mqlSystemdTimer) GetLastTrigger() *plugin.TValue[*time.Time] {
+	return &c.LastTrigger
+}
+
+func (c *mqlSystemdTimer) GetNextTrigger() *plugin.TValue[*time.Time] {
+	return &c.NextTrigger
+}
+
+// mqlSystemdUnits for the systemd.units resource
+type mqlSystemdUnits struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsUnixInternal it will be used here
-	Base plugin.TValue[*mqlOsBase]
+	// optional: if you define mqlSystemdUnitsInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createOsUnix creates a new instance of this resource
-func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsUnix{
+// createSystemdUnits creates a new instance of this resource
+func createSystemdUnits(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSystemdUnits{
 		MqlRuntime: runtime,
 	}
 
@@ -6518,7 +11809,7 @@ func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.unix", res.__id)
+		args, err = runtime.ResourceFromRecording("systemd.units", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6528,43 +11819,76 @@ func createOsUnix(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlOsUnix) MqlName() string {
-	return "os.unix"
+func (c *mqlSystemdUnits) MqlName() string {
+	return "systemd.units"
 }
 
-func (c *mqlOsUnix) MqlID() string {
+func (c *mqlSystemdUnits) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsUnix) GetBase() *plugin.TValue[*mqlOsBase] {
-	return plugin.GetOrCompute[*mqlOsBase](&c.Base, func() (*mqlOsBase, error) {
+func (c *mqlSystemdUnits) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.unix", c.__id, "base")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("systemd.units", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlOsBase), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.base()
+		return c.list()
 	})
 }
 
-// mqlOsLinux for the os.linux resource
-type mqlOsLinux struct {
+// mqlSystemdUnit for the systemd.unit resource
+type mqlSystemdUnit struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsLinuxInternal it will be used here
-	Unix plugin.TValue[*mqlOsUnix]
-	Iptables plugin.TValue[*mqlIptables]
-	Ip6tables plugin.TValue[*mqlIp6tables]
-}
-
-// createOsLinux creates a new instance of this resource
-func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsLinux{
+	// optional: if you define mqlSystemdUnitInternal it will be used here
+	Name plugin.TValue[string]
+	Path plugin.TValue[string]
+	DropIns plugin.TValue[[]interface{}]
+	Masked plugin.TValue[bool]
+	Description plugin.TValue[string]
+	Options plugin.TValue[interface{}]
+	ExecStart plugin.TValue[[]interface{}]
+	User plugin.TValue[string]
+	Group plugin.TValue[string]
+	DynamicUser plugin.TValue[bool]
+	SupplementaryGroups plugin.TValue[[]interface{}]
+	ProtectSystem plugin.TValue[string]
+	ProtectHome plugin.TValue[string]
+	PrivateTmp plugin.TValue[bool]
+	PrivateDevices plugin.TValue[bool]
+	PrivateNetwork plugin.TValue[bool]
+	PrivateUsers plugin.TValue[bool]
+	ProtectKernelTunables plugin.TValue[bool]
+	ProtectKernelModules plugin.TValue[bool]
+	ProtectKernelLogs plugin.TValue[bool]
+	ProtectControlGroups plugin.TValue[bool]
+	ProtectClock plugin.TValue[bool]
+	ProtectHostname plugin.TValue[bool]
+	NoNewPrivileges plugin.TValue[bool]
+	RestrictRealtime plugin.TValue[bool]
+	RestrictSUIDSGID plugin.TValue[bool]
+	LockPersonality plugin.TValue[bool]
+	MemoryDenyWriteExecute plugin.TValue[bool]
+	CapabilityBoundingSet plugin.TValue[[]interface{}]
+	AmbientCapabilities plugin.TValue[[]interface{}]
+	RestrictAddressFamilies plugin.TValue[[]interface{}]
+	RestrictNamespaces plugin.TValue[[]interface{}]
+	SystemCallFilter plugin.TValue[[]interface{}]
+	Exposure plugin.TValue[float64]
+	ExposureLevel plugin.TValue[string]
+	SecurityChecks plugin.TValue[[]interface{}]
+}
+
+// createSystemdUnit creates a new instance of this resource
+func createSystemdUnit(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSystemdUnit{
 		MqlRuntime: runtime,
 	}
 
@@ -6581,7 +11905,7 @@ func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.linux", res.__id)
+		args, err = runtime.ResourceFromRecording("systemd.unit", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6591,75 +11915,175 @@ func createOsLinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlOsLinux) MqlName() string {
-	return "os.linux"
+func (c *mqlSystemdUnit) MqlName() string {
+	return "systemd.unit"
 }
 
-func (c *mqlOsLinux) MqlID() string {
+func (c *mqlSystemdUnit) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsLinux) GetUnix() *plugin.TValue[*mqlOsUnix] {
-	return plugin.GetOrCompute[*mqlOsUnix](&c.Unix, func() (*mqlOsUnix, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "unix")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlOsUnix), nil
-			}
-		}
+func (c *mqlSystemdUnit) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlSystemdUnit) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlSystemdUnit) GetDropIns() *plugin.TValue[[]interface{}] {
+	return &c.DropIns
+}
+
+func (c *mqlSystemdUnit) GetMasked() *plugin.TValue[bool] {
+	return &c.Masked
+}
+
+func (c *mqlSystemdUnit) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlSystemdUnit) GetOptions() *plugin.TValue[interface{}] {
+	return &c.Options
+}
+
+func (c *mqlSystemdUnit) GetExecStart() *plugin.TValue[[]interface{}] {
+	return &c.ExecStart
+}
+
+func (c *mqlSystemdUnit) GetUser() *plugin.TValue[string] {
+	return &c.User
+}
+
+func (c *mqlSystemdUnit) GetGroup() *plugin.TValue[string] {
+	return &c.Group
+}
+
+func (c *mqlSystemdUnit) GetDynamicUser() *plugin.TValue[bool] {
+	return &c.DynamicUser
+}
+
+func (c *mqlSystemdUnit) GetSupplementaryGroups() *plugin.TValue[[]interface{}] {
+	return &c.SupplementaryGroups
+}
+
+func (c *mqlSystemdUnit) GetProtectSystem() *plugin.TValue[string] {
+	return &c.ProtectSystem
+}
+
+func (c *mqlSystemdUnit) GetProtectHome() *plugin.TValue[string] {
+	return &c.ProtectHome
+}
+
+func (c *mqlSystemdUnit) GetPrivateTmp() *plugin.TValue[bool] {
+	return &c.PrivateTmp
+}
+
+func (c *mqlSystemdUnit) GetPrivateDevices() *plugin.TValue[bool] {
+	return &c.PrivateDevices
+}
+
+func (c *mqlSystemdUnit) GetPrivateNetwork() *plugin.TValue[bool] {
+	return &c.PrivateNetwork
+}
+
+func (c *mqlSystemdUnit) GetPrivateUsers() *plugin.TValue[bool] {
+	return &c.PrivateUsers
+}
+
+func (c *mqlSystemdUnit) GetProtectKernelTunables() *plugin.TValue[bool] {
+	return &c.ProtectKernelTunables
+}
+
+func (c *mqlSystemdUnit) GetProtectKernelModules() *plugin.TValue[bool] {
+	return &c.ProtectKernelModules
+}
+
+func (c *mqlSystemdUnit) GetProtectKernelLogs() *plugin.TValue[bool] {
+	return &c.ProtectKernelLogs
+}
+
+func (c *mqlSystemdUnit) GetProtectControlGroups() *plugin.TValue[bool] {
+	return &c.ProtectControlGroups
+}
+
+func (c *mqlSystemdUnit) GetProtectClock() *plugin.TValue[bool] {
+	return &c.ProtectClock
+}
+
+func (c *mqlSystemdUnit) GetProtectHostname() *plugin.TValue[bool] {
+	return &c.ProtectHostname
+}
+
+func (c *mqlSystemdUnit) GetNoNewPrivileges() *plugin.TValue[bool] {
+	return &c.NoNewPrivileges
+}
+
+func (c *mqlSystemdUnit) GetRestrictRealtime() *plugin.TValue[bool] {
+	return &c.RestrictRealtime
+}
+
+func (c *mqlSystemdUnit) GetRestrictSUIDSGID() *plugin.TValue[bool] {
+	return &c.RestrictSUIDSGID
+}
+
+func (c *mqlSystemdUnit) GetLockPersonality() *plugin.TValue[bool] {
+	return &c.LockPersonality
+}
+
+func (c *mqlSystemdUnit) GetMemoryDenyWriteExecute() *plugin.TValue[bool] {
+	return &c.MemoryDenyWriteExecute
+}
+
+func (c *mqlSystemdUnit) GetCapabilityBoundingSet() *plugin.TValue[[]interface{}] {
+	return &c.CapabilityBoundingSet
+}
+
+func (c *mqlSystemdUnit) GetAmbientCapabilities() *plugin.TValue[[]interface{}] {
+	return &c.AmbientCapabilities
+}
+
+func (c *mqlSystemdUnit) GetRestrictAddressFamilies() *plugin.TValue[[]interface{}] {
+	return &c.RestrictAddressFamilies
+}
 
-		return c.unix()
-	})
+func (c *mqlSystemdUnit) GetRestrictNamespaces() *plugin.TValue[[]interface{}] {
+	return &c.RestrictNamespaces
 }
 
-func (c *mqlOsLinux) GetIptables() *plugin.TValue[*mqlIptables] {
-	return plugin.GetOrCompute[*mqlIptables](&c.Iptables, func() (*mqlIptables, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "iptables")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlIptables), nil
-			}
-		}
+func (c *mqlSystemdUnit) GetSystemCallFilter() *plugin.TValue[[]interface{}] {
+	return &c.SystemCallFilter
+}
 
-		return c.iptables()
-	})
+func (c *mqlSystemdUnit) GetExposure() *plugin.TValue[float64] {
+	return &c.Exposure
 }
 
-func (c *mqlOsLinux) GetIp6tables() *plugin.TValue[*mqlIp6tables] {
-	return plugin.GetOrCompute[*mqlIp6tables](&c.Ip6tables, func() (*mqlIp6tables, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.linux", c.__id, "ip6tables")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlIp6tables), nil
-			}
-		}
+func (c *mqlSystemdUnit) GetExposureLevel() *plugin.TValue[string] {
+	return &c.ExposureLevel
+}
 
-		return c.ip6tables()
-	})
+func (c *mqlSystemdUnit) GetSecurityChecks() *plugin.TValue[[]interface{}] {
+	return &c.SecurityChecks
 }
 
-// mqlOsRootCertificates for the os.rootCertificates resource
-type mqlOsRootCertificates struct {
+// mqlSelinux for the selinux resource
+type mqlSelinux struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOsRootCertificatesInternal it will be used here
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[[]interface{}]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlSelinuxInternal it will be used here
+	Enabled plugin.TValue[bool]
+	Mode plugin.TValue[string]
+	ConfigMode plugin.TValue[string]
+	PolicyType plugin.TValue[string]
+	PolicyVersion plugin.TValue[int64]
+	Booleans plugin.TValue[[]interface{}]
+	Modules plugin.TValue[[]interface{}]
 }
 
-// createOsRootCertificates creates a new instance of this resource
-func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOsRootCertificates{
+// createSelinux creates a new instance of this resource
+func createSelinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinux{
 		MqlRuntime: runtime,
 	}
 
@@ -6676,7 +12100,7 @@ func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawD
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("os.rootCertificates", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6686,33 +12110,38 @@ func createOsRootCertificates(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlOsRootCertificates) MqlName() string {
-	return "os.rootCertificates"
+func (c *mqlSelinux) MqlName() string {
+	return "selinux"
 }
 
-func (c *mqlOsRootCertificates) MqlID() string {
+func (c *mqlSelinux) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOsRootCertificates) GetFiles() *plugin.TValue[[]interface{}] {
-	return &c.Files
+func (c *mqlSelinux) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
 }
 
-func (c *mqlOsRootCertificates) GetContent() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Content, func() ([]interface{}, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
+func (c *mqlSelinux) GetMode() *plugin.TValue[string] {
+	return &c.Mode
+}
 
-		return c.content(vargFiles.Data)
-	})
+func (c *mqlSelinux) GetConfigMode() *plugin.TValue[string] {
+	return &c.ConfigMode
 }
 
-func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlSelinux) GetPolicyType() *plugin.TValue[string] {
+	return &c.PolicyType
+}
+
+func (c *mqlSelinux) GetPolicyVersion() *plugin.TValue[int64] {
+	return &c.PolicyVersion
+}
+
+func (c *mqlSelinux) GetBooleans() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Booleans, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("os.rootCertificates", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("selinux", c.__id, "booleans")
 			if err != nil {
 				return nil, err
 			}
@@ -6721,29 +12150,39 @@ func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
+		return c.booleans()
+	})
+}
+
+func (c *mqlSelinux) GetModules() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Modules, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("selinux", c.__id, "modules")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.list(vargContent.Data)
+		return c.modules()
 	})
 }
 
-// mqlCommand for the command resource
-type mqlCommand struct {
+// mqlSelinuxBoolean for the selinux.boolean resource
+type mqlSelinuxBoolean struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlCommandInternal
-	Command plugin.TValue[string]
-	Stdout plugin.TValue[string]
-	Stderr plugin.TValue[string]
-	Exitcode plugin.TValue[int64]
+	// optional: if you define mqlSelinuxBooleanInternal it will be used here
+	Name plugin.TValue[string]
+	Value plugin.TValue[bool]
+	Pending plugin.TValue[bool]
 }
 
-// createCommand creates a new instance of this resource
-func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCommand{
+// createSelinuxBoolean creates a new instance of this resource
+func createSelinuxBoolean(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxBoolean{
 		MqlRuntime: runtime,
 	}
 
@@ -6752,15 +12191,10 @@ func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("command", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.boolean", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6770,65 +12204,39 @@ func createCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlCommand) MqlName() string {
-	return "command"
+func (c *mqlSelinuxBoolean) MqlName() string {
+	return "selinux.boolean"
 }
 
-func (c *mqlCommand) MqlID() string {
+func (c *mqlSelinuxBoolean) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCommand) GetCommand() *plugin.TValue[string] {
-	return &c.Command
-}
-
-func (c *mqlCommand) GetStdout() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return "", vargCommand.Error
-		}
-
-		return c.stdout(vargCommand.Data)
-	})
+func (c *mqlSelinuxBoolean) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlCommand) GetStderr() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return "", vargCommand.Error
-		}
-
-		return c.stderr(vargCommand.Data)
-	})
+func (c *mqlSelinuxBoolean) GetValue() *plugin.TValue[bool] {
+	return &c.Value
 }
 
-func (c *mqlCommand) GetExitcode() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
-		vargCommand := c.GetCommand()
-		if vargCommand.Error != nil {
-			return 0, vargCommand.Error
-		}
-
-		return c.exitcode(vargCommand.Data)
-	})
+func (c *mqlSelinuxBoolean) GetPending() *plugin.TValue[bool] {
+	return &c.Pending
 }
 
-// mqlPowershell for the powershell resource
-type mqlPowershell struct {
+// mqlSelinuxModule for the selinux.module resource
+type mqlSelinuxModule struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlPowershellInternal
-	Script plugin.TValue[string]
-	Stdout plugin.TValue[string]
-	Stderr plugin.TValue[string]
-	Exitcode plugin.TValue[int64]
+	// optional: if you define mqlSelinuxModuleInternal it will be used here
+	Name plugin.TValue[string]
+	Priority plugin.TValue[int64]
+	Enabled plugin.TValue[bool]
 }
 
-// createPowershell creates a new instance of this resource
-func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPowershell{
+// createSelinuxModule creates a new instance of this resource
+func createSelinuxModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxModule{
 		MqlRuntime: runtime,
 	}
 
@@ -6837,15 +12245,10 @@ func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("powershell", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6855,56 +12258,38 @@ func createPowershell(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlPowershell) MqlName() string {
-	return "powershell"
+func (c *mqlSelinuxModule) MqlName() string {
+	return "selinux.module"
 }
 
-func (c *mqlPowershell) MqlID() string {
+func (c *mqlSelinuxModule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPowershell) GetScript() *plugin.TValue[string] {
-	return &c.Script
-}
-
-func (c *mqlPowershell) GetStdout() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stdout, func() (string, error) {
-		return c.stdout()
-	})
+func (c *mqlSelinuxModule) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlPowershell) GetStderr() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Stderr, func() (string, error) {
-		return c.stderr()
-	})
+func (c *mqlSelinuxModule) GetPriority() *plugin.TValue[int64] {
+	return &c.Priority
 }
 
-func (c *mqlPowershell) GetExitcode() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Exitcode, func() (int64, error) {
-		return c.exitcode()
-	})
+func (c *mqlSelinuxModule) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
 }
 
-// mqlFile for the file resource
-type mqlFile struct {
+// mqlSelinuxFileContext for the selinux.fileContext resource
+type mqlSelinuxFileContext struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFileInternal it will be used here
+	// optional: if you define mqlSelinuxFileContextInternal it will be used here
 	Path plugin.TValue[string]
-	Basename plugin.TValue[string]
-	Dirname plugin.TValue[string]
-	Content plugin.TValue[string]
-	Exists plugin.TValue[bool]
-	Permissions plugin.TValue[*mqlFilePermissions]
-	Size plugin.TValue[int64]
-	User plugin.TValue[*mqlUser]
-	Group plugin.TValue[*mqlGroup]
-	Empty plugin.TValue[bool]
+	Context plugin.TValue[string]
 }
 
-// createFile creates a new instance of this resource
-func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFile{
+// createSelinuxFileContext creates a new instance of this resource
+func createSelinuxFileContext(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxFileContext{
 		MqlRuntime: runtime,
 	}
 
@@ -6921,7 +12306,7 @@ func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("file", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.fileContext", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6931,169 +12316,121 @@ func createFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlFile) MqlName() string {
-	return "file"
+func (c *mqlSelinuxFileContext) MqlName() string {
+	return "selinux.fileContext"
 }
 
-func (c *mqlFile) MqlID() string {
+func (c *mqlSelinuxFileContext) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFile) GetPath() *plugin.TValue[string] {
-	return &c.Path
-}
-
-func (c *mqlFile) GetBasename() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Basename, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		return c.basename(vargPath.Data)
-	})
-}
-
-func (c *mqlFile) GetDirname() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Dirname, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		return c.dirname(vargPath.Data)
-	})
-}
-
-func (c *mqlFile) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return "", vargPath.Error
-		}
-
-		vargExists := c.GetExists()
-		if vargExists.Error != nil {
-			return "", vargExists.Error
-		}
-
-		return c.content(vargPath.Data, vargExists.Data)
-	})
-}
-
-func (c *mqlFile) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return false, vargPath.Error
-		}
-
-		return c.exists(vargPath.Data)
+func (c *mqlSelinuxFileContext) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlSelinuxFileContext) GetContext() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Context, func() (string, error) {
+		return c.context()
 	})
 }
 
-func (c *mqlFile) GetPermissions() *plugin.TValue[*mqlFilePermissions] {
-	return plugin.GetOrCompute[*mqlFilePermissions](&c.Permissions, func() (*mqlFilePermissions, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "permissions")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlFilePermissions), nil
-			}
+// mqlApparmor for the apparmor resource
+type mqlApparmor struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlApparmorInternal it will be used here
+	Enabled plugin.TValue[bool]
+	Profiles plugin.TValue[[]interface{}]
+	Processes plugin.TValue[[]interface{}]
+}
+
+// createApparmor creates a new instance of this resource
+func createApparmor(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlApparmor{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("apparmor", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.permissions(vargPath.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlFile) GetSize() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Size, func() (int64, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return 0, vargPath.Error
-		}
+func (c *mqlApparmor) MqlName() string {
+	return "apparmor"
+}
 
-		return c.size(vargPath.Data)
+func (c *mqlApparmor) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlApparmor) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		return c.enabled()
 	})
 }
 
-func (c *mqlFile) GetUser() *plugin.TValue[*mqlUser] {
-	return plugin.GetOrCompute[*mqlUser](&c.User, func() (*mqlUser, error) {
+func (c *mqlApparmor) GetProfiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Profiles, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "user")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("apparmor", c.__id, "profiles")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlUser), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.user()
+		return c.profiles()
 	})
 }
 
-func (c *mqlFile) GetGroup() *plugin.TValue[*mqlGroup] {
-	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
+func (c *mqlApparmor) GetProcesses() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Processes, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("file", c.__id, "group")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("apparmor", c.__id, "processes")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlGroup), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.group()
-	})
-}
-
-func (c *mqlFile) GetEmpty() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Empty, func() (bool, error) {
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return false, vargPath.Error
-		}
-
-		return c.empty(vargPath.Data)
+		return c.processes()
 	})
 }
 
-// mqlFilePermissions for the file.permissions resource
-type mqlFilePermissions struct {
+// mqlApparmorProfile for the apparmor.profile resource
+type mqlApparmorProfile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFilePermissionsInternal it will be used here
-	Mode plugin.TValue[int64]
-	User_readable plugin.TValue[bool]
-	User_writeable plugin.TValue[bool]
-	User_executable plugin.TValue[bool]
-	Group_readable plugin.TValue[bool]
-	Group_writeable plugin.TValue[bool]
-	Group_executable plugin.TValue[bool]
-	Other_readable plugin.TValue[bool]
-	Other_writeable plugin.TValue[bool]
-	Other_executable plugin.TValue[bool]
-	Suid plugin.TValue[bool]
-	Sgid plugin.TValue[bool]
-	Sticky plugin.TValue[bool]
-	IsDirectory plugin.TValue[bool]
-	IsFile plugin.TValue[bool]
-	IsSymlink plugin.TValue[bool]
-	String plugin.TValue[string]
+	// optional: if you define mqlApparmorProfileInternal it will be used here
+	Name plugin.TValue[string]
+	Mode plugin.TValue[string]
 }
 
-// createFilePermissions creates a new instance of this resource
-func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFilePermissions{
+// createApparmorProfile creates a new instance of this resource
+func createApparmorProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlApparmorProfile{
 		MqlRuntime: runtime,
 	}
 
@@ -7102,15 +12439,10 @@ func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("file.permissions", res.__id)
+		args, err = runtime.ResourceFromRecording("apparmor.profile", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7120,94 +12452,188 @@ func createFilePermissions(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlFilePermissions) MqlName() string {
-	return "file.permissions"
+func (c *mqlApparmorProfile) MqlName() string {
+	return "apparmor.profile"
 }
 
-func (c *mqlFilePermissions) MqlID() string {
+func (c *mqlApparmorProfile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFilePermissions) GetMode() *plugin.TValue[int64] {
+func (c *mqlApparmorProfile) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlApparmorProfile) GetMode() *plugin.TValue[string] {
 	return &c.Mode
 }
 
-func (c *mqlFilePermissions) GetUser_readable() *plugin.TValue[bool] {
-	return &c.User_readable
+// mqlApparmorProcess for the apparmor.process resource
+type mqlApparmorProcess struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlApparmorProcessInternal it will be used here
+	Pid plugin.TValue[int64]
+	Command plugin.TValue[string]
+	Profile plugin.TValue[string]
+	Mode plugin.TValue[string]
+	Process plugin.TValue[*mqlProcess]
 }
 
-func (c *mqlFilePermissions) GetUser_writeable() *plugin.TValue[bool] {
-	return &c.User_writeable
+// createApparmorProcess creates a new instance of this resource
+func createApparmorProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlApparmorProcess{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("apparmor.process", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlFilePermissions) GetUser_executable() *plugin.TValue[bool] {
-	return &c.User_executable
+func (c *mqlApparmorProcess) MqlName() string {
+	return "apparmor.process"
 }
 
-func (c *mqlFilePermissions) GetGroup_readable() *plugin.TValue[bool] {
-	return &c.Group_readable
+func (c *mqlApparmorProcess) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlFilePermissions) GetGroup_writeable() *plugin.TValue[bool] {
-	return &c.Group_writeable
+func (c *mqlApparmorProcess) GetPid() *plugin.TValue[int64] {
+	return &c.Pid
 }
 
-func (c *mqlFilePermissions) GetGroup_executable() *plugin.TValue[bool] {
-	return &c.Group_executable
+func (c *mqlApparmorProcess) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-func (c *mqlFilePermissions) GetOther_readable() *plugin.TValue[bool] {
-	return &c.Other_readable
+func (c *mqlApparmorProcess) GetProfile() *plugin.TValue[string] {
+	return &c.Profile
 }
 
-func (c *mqlFilePermissions) GetOther_writeable() *plugin.TValue[bool] {
-	return &c.Other_writeable
+func (c *mqlApparmorProcess) GetMode() *plugin.TValue[string] {
+	return &c.Mode
 }
 
-func (c *mqlFilePermissions) GetOther_executable() *plugin.TValue[bool] {
-	return &c.Other_executable
+func (c *mqlApparmorProcess) GetProcess() *plugin.TValue[*mqlProcess] {
+	return plugin.GetOrCompute[*mqlProcess](&c.Process, func() (*mqlProcess, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("apparmor.process", c.__id, "process")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlProcess), nil
+			}
+		}
+
+		return c.process()
+	})
 }
 
-func (c *mqlFilePermissions) GetSuid() *plugin.TValue[bool] {
-	return &c.Suid
+// mqlKernel for the kernel resource
+type mqlKernel struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlKernelInternal
+	Info plugin.TValue[interface{}]
+	Parameters plugin.TValue[map[string]interface{}]
+	Modules plugin.TValue[[]interface{}]
+	Installed plugin.TValue[[]interface{}]
 }
 
-func (c *mqlFilePermissions) GetSgid() *plugin.TValue[bool] {
-	return &c.Sgid
+// createKernel creates a new instance of this resource
+func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernel{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("kernel", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlFilePermissions) GetSticky() *plugin.TValue[bool] {
-	return &c.Sticky
+func (c *mqlKernel) MqlName() string {
+	return "kernel"
 }
 
-func (c *mqlFilePermissions) GetIsDirectory() *plugin.TValue[bool] {
-	return &c.IsDirectory
+func (c *mqlKernel) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlFilePermissions) GetIsFile() *plugin.TValue[bool] {
-	return &c.IsFile
+func (c *mqlKernel) GetInfo() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Info, func() (interface{}, error) {
+		return c.info()
+	})
 }
 
-func (c *mqlFilePermissions) GetIsSymlink() *plugin.TValue[bool] {
-	return &c.IsSymlink
+func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Parameters, func() (map[string]interface{}, error) {
+		return c.parameters()
+	})
 }
 
-func (c *mqlFilePermissions) GetString() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
-		return c.string()
+func (c *mqlKernel) GetModules() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Modules, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.modules()
 	})
 }
 
-// mqlFiles for the files resource
-type mqlFiles struct {
+func (c *mqlKernel) GetInstalled() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Installed, func() ([]interface{}, error) {
+		return c.installed()
+	})
+}
+
+// mqlKernelModule for the kernel.module resource
+type mqlKernelModule struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFilesInternal it will be used here
+	// optional: if you define mqlKernelModuleInternal it will be used here
+	Name plugin.TValue[string]
+	Size plugin.TValue[string]
+	Loaded plugin.TValue[bool]
 }
 
-// createFiles creates a new instance of this resource
-func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFiles{
+// createKernelModule creates a new instance of this resource
+func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernelModule{
 		MqlRuntime: runtime,
 	}
 
@@ -7216,10 +12642,15 @@ func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("files", res.__id)
+		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7229,31 +12660,38 @@ func createFiles(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlFiles) MqlName() string {
-	return "files"
+func (c *mqlKernelModule) MqlName() string {
+	return "kernel.module"
 }
 
-func (c *mqlFiles) MqlID() string {
+func (c *mqlKernelModule) MqlID() string {
 	return c.__id
 }
 
-// mqlFilesFind for the files.find resource
-type mqlFilesFind struct {
+func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
+	return &c.Size
+}
+
+func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
+	return &c.Loaded
+}
+
+// mqlDocker for the docker resource
+type mqlDocker struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlFilesFindInternal it will be used here
-	From plugin.TValue[string]
-	Xdev plugin.TValue[bool]
-	Type plugin.TValue[string]
-	Regex plugin.TValue[string]
-	Permissions plugin.TValue[int64]
-	Name plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerInternal it will be used here
+	Images plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
 }
 
-// createFilesFind creates a new instance of this resource
-func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFilesFind{
+// createDocker creates a new instance of this resource
+func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDocker{
 		MqlRuntime: runtime,
 	}
 
@@ -7262,15 +12700,10 @@ func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("files.find", res.__id)
+		args, err = runtime.ResourceFromRecording("docker", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7280,42 +12713,34 @@ func createFilesFind(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlFilesFind) MqlName() string {
-	return "files.find"
+func (c *mqlDocker) MqlName() string {
+	return "docker"
 }
 
-func (c *mqlFilesFind) MqlID() string {
+func (c *mqlDocker) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFilesFind) GetFrom() *plugin.TValue[string] {
-	return &c.From
-}
-
-func (c *mqlFilesFind) GetXdev() *plugin.TValue[bool] {
-	return &c.Xdev
-}
-
-func (c *mqlFilesFind) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlFilesFind) GetRegex() *plugin.TValue[string] {
-	return &c.Regex
-}
-
-func (c *mqlFilesFind) GetPermissions() *plugin.TValue[int64] {
-	return &c.Permissions
-}
+func (c *mqlDocker) GetImages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Images, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlFilesFind) GetName() *plugin.TValue[string] {
-	return &c.Name
+		return c.images()
+	})
 }
 
-func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlDocker) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("files.find", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
 			if err != nil {
 				return nil, err
 			}
@@ -7324,25 +12749,23 @@ func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		return c.containers()
 	})
 }
 
-// mqlParseIni for the parse.ini resource
-type mqlParseIni struct {
+// mqlDockerFile for the docker.file resource
+type mqlDockerFile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseIniInternal it will be used here
-	Delimiter plugin.TValue[string]
+	mqlDockerFileInternal
 	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Sections plugin.TValue[map[string]interface{}]
-	Params plugin.TValue[map[string]interface{}]
+	Instructions plugin.TValue[interface{}]
+	Stages plugin.TValue[[]interface{}]
 }
 
-// createParseIni creates a new instance of this resource
-func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseIni{
+// createDockerFile creates a new instance of this resource
+func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFile{
 		MqlRuntime: runtime,
 	}
 
@@ -7359,7 +12782,7 @@ func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.ini", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7369,73 +12792,83 @@ func createParseIni(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlParseIni) MqlName() string {
-	return "parse.ini"
+func (c *mqlDockerFile) MqlName() string {
+	return "docker.file"
 }
 
-func (c *mqlParseIni) MqlID() string {
+func (c *mqlDockerFile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseIni) GetDelimiter() *plugin.TValue[string] {
-	return &c.Delimiter
-}
+func (c *mqlDockerFile) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
 
-func (c *mqlParseIni) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+		return c.file()
+	})
 }
 
-func (c *mqlParseIni) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+func (c *mqlDockerFile) GetInstructions() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Instructions, func() (interface{}, error) {
 		vargFile := c.GetFile()
 		if vargFile.Error != nil {
-			return "", vargFile.Error
+			return nil, vargFile.Error
 		}
 
-		return c.content(vargFile.Data)
+		return c.instructions(vargFile.Data)
 	})
 }
 
-func (c *mqlParseIni) GetSections() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Sections, func() (map[string]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		vargDelimiter := c.GetDelimiter()
-		if vargDelimiter.Error != nil {
-			return nil, vargDelimiter.Error
+func (c *mqlDockerFile) GetStages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Stages, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "stages")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.sections(vargContent.Data, vargDelimiter.Data)
-	})
-}
-
-func (c *mqlParseIni) GetParams() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
-		vargSections := c.GetSections()
-		if vargSections.Error != nil {
-			return nil, vargSections.Error
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
 		}
 
-		return c.params(vargSections.Data)
+		return c.stages(vargFile.Data)
 	})
 }
 
-// mqlParseJson for the parse.json resource
-type mqlParseJson struct {
+// mqlDockerFileStage for the docker.file.stage resource
+type mqlDockerFileStage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseJsonInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	// optional: if you define mqlDockerFileStageInternal it will be used here
+	From plugin.TValue[*mqlDockerFileFrom]
+	File plugin.TValue[*mqlDockerFile]
+	Env plugin.TValue[map[string]interface{}]
+	Labels plugin.TValue[map[string]interface{}]
+	Run plugin.TValue[[]interface{}]
+	Cmd plugin.TValue[*mqlDockerFileRun]
+	User plugin.TValue[*mqlDockerFileUser]
+	Entrypoint plugin.TValue[*mqlDockerFileRun]
+	Add plugin.TValue[[]interface{}]
+	Copy plugin.TValue[[]interface{}]
+	Expose plugin.TValue[[]interface{}]
 }
 
-// createParseJson creates a new instance of this resource
-func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseJson{
+// createDockerFileStage creates a new instance of this resource
+func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileStage{
 		MqlRuntime: runtime,
 	}
 
@@ -7444,71 +12877,83 @@ func createParseJson(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("docker.file.stage", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
 	}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.json", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+	return res, nil
+}
+
+func (c *mqlDockerFileStage) MqlName() string {
+	return "docker.file.stage"
+}
+
+func (c *mqlDockerFileStage) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDockerFileStage) GetFrom() *plugin.TValue[*mqlDockerFileFrom] {
+	return &c.From
+}
+
+func (c *mqlDockerFileStage) GetFile() *plugin.TValue[*mqlDockerFile] {
+	return &c.File
+}
 
-	return res, nil
+func (c *mqlDockerFileStage) GetEnv() *plugin.TValue[map[string]interface{}] {
+	return &c.Env
 }
 
-func (c *mqlParseJson) MqlName() string {
-	return "parse.json"
+func (c *mqlDockerFileStage) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return &c.Labels
 }
 
-func (c *mqlParseJson) MqlID() string {
-	return c.__id
+func (c *mqlDockerFileStage) GetRun() *plugin.TValue[[]interface{}] {
+	return &c.Run
 }
 
-func (c *mqlParseJson) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlDockerFileStage) GetCmd() *plugin.TValue[*mqlDockerFileRun] {
+	return &c.Cmd
 }
 
-func (c *mqlParseJson) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
+func (c *mqlDockerFileStage) GetUser() *plugin.TValue[*mqlDockerFileUser] {
+	return &c.User
+}
 
-		return c.content(vargFile.Data)
-	})
+func (c *mqlDockerFileStage) GetEntrypoint() *plugin.TValue[*mqlDockerFileRun] {
+	return &c.Entrypoint
 }
 
-func (c *mqlParseJson) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlDockerFileStage) GetAdd() *plugin.TValue[[]interface{}] {
+	return &c.Add
+}
 
-		return c.params(vargContent.Data)
-	})
+func (c *mqlDockerFileStage) GetCopy() *plugin.TValue[[]interface{}] {
+	return &c.Copy
 }
 
-// mqlParsePlist for the parse.plist resource
-type mqlParsePlist struct {
+func (c *mqlDockerFileStage) GetExpose() *plugin.TValue[[]interface{}] {
+	return &c.Expose
+}
+
+// mqlDockerFileUser for the docker.file.user resource
+type mqlDockerFileUser struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParsePlistInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	// optional: if you define mqlDockerFileUserInternal it will be used here
+	User plugin.TValue[string]
+	Group plugin.TValue[string]
 }
 
-// createParsePlist creates a new instance of this resource
-func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParsePlist{
+// createDockerFileUser creates a new instance of this resource
+func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileUser{
 		MqlRuntime: runtime,
 	}
 
@@ -7517,15 +12962,10 @@ func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.plist", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.user", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7535,53 +12975,34 @@ func createParsePlist(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlParsePlist) MqlName() string {
-	return "parse.plist"
+func (c *mqlDockerFileUser) MqlName() string {
+	return "docker.file.user"
 }
 
-func (c *mqlParsePlist) MqlID() string {
+func (c *mqlDockerFileUser) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParsePlist) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlParsePlist) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
+func (c *mqlDockerFileUser) GetUser() *plugin.TValue[string] {
+	return &c.User
 }
 
-func (c *mqlParsePlist) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.params(vargContent.Data)
-	})
+func (c *mqlDockerFileUser) GetGroup() *plugin.TValue[string] {
+	return &c.Group
 }
 
-// mqlParseYaml for the parse.yaml resource
-type mqlParseYaml struct {
+// mqlDockerFileExpose for the docker.file.expose resource
+type mqlDockerFileExpose struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseYamlInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[interface{}]
+	// optional: if you define mqlDockerFileExposeInternal it will be used here
+	Port plugin.TValue[int64]
+	Protocol plugin.TValue[string]
 }
 
-// createParseYaml creates a new instance of this resource
-func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseYaml{
+// createDockerFileExpose creates a new instance of this resource
+func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileExpose{
 		MqlRuntime: runtime,
 	}
 
@@ -7590,15 +13011,10 @@ func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.yaml", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.expose", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7608,54 +13024,37 @@ func createParseYaml(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlParseYaml) MqlName() string {
-	return "parse.yaml"
+func (c *mqlDockerFileExpose) MqlName() string {
+	return "docker.file.expose"
 }
 
-func (c *mqlParseYaml) MqlID() string {
+func (c *mqlDockerFileExpose) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseYaml) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlParseYaml) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
+func (c *mqlDockerFileExpose) GetPort() *plugin.TValue[int64] {
+	return &c.Port
 }
 
-func (c *mqlParseYaml) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.params(vargContent.Data)
-	})
+func (c *mqlDockerFileExpose) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
 }
 
-// mqlParseCertificates for the parse.certificates resource
-type mqlParseCertificates struct {
+// mqlDockerFileFrom for the docker.file.from resource
+type mqlDockerFileFrom struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseCertificatesInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerFileFromInternal it will be used here
+	Platform plugin.TValue[string]
+	Image plugin.TValue[string]
+	Tag plugin.TValue[string]
+	Digest plugin.TValue[string]
+	Name plugin.TValue[string]
 }
 
-// createParseCertificates creates a new instance of this resource
-func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseCertificates{
+// createDockerFileFrom creates a new instance of this resource
+func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileFrom{
 		MqlRuntime: runtime,
 	}
 
@@ -7664,15 +13063,10 @@ func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawDa
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.certificates", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.from", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7682,85 +13076,45 @@ func createParseCertificates(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	return res, nil
 }
 
-func (c *mqlParseCertificates) MqlName() string {
-	return "parse.certificates"
+func (c *mqlDockerFileFrom) MqlName() string {
+	return "docker.file.from"
 }
 
-func (c *mqlParseCertificates) MqlID() string {
+func (c *mqlDockerFileFrom) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseCertificates) GetPath() *plugin.TValue[string] {
-	return &c.Path
-}
-
-func (c *mqlParseCertificates) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "file")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlFile), nil
-			}
-		}
-
-		return c.file()
-	})
+func (c *mqlDockerFileFrom) GetPlatform() *plugin.TValue[string] {
+	return &c.Platform
 }
 
-func (c *mqlParseCertificates) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
+func (c *mqlDockerFileFrom) GetImage() *plugin.TValue[string] {
+	return &c.Image
 }
 
-func (c *mqlParseCertificates) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.certificates", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
-		}
+func (c *mqlDockerFileFrom) GetTag() *plugin.TValue[string] {
+	return &c.Tag
+}
 
-		return c.list(vargContent.Data, vargPath.Data)
-	})
+func (c *mqlDockerFileFrom) GetDigest() *plugin.TValue[string] {
+	return &c.Digest
 }
 
-// mqlParseOpenpgp for the parse.openpgp resource
-type mqlParseOpenpgp struct {
+func (c *mqlDockerFileFrom) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+// mqlDockerFileRun for the docker.file.run resource
+type mqlDockerFileRun struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlParseOpenpgpInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerFileRunInternal it will be used here
+	Script plugin.TValue[string]
 }
 
-// createParseOpenpgp creates a new instance of this resource
-func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlParseOpenpgp{
+// createDockerFileRun creates a new instance of this resource
+func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileRun{
 		MqlRuntime: runtime,
 	}
 
@@ -7769,15 +13123,10 @@ func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("parse.openpgp", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.run", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7787,74 +13136,32 @@ func createParseOpenpgp(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlParseOpenpgp) MqlName() string {
-	return "parse.openpgp"
+func (c *mqlDockerFileRun) MqlName() string {
+	return "docker.file.run"
 }
 
-func (c *mqlParseOpenpgp) MqlID() string {
+func (c *mqlDockerFileRun) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlParseOpenpgp) GetPath() *plugin.TValue[string] {
-	return &c.Path
-}
-
-func (c *mqlParseOpenpgp) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlParseOpenpgp) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlParseOpenpgp) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("parse.openpgp", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.list(vargContent.Data)
-	})
+func (c *mqlDockerFileRun) GetScript() *plugin.TValue[string] {
+	return &c.Script
 }
 
-// mqlUser for the user resource
-type mqlUser struct {
+// mqlDockerFileAdd for the docker.file.add resource
+type mqlDockerFileAdd struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlUserInternal it will be used here
-	Uid plugin.TValue[int64]
-	Gid plugin.TValue[int64]
-	Sid plugin.TValue[string]
-	Name plugin.TValue[string]
-	Home plugin.TValue[string]
-	Shell plugin.TValue[string]
-	Enabled plugin.TValue[bool]
-	Authorizedkeys plugin.TValue[*mqlAuthorizedkeys]
-	Sshkeys plugin.TValue[[]interface{}]
-	Group plugin.TValue[*mqlGroup]
+	// optional: if you define mqlDockerFileAddInternal it will be used here
+	Src plugin.TValue[[]interface{}]
+	Dst plugin.TValue[string]
+	Chown plugin.TValue[string]
+	Chmod plugin.TValue[string]
 }
 
-// createUser creates a new instance of this resource
-func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlUser{
+// createDockerFileAdd creates a new instance of this resource
+func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileAdd{
 		MqlRuntime: runtime,
 	}
 
@@ -7863,15 +13170,10 @@ func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("user", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.add", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -7881,114 +13183,104 @@ func createUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlUser) MqlName() string {
-	return "user"
+func (c *mqlDockerFileAdd) MqlName() string {
+	return "docker.file.add"
 }
 
-func (c *mqlUser) MqlID() string {
+func (c *mqlDockerFileAdd) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlUser) GetUid() *plugin.TValue[int64] {
-	return &c.Uid
+func (c *mqlDockerFileAdd) GetSrc() *plugin.TValue[[]interface{}] {
+	return &c.Src
 }
 
-func (c *mqlUser) GetGid() *plugin.TValue[int64] {
-	return &c.Gid
+func (c *mqlDockerFileAdd) GetDst() *plugin.TValue[string] {
+	return &c.Dst
 }
 
-func (c *mqlUser) GetSid() *plugin.TValue[string] {
-	return &c.Sid
+func (c *mqlDockerFileAdd) GetChown() *plugin.TValue[string] {
+	return &c.Chown
 }
 
-func (c *mqlUser) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlDockerFileAdd) GetChmod() *plugin.TValue[string] {
+	return &c.Chmod
 }
 
-func (c *mqlUser) GetHome() *plugin.TValue[string] {
-	return &c.Home
+// mqlDockerFileCopy for the docker.file.copy resource
+type mqlDockerFileCopy struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDockerFileCopyInternal it will be used here
+	Src plugin.TValue[[]interface{}]
+	Dst plugin.TValue[string]
+	Chown plugin.TValue[string]
+	Chmod plugin.TValue[string]
 }
 
-func (c *mqlUser) GetShell() *plugin.TValue[string] {
-	return &c.Shell
-}
+// createDockerFileCopy creates a new instance of this resource
+func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileCopy{
+		MqlRuntime: runtime,
+	}
 
-func (c *mqlUser) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
-}
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-func (c *mqlUser) GetAuthorizedkeys() *plugin.TValue[*mqlAuthorizedkeys] {
-	return plugin.GetOrCompute[*mqlAuthorizedkeys](&c.Authorizedkeys, func() (*mqlAuthorizedkeys, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "authorizedkeys")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlAuthorizedkeys), nil
-			}
-		}
+	// to override __id implement: id() (string, error)
 
-		vargHome := c.GetHome()
-		if vargHome.Error != nil {
-			return nil, vargHome.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("docker.file.copy", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.authorizedkeys(vargHome.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlUser) GetSshkeys() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Sshkeys, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "sshkeys")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlDockerFileCopy) MqlName() string {
+	return "docker.file.copy"
+}
 
-		return c.sshkeys()
-	})
+func (c *mqlDockerFileCopy) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlUser) GetGroup() *plugin.TValue[*mqlGroup] {
-	return plugin.GetOrCompute[*mqlGroup](&c.Group, func() (*mqlGroup, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("user", c.__id, "group")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlGroup), nil
-			}
-		}
+func (c *mqlDockerFileCopy) GetSrc() *plugin.TValue[[]interface{}] {
+	return &c.Src
+}
 
-		vargGid := c.GetGid()
-		if vargGid.Error != nil {
-			return nil, vargGid.Error
-		}
+func (c *mqlDockerFileCopy) GetDst() *plugin.TValue[string] {
+	return &c.Dst
+}
 
-		return c.group(vargGid.Data)
-	})
+func (c *mqlDockerFileCopy) GetChown() *plugin.TValue[string] {
+	return &c.Chown
 }
 
-// mqlPrivatekey for the privatekey resource
-type mqlPrivatekey struct {
+func (c *mqlDockerFileCopy) GetChmod() *plugin.TValue[string] {
+	return &c.Chmod
+}
+
+// mqlDockerImage for the docker.image resource
+type mqlDockerImage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPrivatekeyInternal it will be used here
-	Pem plugin.TValue[string]
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Encrypted plugin.TValue[bool]
+	// optional: if you define mqlDockerImageInternal it will be used here
+	Id plugin.TValue[string]
+	Size plugin.TValue[int64]
+	Virtualsize plugin.TValue[int64]
+	Tags plugin.TValue[[]interface{}]
+	Labels plugin.TValue[map[string]interface{}]
 }
 
-// createPrivatekey creates a new instance of this resource
-func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPrivatekey{
+// createDockerImage creates a new instance of this resource
+func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerImage{
 		MqlRuntime: runtime,
 	}
 
@@ -8005,7 +13297,7 @@ func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("privatekey", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8015,41 +13307,53 @@ func createPrivatekey(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlPrivatekey) MqlName() string {
-	return "privatekey"
+func (c *mqlDockerImage) MqlName() string {
+	return "docker.image"
 }
 
-func (c *mqlPrivatekey) MqlID() string {
+func (c *mqlDockerImage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPrivatekey) GetPem() *plugin.TValue[string] {
-	return &c.Pem
+func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlPrivatekey) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
+	return &c.Size
 }
 
-func (c *mqlPrivatekey) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlDockerImage) GetVirtualsize() *plugin.TValue[int64] {
+	return &c.Virtualsize
 }
 
-func (c *mqlPrivatekey) GetEncrypted() *plugin.TValue[bool] {
-	return &c.Encrypted
+func (c *mqlDockerImage) GetTags() *plugin.TValue[[]interface{}] {
+	return &c.Tags
 }
 
-// mqlUsers for the users resource
-type mqlUsers struct {
+func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return &c.Labels
+}
+
+// mqlDockerContainer for the docker.container resource
+type mqlDockerContainer struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlUsersInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDockerContainerInternal it will be used here
+	Os plugin.TValue[*mqlOsLinux]
+	Id plugin.TValue[string]
+	Command plugin.TValue[string]
+	Image plugin.TValue[string]
+	Imageid plugin.TValue[string]
+	Names plugin.TValue[[]interface{}]
+	State plugin.TValue[string]
+	Status plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
 }
 
-// createUsers creates a new instance of this resource
-func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlUsers{
+// createDockerContainer creates a new instance of this resource
+func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerContainer{
 		MqlRuntime: runtime,
 	}
 
@@ -8058,10 +13362,15 @@ func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("users", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8071,139 +13380,74 @@ func createUsers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlUsers) MqlName() string {
-	return "users"
+func (c *mqlDockerContainer) MqlName() string {
+	return "docker.container"
 }
 
-func (c *mqlUsers) MqlID() string {
+func (c *mqlDockerContainer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlUsers) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
+	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("users", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlOsLinux), nil
 			}
 		}
 
-		return c.list()
+		return c.os()
 	})
 }
 
-// mqlAuthorizedkeys for the authorizedkeys resource
-type mqlAuthorizedkeys struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlAuthorizedkeysInternal it will be used here
-	Path plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-// createAuthorizedkeys creates a new instance of this resource
-func createAuthorizedkeys(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuthorizedkeys{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("authorizedkeys", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
+func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-func (c *mqlAuthorizedkeys) MqlName() string {
-	return "authorizedkeys"
+func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
 }
 
-func (c *mqlAuthorizedkeys) MqlID() string {
-	return c.__id
+func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
+	return &c.Imageid
 }
 
-func (c *mqlAuthorizedkeys) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]interface{}] {
+	return &c.Names
 }
 
-func (c *mqlAuthorizedkeys) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
+	return &c.State
 }
 
-func (c *mqlAuthorizedkeys) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
+func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
+	return &c.Status
 }
 
-func (c *mqlAuthorizedkeys) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("authorizedkeys", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.list(vargFile.Data, vargContent.Data)
-	})
+func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return &c.Labels
 }
 
-// mqlAuthorizedkeysEntry for the authorizedkeys.entry resource
-type mqlAuthorizedkeysEntry struct {
+// mqlIptables for the iptables resource
+type mqlIptables struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuthorizedkeysEntryInternal it will be used here
-	Line plugin.TValue[int64]
-	Type plugin.TValue[string]
-	Key plugin.TValue[string]
-	Label plugin.TValue[string]
-	Options plugin.TValue[[]interface{}]
-	File plugin.TValue[*mqlFile]
+	// optional: if you define mqlIptablesInternal it will be used here
+	Input plugin.TValue[[]interface{}]
+	Output plugin.TValue[[]interface{}]
 }
 
-// createAuthorizedkeysEntry creates a new instance of this resource
-func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuthorizedkeysEntry{
+// createIptables creates a new instance of this resource
+func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptables{
 		MqlRuntime: runtime,
 	}
 
@@ -8212,15 +13456,10 @@ func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("authorizedkeys.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("iptables", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8230,52 +13469,58 @@ func createAuthorizedkeysEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlAuthorizedkeysEntry) MqlName() string {
-	return "authorizedkeys.entry"
+func (c *mqlIptables) MqlName() string {
+	return "iptables"
 }
 
-func (c *mqlAuthorizedkeysEntry) MqlID() string {
+func (c *mqlIptables) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuthorizedkeysEntry) GetLine() *plugin.TValue[int64] {
-	return &c.Line
-}
-
-func (c *mqlAuthorizedkeysEntry) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlAuthorizedkeysEntry) GetKey() *plugin.TValue[string] {
-	return &c.Key
-}
+func (c *mqlIptables) GetInput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAuthorizedkeysEntry) GetLabel() *plugin.TValue[string] {
-	return &c.Label
+		return c.input()
+	})
 }
 
-func (c *mqlAuthorizedkeysEntry) GetOptions() *plugin.TValue[[]interface{}] {
-	return &c.Options
-}
+func (c *mqlIptables) GetOutput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlAuthorizedkeysEntry) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+		return c.output()
+	})
 }
-
-// mqlGroup for the group resource
-type mqlGroup struct {
+
+// mqlIp6tables for the ip6tables resource
+type mqlIp6tables struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlGroupInternal
-	Gid plugin.TValue[int64]
-	Sid plugin.TValue[string]
-	Name plugin.TValue[string]
-	Members plugin.TValue[[]interface{}]
+	// optional: if you define mqlIp6tablesInternal it will be used here
+	Input plugin.TValue[[]interface{}]
+	Output plugin.TValue[[]interface{}]
 }
 
-// createGroup creates a new instance of this resource
-func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlGroup{
+// createIp6tables creates a new instance of this resource
+func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIp6tables{
 		MqlRuntime: runtime,
 	}
 
@@ -8284,15 +13529,10 @@ func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("group", res.__id)
+		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8302,30 +13542,34 @@ func createGroup(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlGroup) MqlName() string {
-	return "group"
+func (c *mqlIp6tables) MqlName() string {
+	return "ip6tables"
 }
 
-func (c *mqlGroup) MqlID() string {
+func (c *mqlIp6tables) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlGroup) GetGid() *plugin.TValue[int64] {
-	return &c.Gid
-}
-
-func (c *mqlGroup) GetSid() *plugin.TValue[string] {
-	return &c.Sid
-}
+func (c *mqlIp6tables) GetInput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlGroup) GetName() *plugin.TValue[string] {
-	return &c.Name
+		return c.input()
+	})
 }
 
-func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Members, func() ([]interface{}, error) {
+func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("group", c.__id, "members")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
 			if err != nil {
 				return nil, err
 			}
@@ -8334,21 +13578,32 @@ func (c *mqlGroup) GetMembers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.members()
+		return c.output()
 	})
 }
 
-// mqlGroups for the groups resource
-type mqlGroups struct {
+// mqlIptablesEntry for the iptables.entry resource
+type mqlIptablesEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlGroupsInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlIptablesEntryInternal it will be used here
+	LineNumber plugin.TValue[int64]
+	Packets plugin.TValue[int64]
+	Bytes plugin.TValue[int64]
+	Target plugin.TValue[string]
+	Protocol plugin.TValue[string]
+	Opt plugin.TValue[string]
+	In plugin.TValue[string]
+	Out plugin.TValue[string]
+	Source plugin.TValue[string]
+	Destination plugin.TValue[string]
+	Options plugin.TValue[string]
+	Chain plugin.TValue[string]
 }
 
-// createGroups creates a new instance of this resource
-func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlGroups{
+// createIptablesEntry creates a new instance of this resource
+func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptablesEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -8357,10 +13612,15 @@ func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("groups", res.__id)
+		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8370,54 +13630,77 @@ func createGroups(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlGroups) MqlName() string {
-	return "groups"
+func (c *mqlIptablesEntry) MqlName() string {
+	return "iptables.entry"
 }
 
-func (c *mqlGroups) MqlID() string {
+func (c *mqlIptablesEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlGroups) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("groups", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
 
-		return c.list()
-	})
+func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
+	return &c.Packets
 }
 
-// mqlPackage for the package resource
-type mqlPackage struct {
+func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
+	return &c.Bytes
+}
+
+func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
+	return &c.Target
+}
+
+func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
+	return &c.Opt
+}
+
+func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
+	return &c.In
+}
+
+func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
+	return &c.Out
+}
+
+func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
+	return &c.Destination
+}
+
+func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
+	return &c.Options
+}
+
+func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
+	return &c.Chain
+}
+
+// mqlProcess for the process resource
+type mqlProcess struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlPackageInternal
-	Name plugin.TValue[string]
-	Description plugin.TValue[string]
-	Version plugin.TValue[string]
-	Arch plugin.TValue[string]
-	Epoch plugin.TValue[string]
-	Format plugin.TValue[string]
-	Status plugin.TValue[string]
-	Purl plugin.TValue[string]
-	Cpes plugin.TValue[[]interface{}]
-	Origin plugin.TValue[string]
-	Available plugin.TValue[string]
-	Installed plugin.TValue[bool]
-	Outdated plugin.TValue[bool]
-	Files plugin.TValue[[]interface{}]
+	mqlProcessInternal
+	Pid plugin.TValue[int64]
+	State plugin.TValue[string]
+	Executable plugin.TValue[string]
+	Command plugin.TValue[string]
+	Flags plugin.TValue[map[string]interface{}]
 }
 
-// createPackage creates a new instance of this resource
-func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPackage{
+// createProcess creates a new instance of this resource
+func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcess{
 		MqlRuntime: runtime,
 	}
 
@@ -8434,7 +13717,7 @@ func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("package", res.__id)
+		args, err = runtime.ResourceFromRecording("process", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8444,76 +13727,86 @@ func createPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlPackage) MqlName() string {
-	return "package"
+func (c *mqlProcess) MqlName() string {
+	return "process"
 }
 
-func (c *mqlPackage) MqlID() string {
+func (c *mqlProcess) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPackage) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
+	return &c.Pid
 }
 
-func (c *mqlPackage) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlProcess) GetState() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
+		return c.state()
+	})
 }
 
-func (c *mqlPackage) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
+		return c.executable()
+	})
 }
 
-func (c *mqlPackage) GetArch() *plugin.TValue[string] {
-	return &c.Arch
+func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
+		return c.command()
+	})
 }
 
-func (c *mqlPackage) GetEpoch() *plugin.TValue[string] {
-	return &c.Epoch
+func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Flags, func() (map[string]interface{}, error) {
+		return c.flags()
+	})
 }
 
-func (c *mqlPackage) GetFormat() *plugin.TValue[string] {
-	return &c.Format
+// mqlProcesses for the processes resource
+type mqlProcesses struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlProcessesInternal
+	List plugin.TValue[[]interface{}]
 }
 
-func (c *mqlPackage) GetStatus() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
-		return c.status()
-	})
-}
+// createProcesses creates a new instance of this resource
+func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcesses{
+		MqlRuntime: runtime,
+	}
 
-func (c *mqlPackage) GetPurl() *plugin.TValue[string] {
-	return &c.Purl
-}
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-func (c *mqlPackage) GetCpes() *plugin.TValue[[]interface{}] {
-	return &c.Cpes
-}
+	// to override __id implement: id() (string, error)
 
-func (c *mqlPackage) GetOrigin() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Origin, func() (string, error) {
-		return c.origin()
-	})
-}
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("processes", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
 
-func (c *mqlPackage) GetAvailable() *plugin.TValue[string] {
-	return &c.Available
+	return res, nil
 }
 
-func (c *mqlPackage) GetInstalled() *plugin.TValue[bool] {
-	return &c.Installed
+func (c *mqlProcesses) MqlName() string {
+	return "processes"
 }
 
-func (c *mqlPackage) GetOutdated() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Outdated, func() (bool, error) {
-		return c.outdated()
-	})
+func (c *mqlProcesses) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlPackage) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("package", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -8522,21 +13815,29 @@ func (c *mqlPackage) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.files()
+		return c.list()
 	})
 }
 
-// mqlPkgFileInfo for the pkgFileInfo resource
-type mqlPkgFileInfo struct {
+// mqlPort for the port resource
+type mqlPort struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPkgFileInfoInternal it will be used here
-	Path plugin.TValue[string]
+	mqlPortInternal
+	Protocol plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Address plugin.TValue[string]
+	User plugin.TValue[*mqlUser]
+	Process plugin.TValue[*mqlProcess]
+	State plugin.TValue[string]
+	RemoteAddress plugin.TValue[string]
+	RemotePort plugin.TValue[int64]
+	Tls plugin.TValue[plugin.Resource]
 }
 
-// createPkgFileInfo creates a new instance of this resource
-func createPkgFileInfo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPkgFileInfo{
+// createPort creates a new instance of this resource
+func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPort{
 		MqlRuntime: runtime,
 	}
 
@@ -8553,7 +13854,7 @@ func createPkgFileInfo(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pkgFileInfo", res.__id)
+		args, err = runtime.ResourceFromRecording("port", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8563,88 +13864,101 @@ func createPkgFileInfo(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlPkgFileInfo) MqlName() string {
-	return "pkgFileInfo"
+func (c *mqlPort) MqlName() string {
+	return "port"
 }
 
-func (c *mqlPkgFileInfo) MqlID() string {
+func (c *mqlPort) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPkgFileInfo) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
 }
 
-// mqlPackages for the packages resource
-type mqlPackages struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	mqlPackagesInternal
-	List plugin.TValue[[]interface{}]
+func (c *mqlPort) GetPort() *plugin.TValue[int64] {
+	return &c.Port
 }
 
-// createPackages creates a new instance of this resource
-func createPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPackages{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlPort) GetAddress() *plugin.TValue[string] {
+	return &c.Address
+}
 
-	// to override __id implement: id() (string, error)
+func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
+	return &c.User
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("packages", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
+	return plugin.GetOrCompute[*mqlProcess](&c.Process, func() (*mqlProcess, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "process")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlProcess), nil
+			}
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
+		return c.process()
+	})
 }
 
-func (c *mqlPackages) MqlName() string {
-	return "packages"
+func (c *mqlPort) GetState() *plugin.TValue[string] {
+	return &c.State
 }
 
-func (c *mqlPackages) MqlID() string {
-	return c.__id
+func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
+	return &c.RemoteAddress
 }
 
-func (c *mqlPackages) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
+	return &c.RemotePort
+}
+
+func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("packages", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(plugin.Resource), nil
 			}
 		}
 
-		return c.list()
+		vargAddress := c.GetAddress()
+		if vargAddress.Error != nil {
+			return nil, vargAddress.Error
+		}
+
+		vargPort := c.GetPort()
+		if vargPort.Error != nil {
+			return nil, vargPort.Error
+		}
+
+		vargProtocol := c.GetProtocol()
+		if vargProtocol.Error != nil {
+			return nil, vargProtocol.Error
+		}
+
+		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
 	})
 }
 
-// mqlPamConf for the pam.conf resource
-type mqlPamConf struct {
+// mqlPorts for the ports resource
+type mqlPorts struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPamConfInternal it will be used here
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[string]
-	Services plugin.TValue[map[string]interface{}]
-	Entries plugin.TValue[map[string]interface{}]
+	mqlPortsInternal
+	Listening plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createPamConf creates a new instance of this resource
-func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPamConf{
+// createPorts creates a new instance of this resource
+func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPorts{
 		MqlRuntime: runtime,
 	}
 
@@ -8653,15 +13967,10 @@ func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pam.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("ports", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8671,18 +13980,18 @@ func createPamConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlPamConf) MqlName() string {
-	return "pam.conf"
+func (c *mqlPorts) MqlName() string {
+	return "ports"
 }
 
-func (c *mqlPamConf) MqlID() string {
+func (c *mqlPorts) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+func (c *mqlPorts) GetListening() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Listening, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
 			if err != nil {
 				return nil, err
 			}
@@ -8691,69 +14000,98 @@ func (c *mqlPamConf) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.files()
+		return c.listening()
 	})
 }
 
-func (c *mqlPamConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
+func (c *mqlPorts) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.content(vargFiles.Data)
+		return c.list()
 	})
 }
 
-func (c *mqlPamConf) GetServices() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Services, func() (map[string]interface{}, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
+// mqlAuditpol for the auditpol resource
+type mqlAuditpol struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditpolInternal it will be used here
+	List plugin.TValue[[]interface{}]
+}
+
+// createAuditpol creates a new instance of this resource
+func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.services(vargFiles.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlPamConf) GetEntries() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Entries, func() (map[string]interface{}, error) {
+func (c *mqlAuditpol) MqlName() string {
+	return "auditpol"
+}
+
+func (c *mqlAuditpol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditpol) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("pam.conf", c.__id, "entries")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(map[string]interface{}), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.entries(vargFiles.Data)
+		return c.list()
 	})
 }
 
-// mqlPamConfServiceEntry for the pam.conf.serviceEntry resource
-type mqlPamConfServiceEntry struct {
+// mqlAuditpolEntry for the auditpol.entry resource
+type mqlAuditpolEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPamConfServiceEntryInternal it will be used here
-	Service plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	PamType plugin.TValue[string]
-	Control plugin.TValue[string]
-	Module plugin.TValue[string]
-	Options plugin.TValue[[]interface{}]
+	// optional: if you define mqlAuditpolEntryInternal it will be used here
+	Machinename plugin.TValue[string]
+	Policytarget plugin.TValue[string]
+	Subcategory plugin.TValue[string]
+	Subcategoryguid plugin.TValue[string]
+	Inclusionsetting plugin.TValue[string]
+	Exclusionsetting plugin.TValue[string]
 }
 
-// createPamConfServiceEntry creates a new instance of this resource
-func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPamConfServiceEntry{
+// createAuditpolEntry creates a new instance of this resource
+func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpolEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -8770,7 +14108,7 @@ func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pam.conf.serviceEntry", res.__id)
+		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8780,48 +14118,51 @@ func createPamConfServiceEntry(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlPamConfServiceEntry) MqlName() string {
-	return "pam.conf.serviceEntry"
+func (c *mqlAuditpolEntry) MqlName() string {
+	return "auditpol.entry"
 }
 
-func (c *mqlPamConfServiceEntry) MqlID() string {
+func (c *mqlAuditpolEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPamConfServiceEntry) GetService() *plugin.TValue[string] {
-	return &c.Service
+func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
+	return &c.Machinename
 }
 
-func (c *mqlPamConfServiceEntry) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
+	return &c.Policytarget
 }
 
-func (c *mqlPamConfServiceEntry) GetPamType() *plugin.TValue[string] {
-	return &c.PamType
+func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
+	return &c.Subcategory
 }
 
-func (c *mqlPamConfServiceEntry) GetControl() *plugin.TValue[string] {
-	return &c.Control
+func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
+	return &c.Subcategoryguid
 }
 
-func (c *mqlPamConfServiceEntry) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
+	return &c.Inclusionsetting
 }
 
-func (c *mqlPamConfServiceEntry) GetOptions() *plugin.TValue[[]interface{}] {
-	return &c.Options
+func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
+	return &c.Exclusionsetting
 }
 
-// mqlSshd for the sshd resource
-type mqlSshd struct {
+// mqlAuditdConfig for the auditd.config resource
+type mqlAuditdConfig struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlSshdInternal it will be used here
+	// optional: if you define mqlAuditdConfigInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
 }
 
-// createSshd creates a new instance of this resource
-func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSshd{
+// createAuditdConfig creates a new instance of this resource
+func createAuditdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdConfig{
 		MqlRuntime: runtime,
 	}
 
@@ -8830,10 +14171,15 @@ func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sshd", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.config", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8843,34 +14189,66 @@ func createSshd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlSshd) MqlName() string {
-	return "sshd"
+func (c *mqlAuditdConfig) MqlName() string {
+	return "auditd.config"
 }
 
-func (c *mqlSshd) MqlID() string {
+func (c *mqlAuditdConfig) MqlID() string {
 	return c.__id
 }
 
-// mqlSshdConfig for the sshd.config resource
-type mqlSshdConfig struct {
+func (c *mqlAuditdConfig) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.config", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
+}
+
+func (c *mqlAuditdConfig) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlAuditdConfig) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
+}
+
+// mqlAuditdRules for the auditd.rules resource
+type mqlAuditdRules struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlSshdConfigInternal
-	File plugin.TValue[*mqlFile]
+	mqlAuditdRulesInternal
+	Path plugin.TValue[string]
+	Controls plugin.TValue[[]interface{}]
 	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
-	Blocks plugin.TValue[[]interface{}]
-	Ciphers plugin.TValue[[]interface{}]
-	Macs plugin.TValue[[]interface{}]
-	Kexs plugin.TValue[[]interface{}]
-	Hostkeys plugin.TValue[[]interface{}]
-	PermitRootLogin plugin.TValue[[]interface{}]
+	Syscalls plugin.TValue[[]interface{}]
 }
 
-// createSshdConfig creates a new instance of this resource
-func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSshdConfig{
+// createAuditdRules creates a new instance of this resource
+func createAuditdRules(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdRules{
 		MqlRuntime: runtime,
 	}
 
@@ -8887,7 +14265,7 @@ func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sshd.config", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.rules", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -8897,34 +14275,43 @@ func createSshdConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlSshdConfig) MqlName() string {
-	return "sshd.config"
+func (c *mqlAuditdRules) MqlName() string {
+	return "auditd.rules"
 }
 
-func (c *mqlSshdConfig) MqlID() string {
+func (c *mqlAuditdRules) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSshdConfig) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlAuditdRules) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlAuditdRules) GetControls() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Controls, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.rules", c.__id, "controls")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.file()
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.controls(vargPath.Data)
 	})
 }
 
-func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
+func (c *mqlAuditdRules) GetFiles() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.rules", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -8933,41 +14320,19 @@ func (c *mqlSshdConfig) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		return c.files(vargFile.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetParams() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
 		}
 
-		return c.params(vargFile.Data)
+		return c.files(vargPath.Data)
 	})
 }
 
-func (c *mqlSshdConfig) GetBlocks() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Blocks, func() ([]interface{}, error) {
+func (c *mqlAuditdRules) GetSyscalls() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Syscalls, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sshd.config", c.__id, "blocks")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.rules", c.__id, "syscalls")
 			if err != nil {
 				return nil, err
 			}
@@ -8976,82 +14341,27 @@ func (c *mqlSshdConfig) GetBlocks() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		return c.blocks(vargFile.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetCiphers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.ciphers(vargParams.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetMacs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.macs(vargParams.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetKexs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Kexs, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.kexs(vargParams.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetHostkeys() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Hostkeys, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.hostkeys(vargParams.Data)
-	})
-}
-
-func (c *mqlSshdConfig) GetPermitRootLogin() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.PermitRootLogin, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
 		}
 
-		return c.permitRootLogin(vargParams.Data)
+		return c.syscalls(vargPath.Data)
 	})
 }
 
-// mqlSshdConfigMatchBlock for the sshd.config.matchBlock resource
-type mqlSshdConfigMatchBlock struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlSshdConfigMatchBlockInternal it will be used here
-	Criteria plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
+// mqlAuditdLoadedRules for the auditd.loadedRules resource
+type mqlAuditdLoadedRules struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlAuditdLoadedRulesInternal
+	Files plugin.TValue[[]interface{}]
+	Syscalls plugin.TValue[[]interface{}]
 }
 
-// createSshdConfigMatchBlock creates a new instance of this resource
-func createSshdConfigMatchBlock(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSshdConfigMatchBlock{
+// createAuditdLoadedRules creates a new instance of this resource
+func createAuditdLoadedRules(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdLoadedRules{
 		MqlRuntime: runtime,
 	}
 
@@ -9060,10 +14370,15 @@ func createSshdConfigMatchBlock(runtime *plugin.Runtime, args map[string]*llx.Ra
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sshd.config.matchBlock", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.loadedRules", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9073,39 +14388,58 @@ func createSshdConfigMatchBlock(runtime *plugin.Runtime, args map[string]*llx.Ra
 	return res, nil
 }
 
-func (c *mqlSshdConfigMatchBlock) MqlName() string {
-	return "sshd.config.matchBlock"
+func (c *mqlAuditdLoadedRules) MqlName() string {
+	return "auditd.loadedRules"
 }
 
-func (c *mqlSshdConfigMatchBlock) MqlID() string {
+func (c *mqlAuditdLoadedRules) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSshdConfigMatchBlock) GetCriteria() *plugin.TValue[string] {
-	return &c.Criteria
+func (c *mqlAuditdLoadedRules) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.loadedRules", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.files()
+	})
 }
 
-func (c *mqlSshdConfigMatchBlock) GetParams() *plugin.TValue[map[string]interface{}] {
-	return &c.Params
+func (c *mqlAuditdLoadedRules) GetSyscalls() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Syscalls, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditd.loadedRules", c.__id, "syscalls")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.syscalls()
+	})
 }
 
-// mqlService for the service resource
-type mqlService struct {
+// mqlAuditdRuleControl for the auditd.rule.control resource
+type mqlAuditdRuleControl struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlServiceInternal it will be used here
-	Name plugin.TValue[string]
-	Description plugin.TValue[string]
-	Installed plugin.TValue[bool]
-	Running plugin.TValue[bool]
-	Enabled plugin.TValue[bool]
-	Type plugin.TValue[string]
-	Masked plugin.TValue[bool]
+	// optional: if you define mqlAuditdRuleControlInternal it will be used here
+	Flag plugin.TValue[string]
+	Value plugin.TValue[string]
 }
 
-// createService creates a new instance of this resource
-func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlService{
+// createAuditdRuleControl creates a new instance of this resource
+func createAuditdRuleControl(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdRuleControl{
 		MqlRuntime: runtime,
 	}
 
@@ -9114,15 +14448,10 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("service", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.rule.control", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9132,53 +14461,35 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlService) MqlName() string {
-	return "service"
+func (c *mqlAuditdRuleControl) MqlName() string {
+	return "auditd.rule.control"
 }
 
-func (c *mqlService) MqlID() string {
+func (c *mqlAuditdRuleControl) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlService) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlService) GetDescription() *plugin.TValue[string] {
-	return &c.Description
-}
-
-func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
-	return &c.Installed
-}
-
-func (c *mqlService) GetRunning() *plugin.TValue[bool] {
-	return &c.Running
-}
-
-func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
-}
-
-func (c *mqlService) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlAuditdRuleControl) GetFlag() *plugin.TValue[string] {
+	return &c.Flag
 }
 
-func (c *mqlService) GetMasked() *plugin.TValue[bool] {
-	return &c.Masked
+func (c *mqlAuditdRuleControl) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// mqlServices for the services resource
-type mqlServices struct {
+// mqlAuditdRuleFile for the auditd.rule.file resource
+type mqlAuditdRuleFile struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlServicesInternal
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlAuditdRuleFileInternal it will be used here
+	Path plugin.TValue[string]
+	Permissions plugin.TValue[string]
+	Keys plugin.TValue[[]interface{}]
 }
 
-// createServices creates a new instance of this resource
-func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlServices{
+// createAuditdRuleFile creates a new instance of this resource
+func createAuditdRuleFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdRuleFile{
 		MqlRuntime: runtime,
 	}
 
@@ -9190,7 +14501,7 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("services", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.rule.file", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9200,44 +14511,42 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlServices) MqlName() string {
-	return "services"
+func (c *mqlAuditdRuleFile) MqlName() string {
+	return "auditd.rule.file"
 }
 
-func (c *mqlServices) MqlID() string {
+func (c *mqlAuditdRuleFile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlServices) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlAuditdRuleFile) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
 
-		return c.list()
-	})
+func (c *mqlAuditdRuleFile) GetPermissions() *plugin.TValue[string] {
+	return &c.Permissions
 }
 
-// mqlKernel for the kernel resource
-type mqlKernel struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	mqlKernelInternal
-	Info plugin.TValue[interface{}]
-	Parameters plugin.TValue[map[string]interface{}]
-	Modules plugin.TValue[[]interface{}]
-	Installed plugin.TValue[[]interface{}]
+func (c *mqlAuditdRuleFile) GetKeys() *plugin.TValue[[]interface{}] {
+	return &c.Keys
 }
 
-// createKernel creates a new instance of this resource
-func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernel{
+// mqlAuditdRuleSyscall for the auditd.rule.syscall resource
+type mqlAuditdRuleSyscall struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlAuditdRuleSyscallInternal it will be used here
+	Action plugin.TValue[string]
+	List plugin.TValue[string]
+	Syscalls plugin.TValue[[]interface{}]
+	Fields plugin.TValue[[]interface{}]
+	Permissions plugin.TValue[string]
+	Keys plugin.TValue[[]interface{}]
+}
+
+// createAuditdRuleSyscall creates a new instance of this resource
+func createAuditdRuleSyscall(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdRuleSyscall{
 		MqlRuntime: runtime,
 	}
 
@@ -9249,7 +14558,7 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.rule.syscall", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9259,61 +14568,51 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlKernel) MqlName() string {
-	return "kernel"
+func (c *mqlAuditdRuleSyscall) MqlName() string {
+	return "auditd.rule.syscall"
 }
 
-func (c *mqlKernel) MqlID() string {
+func (c *mqlAuditdRuleSyscall) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernel) GetInfo() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Info, func() (interface{}, error) {
-		return c.info()
-	})
+func (c *mqlAuditdRuleSyscall) GetAction() *plugin.TValue[string] {
+	return &c.Action
 }
 
-func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Parameters, func() (map[string]interface{}, error) {
-		return c.parameters()
-	})
+func (c *mqlAuditdRuleSyscall) GetList() *plugin.TValue[string] {
+	return &c.List
 }
 
-func (c *mqlKernel) GetModules() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Modules, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlAuditdRuleSyscall) GetSyscalls() *plugin.TValue[[]interface{}] {
+	return &c.Syscalls
+}
 
-		return c.modules()
-	})
+func (c *mqlAuditdRuleSyscall) GetFields() *plugin.TValue[[]interface{}] {
+	return &c.Fields
 }
 
-func (c *mqlKernel) GetInstalled() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Installed, func() ([]interface{}, error) {
-		return c.installed()
-	})
+func (c *mqlAuditdRuleSyscall) GetPermissions() *plugin.TValue[string] {
+	return &c.Permissions
 }
 
-// mqlKernelModule for the kernel.module resource
-type mqlKernelModule struct {
+func (c *mqlAuditdRuleSyscall) GetKeys() *plugin.TValue[[]interface{}] {
+	return &c.Keys
+}
+
+// mqlAuditdRuleField for the auditd.rule.field resource
+type mqlAuditdRuleField struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlKernelModuleInternal it will be used here
+	// optional: if you define mqlAuditdRuleFieldInternal it will be used here
 	Name plugin.TValue[string]
-	Size plugin.TValue[string]
-	Loaded plugin.TValue[bool]
+	Op plugin.TValue[string]
+	Value plugin.TValue[string]
 }
 
-// createKernelModule creates a new instance of this resource
-func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernelModule{
+// createAuditdRuleField creates a new instance of this resource
+func createAuditdRuleField(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditdRuleField{
 		MqlRuntime: runtime,
 	}
 
@@ -9322,15 +14621,10 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
+		args, err = runtime.ResourceFromRecording("auditd.rule.field", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9340,38 +14634,40 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlKernelModule) MqlName() string {
-	return "kernel.module"
+func (c *mqlAuditdRuleField) MqlName() string {
+	return "auditd.rule.field"
 }
 
-func (c *mqlKernelModule) MqlID() string {
+func (c *mqlAuditdRuleField) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
+func (c *mqlAuditdRuleField) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
-	return &c.Size
+func (c *mqlAuditdRuleField) GetOp() *plugin.TValue[string] {
+	return &c.Op
 }
 
-func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
-	return &c.Loaded
+func (c *mqlAuditdRuleField) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// mqlDocker for the docker resource
-type mqlDocker struct {
+// mqlSecpol for the secpol resource
+type mqlSecpol struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerInternal it will be used here
-	Images plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	mqlSecpolInternal
+	Systemaccess plugin.TValue[map[string]interface{}]
+	Eventaudit plugin.TValue[map[string]interface{}]
+	Registryvalues plugin.TValue[map[string]interface{}]
+	Privilegerights plugin.TValue[map[string]interface{}]
 }
 
-// createDocker creates a new instance of this resource
-func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDocker{
+// createSecpol creates a new instance of this resource
+func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSecpol{
 		MqlRuntime: runtime,
 	}
 
@@ -9383,7 +14679,7 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker", res.__id)
+		args, err = runtime.ResourceFromRecording("secpol", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9393,59 +14689,54 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlDocker) MqlName() string {
-	return "docker"
+func (c *mqlSecpol) MqlName() string {
+	return "secpol"
 }
 
-func (c *mqlDocker) MqlID() string {
+func (c *mqlSecpol) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDocker) GetImages() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Images, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Systemaccess, func() (map[string]interface{}, error) {
+		return c.systemaccess()
+	})
+}
 
-		return c.images()
+func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Eventaudit, func() (map[string]interface{}, error) {
+		return c.eventaudit()
 	})
 }
 
-func (c *mqlDocker) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Registryvalues, func() (map[string]interface{}, error) {
+		return c.registryvalues()
+	})
+}
 
-		return c.containers()
+func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Privilegerights, func() (map[string]interface{}, error) {
+		return c.privilegerights()
 	})
 }
 
-// mqlDockerFile for the docker.file resource
-type mqlDockerFile struct {
+// mqlNtpConf for the ntp.conf resource
+type mqlNtpConf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlDockerFileInternal
+	// optional: if you define mqlNtpConfInternal it will be used here
 	File plugin.TValue[*mqlFile]
-	Instructions plugin.TValue[interface{}]
-	Stages plugin.TValue[[]interface{}]
+	Content plugin.TValue[string]
+	Settings plugin.TValue[[]interface{}]
+	Servers plugin.TValue[[]interface{}]
+	Restrict plugin.TValue[[]interface{}]
+	Fudge plugin.TValue[[]interface{}]
 }
 
-// createDockerFile creates a new instance of this resource
-func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFile{
+// createNtpConf creates a new instance of this resource
+func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNtpConf{
 		MqlRuntime: runtime,
 	}
 
@@ -9462,7 +14753,7 @@ func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file", res.__id)
+		args, err = runtime.ResourceFromRecording("ntp.conf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9472,18 +14763,18 @@ func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlDockerFile) MqlName() string {
-	return "docker.file"
+func (c *mqlNtpConf) MqlName() string {
+	return "ntp.conf"
 }
 
-func (c *mqlDockerFile) MqlID() string {
+func (c *mqlNtpConf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFile) GetFile() *plugin.TValue[*mqlFile] {
+func (c *mqlNtpConf) GetFile() *plugin.TValue[*mqlFile] {
 	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ntp.conf", c.__id, "file")
 			if err != nil {
 				return nil, err
 			}
@@ -9496,144 +14787,75 @@ func (c *mqlDockerFile) GetFile() *plugin.TValue[*mqlFile] {
 	})
 }
 
-func (c *mqlDockerFile) GetInstructions() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Instructions, func() (interface{}, error) {
+func (c *mqlNtpConf) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
 		vargFile := c.GetFile()
 		if vargFile.Error != nil {
-			return nil, vargFile.Error
+			return "", vargFile.Error
 		}
 
-		return c.instructions(vargFile.Data)
+		return c.content(vargFile.Data)
 	})
 }
 
-func (c *mqlDockerFile) GetStages() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Stages, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "stages")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
+func (c *mqlNtpConf) GetSettings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Settings, func() ([]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
 		}
 
-		return c.stages(vargFile.Data)
+		return c.settings(vargContent.Data)
 	})
 }
 
-// mqlDockerFileStage for the docker.file.stage resource
-type mqlDockerFileStage struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlDockerFileStageInternal it will be used here
-	From plugin.TValue[*mqlDockerFileFrom]
-	File plugin.TValue[*mqlDockerFile]
-	Env plugin.TValue[map[string]interface{}]
-	Labels plugin.TValue[map[string]interface{}]
-	Run plugin.TValue[[]interface{}]
-	Cmd plugin.TValue[*mqlDockerFileRun]
-	User plugin.TValue[*mqlDockerFileUser]
-	Entrypoint plugin.TValue[*mqlDockerFileRun]
-	Add plugin.TValue[[]interface{}]
-	Copy plugin.TValue[[]interface{}]
-	Expose plugin.TValue[[]interface{}]
-}
-
-// createDockerFileStage creates a new instance of this resource
-func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileStage{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.stage", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlNtpConf) GetServers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Servers, func() ([]interface{}, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
 		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlDockerFileStage) MqlName() string {
-	return "docker.file.stage"
-}
-
-func (c *mqlDockerFileStage) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlDockerFileStage) GetFrom() *plugin.TValue[*mqlDockerFileFrom] {
-	return &c.From
-}
-
-func (c *mqlDockerFileStage) GetFile() *plugin.TValue[*mqlDockerFile] {
-	return &c.File
-}
-
-func (c *mqlDockerFileStage) GetEnv() *plugin.TValue[map[string]interface{}] {
-	return &c.Env
-}
-
-func (c *mqlDockerFileStage) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return &c.Labels
-}
-
-func (c *mqlDockerFileStage) GetRun() *plugin.TValue[[]interface{}] {
-	return &c.Run
-}
-
-func (c *mqlDockerFileStage) GetCmd() *plugin.TValue[*mqlDockerFileRun] {
-	return &c.Cmd
-}
 
-func (c *mqlDockerFileStage) GetUser() *plugin.TValue[*mqlDockerFileUser] {
-	return &c.User
+		return c.servers(vargSettings.Data)
+	})
 }
 
-func (c *mqlDockerFileStage) GetEntrypoint() *plugin.TValue[*mqlDockerFileRun] {
-	return &c.Entrypoint
-}
+func (c *mqlNtpConf) GetRestrict() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Restrict, func() ([]interface{}, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
+		}
 
-func (c *mqlDockerFileStage) GetAdd() *plugin.TValue[[]interface{}] {
-	return &c.Add
+		return c.restrict(vargSettings.Data)
+	})
 }
 
-func (c *mqlDockerFileStage) GetCopy() *plugin.TValue[[]interface{}] {
-	return &c.Copy
-}
+func (c *mqlNtpConf) GetFudge() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Fudge, func() ([]interface{}, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
+		}
 
-func (c *mqlDockerFileStage) GetExpose() *plugin.TValue[[]interface{}] {
-	return &c.Expose
+		return c.fudge(vargSettings.Data)
+	})
 }
 
-// mqlDockerFileUser for the docker.file.user resource
-type mqlDockerFileUser struct {
+// mqlRsyslogConf for the rsyslog.conf resource
+type mqlRsyslogConf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerFileUserInternal it will be used here
-	User plugin.TValue[string]
-	Group plugin.TValue[string]
+	// optional: if you define mqlRsyslogConfInternal it will be used here
+	Path plugin.TValue[string]
+	Files plugin.TValue[[]interface{}]
+	Content plugin.TValue[string]
+	Settings plugin.TValue[[]interface{}]
 }
 
-// createDockerFileUser creates a new instance of this resource
-func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileUser{
+// createRsyslogConf creates a new instance of this resource
+func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRsyslogConf{
 		MqlRuntime: runtime,
 	}
 
@@ -9642,10 +14864,15 @@ func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.user", res.__id)
+		args, err = runtime.ResourceFromRecording("rsyslog.conf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9655,86 +14882,76 @@ func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileUser) MqlName() string {
-	return "docker.file.user"
+func (c *mqlRsyslogConf) MqlName() string {
+	return "rsyslog.conf"
 }
 
-func (c *mqlDockerFileUser) MqlID() string {
+func (c *mqlRsyslogConf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileUser) GetUser() *plugin.TValue[string] {
-	return &c.User
-}
-
-func (c *mqlDockerFileUser) GetGroup() *plugin.TValue[string] {
-	return &c.Group
-}
-
-// mqlDockerFileExpose for the docker.file.expose resource
-type mqlDockerFileExpose struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlDockerFileExposeInternal it will be used here
-	Port plugin.TValue[int64]
-	Protocol plugin.TValue[string]
+func (c *mqlRsyslogConf) GetPath() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Path, func() (string, error) {
+		return c.path()
+	})
 }
 
-// createDockerFileExpose creates a new instance of this resource
-func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileExpose{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.expose", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rsyslog.conf", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
+		return c.files(vargPath.Data)
+	})
 }
 
-func (c *mqlDockerFileExpose) MqlName() string {
-	return "docker.file.expose"
-}
+func (c *mqlRsyslogConf) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
+		}
 
-func (c *mqlDockerFileExpose) MqlID() string {
-	return c.__id
+		return c.content(vargFiles.Data)
+	})
 }
 
-func (c *mqlDockerFileExpose) GetPort() *plugin.TValue[int64] {
-	return &c.Port
-}
+func (c *mqlRsyslogConf) GetSettings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Settings, func() ([]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
 
-func (c *mqlDockerFileExpose) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+		return c.settings(vargContent.Data)
+	})
 }
 
-// mqlDockerFileFrom for the docker.file.from resource
-type mqlDockerFileFrom struct {
+// mqlLogindefs for the logindefs resource
+type mqlLogindefs struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerFileFromInternal it will be used here
-	Platform plugin.TValue[string]
-	Image plugin.TValue[string]
-	Tag plugin.TValue[string]
-	Digest plugin.TValue[string]
-	Name plugin.TValue[string]
+	// optional: if you define mqlLogindefsInternal it will be used here
+	File plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
 }
 
-// createDockerFileFrom creates a new instance of this resource
-func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileFrom{
+// createLogindefs creates a new instance of this resource
+func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLogindefs{
 		MqlRuntime: runtime,
 	}
 
@@ -9743,10 +14960,15 @@ func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.from", res.__id)
+		args, err = runtime.ResourceFromRecording("logindefs", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9756,45 +14978,63 @@ func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileFrom) MqlName() string {
-	return "docker.file.from"
+func (c *mqlLogindefs) MqlName() string {
+	return "logindefs"
 }
 
-func (c *mqlDockerFileFrom) MqlID() string {
+func (c *mqlLogindefs) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileFrom) GetPlatform() *plugin.TValue[string] {
-	return &c.Platform
-}
+func (c *mqlLogindefs) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("logindefs", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
 
-func (c *mqlDockerFileFrom) GetImage() *plugin.TValue[string] {
-	return &c.Image
+		return c.file()
+	})
 }
 
-func (c *mqlDockerFileFrom) GetTag() *plugin.TValue[string] {
-	return &c.Tag
-}
+func (c *mqlLogindefs) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
 
-func (c *mqlDockerFileFrom) GetDigest() *plugin.TValue[string] {
-	return &c.Digest
+		return c.content(vargFile.Data)
+	})
 }
 
-func (c *mqlDockerFileFrom) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlLogindefs) GetParams() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
 }
 
-// mqlDockerFileRun for the docker.file.run resource
-type mqlDockerFileRun struct {
+// mqlLsblk for the lsblk resource
+type mqlLsblk struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerFileRunInternal it will be used here
-	Script plugin.TValue[string]
+	// optional: if you define mqlLsblkInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createDockerFileRun creates a new instance of this resource
-func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileRun{
+// createLsblk creates a new instance of this resource
+func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLsblk{
 		MqlRuntime: runtime,
 	}
 
@@ -9803,10 +15043,15 @@ func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.run", res.__id)
+		args, err = runtime.ResourceFromRecording("lsblk", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9816,32 +15061,45 @@ func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileRun) MqlName() string {
-	return "docker.file.run"
+func (c *mqlLsblk) MqlName() string {
+	return "lsblk"
 }
 
-func (c *mqlDockerFileRun) MqlID() string {
+func (c *mqlLsblk) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileRun) GetScript() *plugin.TValue[string] {
-	return &c.Script
+func (c *mqlLsblk) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("lsblk", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
 }
 
-// mqlDockerFileAdd for the docker.file.add resource
-type mqlDockerFileAdd struct {
+// mqlLsblkEntry for the lsblk.entry resource
+type mqlLsblkEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerFileAddInternal it will be used here
-	Src plugin.TValue[[]interface{}]
-	Dst plugin.TValue[string]
-	Chown plugin.TValue[string]
-	Chmod plugin.TValue[string]
+	// optional: if you define mqlLsblkEntryInternal it will be used here
+	Name plugin.TValue[string]
+	Fstype plugin.TValue[string]
+	Label plugin.TValue[string]
+	Uuid plugin.TValue[string]
+	Mountpoints plugin.TValue[[]interface{}]
 }
 
-// createDockerFileAdd creates a new instance of this resource
-func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileAdd{
+// createLsblkEntry creates a new instance of this resource
+func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLsblkEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -9850,10 +15108,15 @@ func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.add", res.__id)
+		args, err = runtime.ResourceFromRecording("lsblk.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9863,44 +15126,45 @@ func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileAdd) MqlName() string {
-	return "docker.file.add"
+func (c *mqlLsblkEntry) MqlName() string {
+	return "lsblk.entry"
 }
 
-func (c *mqlDockerFileAdd) MqlID() string {
+func (c *mqlLsblkEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileAdd) GetSrc() *plugin.TValue[[]interface{}] {
-	return &c.Src
+func (c *mqlLsblkEntry) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerFileAdd) GetDst() *plugin.TValue[string] {
-	return &c.Dst
+func (c *mqlLsblkEntry) GetFstype() *plugin.TValue[string] {
+	return &c.Fstype
 }
 
-func (c *mqlDockerFileAdd) GetChown() *plugin.TValue[string] {
-	return &c.Chown
+func (c *mqlLsblkEntry) GetLabel() *plugin.TValue[string] {
+	return &c.Label
 }
 
-func (c *mqlDockerFileAdd) GetChmod() *plugin.TValue[string] {
-	return &c.Chmod
+func (c *mqlLsblkEntry) GetUuid() *plugin.TValue[string] {
+	return &c.Uuid
 }
 
-// mqlDockerFileCopy for the docker.file.copy resource
-type mqlDockerFileCopy struct {
+func (c *mqlLsblkEntry) GetMountpoints() *plugin.TValue[[]interface{}] {
+	return &c.Mountpoints
+}
+
+// mqlMount for the mount resource
+type mqlMount struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerFileCopyInternal it will be used here
-	Src plugin.TValue[[]interface{}]
-	Dst plugin.TValue[string]
-	Chown plugin.TValue[string]
-	Chmod plugin.TValue[string]
+	// optional: if you define mqlMountInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createDockerFileCopy creates a new instance of this resource
-func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileCopy{
+// createMount creates a new instance of this resource
+func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMount{
 		MqlRuntime: runtime,
 	}
 
@@ -9909,10 +15173,15 @@ func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.copy", res.__id)
+		args, err = runtime.ResourceFromRecording("mount", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9922,45 +15191,45 @@ func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileCopy) MqlName() string {
-	return "docker.file.copy"
+func (c *mqlMount) MqlName() string {
+	return "mount"
 }
 
-func (c *mqlDockerFileCopy) MqlID() string {
+func (c *mqlMount) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileCopy) GetSrc() *plugin.TValue[[]interface{}] {
-	return &c.Src
-}
-
-func (c *mqlDockerFileCopy) GetDst() *plugin.TValue[string] {
-	return &c.Dst
-}
-
-func (c *mqlDockerFileCopy) GetChown() *plugin.TValue[string] {
-	return &c.Chown
-}
+func (c *mqlMount) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("mount", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlDockerFileCopy) GetChmod() *plugin.TValue[string] {
-	return &c.Chmod
+		return c.list()
+	})
 }
 
-// mqlDockerImage for the docker.image resource
-type mqlDockerImage struct {
+// mqlMountPoint for the mount.point resource
+type mqlMountPoint struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerImageInternal it will be used here
-	Id plugin.TValue[string]
-	Size plugin.TValue[int64]
-	Virtualsize plugin.TValue[int64]
-	Tags plugin.TValue[[]interface{}]
-	Labels plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlMountPointInternal it will be used here
+	Device plugin.TValue[string]
+	Path plugin.TValue[string]
+	Fstype plugin.TValue[string]
+	Options plugin.TValue[map[string]interface{}]
+	Mounted plugin.TValue[bool]
 }
 
-// createDockerImage creates a new instance of this resource
-func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerImage{
+// createMountPoint creates a new instance of this resource
+func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMountPoint{
 		MqlRuntime: runtime,
 	}
 
@@ -9977,7 +15246,7 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
+		args, err = runtime.ResourceFromRecording("mount.point", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -9987,53 +15256,45 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlDockerImage) MqlName() string {
-	return "docker.image"
+func (c *mqlMountPoint) MqlName() string {
+	return "mount.point"
 }
 
-func (c *mqlDockerImage) MqlID() string {
+func (c *mqlMountPoint) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlMountPoint) GetDevice() *plugin.TValue[string] {
+	return &c.Device
 }
 
-func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
-	return &c.Size
+func (c *mqlMountPoint) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlDockerImage) GetVirtualsize() *plugin.TValue[int64] {
-	return &c.Virtualsize
+func (c *mqlMountPoint) GetFstype() *plugin.TValue[string] {
+	return &c.Fstype
 }
 
-func (c *mqlDockerImage) GetTags() *plugin.TValue[[]interface{}] {
-	return &c.Tags
+func (c *mqlMountPoint) GetOptions() *plugin.TValue[map[string]interface{}] {
+	return &c.Options
 }
 
-func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return &c.Labels
+func (c *mqlMountPoint) GetMounted() *plugin.TValue[bool] {
+	return &c.Mounted
 }
 
-// mqlDockerContainer for the docker.container resource
-type mqlDockerContainer struct {
+// mqlShadow for the shadow resource
+type mqlShadow struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDockerContainerInternal it will be used here
-	Os plugin.TValue[*mqlOsLinux]
-	Id plugin.TValue[string]
-	Command plugin.TValue[string]
-	Image plugin.TValue[string]
-	Imageid plugin.TValue[string]
-	Names plugin.TValue[[]interface{}]
-	State plugin.TValue[string]
-	Status plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlShadowInternal it will be used here
+	List plugin.TValue[[]interface{}]
 }
 
-// createDockerContainer creates a new instance of this resource
-func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerContainer{
+// createShadow creates a new instance of this resource
+func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlShadow{
 		MqlRuntime: runtime,
 	}
 
@@ -10050,7 +15311,7 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
+		args, err = runtime.ResourceFromRecording("shadow", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10060,74 +15321,49 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlDockerContainer) MqlName() string {
-	return "docker.container"
+func (c *mqlShadow) MqlName() string {
+	return "shadow"
 }
 
-func (c *mqlDockerContainer) MqlID() string {
+func (c *mqlShadow) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
-	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
+func (c *mqlShadow) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("shadow", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlOsLinux), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.os()
+		return c.list()
 	})
 }
 
-func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
-	return &c.Command
-}
-
-func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
-}
-
-func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
-	return &c.Imageid
-}
-
-func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]interface{}] {
-	return &c.Names
-}
-
-func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
-	return &c.State
-}
-
-func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
-	return &c.Status
-}
-
-func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return &c.Labels
-}
-
-// mqlIptables for the iptables resource
-type mqlIptables struct {
+// mqlShadowEntry for the shadow.entry resource
+type mqlShadowEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIptablesInternal it will be used here
-	Input plugin.TValue[[]interface{}]
-	Output plugin.TValue[[]interface{}]
+	// optional: if you define mqlShadowEntryInternal it will be used here
+	User plugin.TValue[string]
+	Password plugin.TValue[string]
+	Lastchanged plugin.TValue[*time.Time]
+	Mindays plugin.TValue[int64]
+	Maxdays plugin.TValue[int64]
+	Warndays plugin.TValue[int64]
+	Inactivedays plugin.TValue[int64]
+	Expirydates plugin.TValue[string]
+	Reserved plugin.TValue[string]
 }
 
-// createIptables creates a new instance of this resource
-func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptables{
+// createShadowEntry creates a new instance of this resource
+func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlShadowEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -10136,10 +15372,15 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables", res.__id)
+		args, err = runtime.ResourceFromRecording("shadow.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10149,58 +15390,62 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlIptables) MqlName() string {
-	return "iptables"
+func (c *mqlShadowEntry) MqlName() string {
+	return "shadow.entry"
 }
 
-func (c *mqlIptables) MqlID() string {
+func (c *mqlShadowEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptables) GetInput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlShadowEntry) GetUser() *plugin.TValue[string] {
+	return &c.User
+}
 
-		return c.input()
-	})
+func (c *mqlShadowEntry) GetPassword() *plugin.TValue[string] {
+	return &c.Password
 }
 
-func (c *mqlIptables) GetOutput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlShadowEntry) GetLastchanged() *plugin.TValue[*time.Time] {
+	return &c.Lastchanged
+}
 
-		return c.output()
-	})
+func (c *mqlShadowEntry) GetMindays() *plugin.TValue[int64] {
+	return &c.Mindays
 }
 
-// mqlIp6tables for the ip6tables resource
-type mqlIp6tables struct {
+func (c *mqlShadowEntry) GetMaxdays() *plugin.TValue[int64] {
+	return &c.Maxdays
+}
+
+func (c *mqlShadowEntry) GetWarndays() *plugin.TValue[int64] {
+	return &c.Warndays
+}
+
+func (c *mqlShadowEntry) GetInactivedays() *plugin.TValue[int64] {
+	return &c.Inactivedays
+}
+
+func (c *mqlShadowEntry) GetExpirydates() *plugin.TValue[string] {
+	return &c.Expirydates
+}
+
+func (c *mqlShadowEntry) GetReserved() *plugin.TValue[string] {
+	return &c.Reserved
+}
+
+// mqlYum for the yum resource
+type mqlYum struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIp6tablesInternal it will be used here
-	Input plugin.TValue[[]interface{}]
-	Output plugin.TValue[[]interface{}]
+	// optional: if you define mqlYumInternal it will be used here
+	Vars plugin.TValue[map[string]interface{}]
+	Repos plugin.TValue[[]interface{}]
 }
 
-// createIp6tables creates a new instance of this resource
-func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIp6tables{
+// createYum creates a new instance of this resource
+func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlYum{
 		MqlRuntime: runtime,
 	}
 
@@ -10209,10 +15454,15 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
+		args, err = runtime.ResourceFromRecording("yum", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10222,34 +15472,24 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlIp6tables) MqlName() string {
-	return "ip6tables"
+func (c *mqlYum) MqlName() string {
+	return "yum"
 }
 
-func (c *mqlIp6tables) MqlID() string {
+func (c *mqlYum) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIp6tables) GetInput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Input, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.input()
+func (c *mqlYum) GetVars() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Vars, func() (map[string]interface{}, error) {
+		return c.vars()
 	})
 }
 
-func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Output, func() ([]interface{}, error) {
+func (c *mqlYum) GetRepos() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Repos, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("yum", c.__id, "repos")
 			if err != nil {
 				return nil, err
 			}
@@ -10258,32 +15498,32 @@ func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.output()
+		return c.repos()
 	})
 }
 
-// mqlIptablesEntry for the iptables.entry resource
-type mqlIptablesEntry struct {
+// mqlYumRepo for the yum.repo resource
+type mqlYumRepo struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlIptablesEntryInternal it will be used here
-	LineNumber plugin.TValue[int64]
-	Packets plugin.TValue[int64]
-	Bytes plugin.TValue[int64]
-	Target plugin.TValue[string]
-	Protocol plugin.TValue[string]
-	Opt plugin.TValue[string]
-	In plugin.TValue[string]
-	Out plugin.TValue[string]
-	Source plugin.TValue[string]
-	Destination plugin.TValue[string]
-	Options plugin.TValue[string]
-	Chain plugin.TValue[string]
+	// optional: if you define mqlYumRepoInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Status plugin.TValue[string]
+	Baseurl plugin.TValue[[]interface{}]
+	Expire plugin.TValue[string]
+	Filename plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Revision plugin.TValue[string]
+	Pkgs plugin.TValue[string]
+	Size plugin.TValue[string]
+	Mirrors plugin.TValue[string]
+	Enabled plugin.TValue[bool]
 }
 
-// createIptablesEntry creates a new instance of this resource
-func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptablesEntry{
+// createYumRepo creates a new instance of this resource
+func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlYumRepo{
 		MqlRuntime: runtime,
 	}
 
@@ -10300,7 +15540,7 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("yum.repo", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10310,77 +15550,79 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlIptablesEntry) MqlName() string {
-	return "iptables.entry"
+func (c *mqlYumRepo) MqlName() string {
+	return "yum.repo"
 }
 
-func (c *mqlIptablesEntry) MqlID() string {
+func (c *mqlYumRepo) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlYumRepo) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
-	return &c.Packets
+func (c *mqlYumRepo) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
-	return &c.Bytes
+func (c *mqlYumRepo) GetStatus() *plugin.TValue[string] {
+	return &c.Status
 }
 
-func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
-	return &c.Target
+func (c *mqlYumRepo) GetBaseurl() *plugin.TValue[[]interface{}] {
+	return &c.Baseurl
 }
 
-func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlYumRepo) GetExpire() *plugin.TValue[string] {
+	return &c.Expire
 }
 
-func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
-	return &c.Opt
+func (c *mqlYumRepo) GetFilename() *plugin.TValue[string] {
+	return &c.Filename
 }
 
-func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
-	return &c.In
+func (c *mqlYumRepo) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
-	return &c.Out
+func (c *mqlYumRepo) GetRevision() *plugin.TValue[string] {
+	return &c.Revision
 }
 
-func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
-	return &c.Source
+func (c *mqlYumRepo) GetPkgs() *plugin.TValue[string] {
+	return &c.Pkgs
 }
 
-func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
-	return &c.Destination
+func (c *mqlYumRepo) GetSize() *plugin.TValue[string] {
+	return &c.Size
 }
 
-func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
-	return &c.Options
+func (c *mqlYumRepo) GetMirrors() *plugin.TValue[string] {
+	return &c.Mirrors
 }
 
-func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
-	return &c.Chain
+func (c *mqlYumRepo) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		return c.enabled()
+	})
 }
 
-// mqlProcess for the process resource
-type mqlProcess struct {
+// mqlRegistrykey for the registrykey resource
+type mqlRegistrykey struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlProcessInternal
-	Pid plugin.TValue[int64]
-	State plugin.TValue[string]
-	Executable plugin.TValue[string]
-	Command plugin.TValue[string]
-	Flags plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlRegistrykeyInternal it will be used here
+	Path plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Properties plugin.TValue[map[string]interface{}]
+	Items plugin.TValue[[]interface{}]
+	Children plugin.TValue[[]interface{}]
 }
 
-// createProcess creates a new instance of this resource
-func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcess{
+// createRegistrykey creates a new instance of this resource
+func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykey{
 		MqlRuntime: runtime,
 	}
 
@@ -10397,7 +15639,7 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("process", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10407,86 +15649,34 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlProcess) MqlName() string {
-	return "process"
+func (c *mqlRegistrykey) MqlName() string {
+	return "registrykey"
 }
 
-func (c *mqlProcess) MqlID() string {
+func (c *mqlRegistrykey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
-	return &c.Pid
-}
-
-func (c *mqlProcess) GetState() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
-		return c.state()
-	})
-}
-
-func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
-		return c.executable()
-	})
+func (c *mqlRegistrykey) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
-		return c.command()
+func (c *mqlRegistrykey) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
 	})
 }
 
-func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Flags, func() (map[string]interface{}, error) {
-		return c.flags()
+func (c *mqlRegistrykey) GetProperties() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Properties, func() (map[string]interface{}, error) {
+		return c.properties()
 	})
 }
 
-// mqlProcesses for the processes resource
-type mqlProcesses struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	mqlProcessesInternal
-	List plugin.TValue[[]interface{}]
-}
-
-// createProcesses creates a new instance of this resource
-func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcesses{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("processes", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlProcesses) MqlName() string {
-	return "processes"
-}
-
-func (c *mqlProcesses) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlRegistrykey) GetItems() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Items, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("registrykey", c.__id, "items")
 			if err != nil {
 				return nil, err
 			}
@@ -10495,29 +15685,32 @@ func (c *mqlProcesses) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		return c.items()
 	})
 }
 
-// mqlPort for the port resource
-type mqlPort struct {
+func (c *mqlRegistrykey) GetChildren() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Children, func() ([]interface{}, error) {
+		return c.children()
+	})
+}
+
+// mqlRegistrykeyProperty for the registrykey.property resource
+type mqlRegistrykeyProperty struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlPortInternal
-	Protocol plugin.TValue[string]
-	Port plugin.TValue[int64]
-	Address plugin.TValue[string]
-	User plugin.TValue[*mqlUser]
-	Process plugin.TValue[*mqlProcess]
-	State plugin.TValue[string]
-	RemoteAddress plugin.TValue[string]
-	RemotePort plugin.TValue[int64]
-	Tls plugin.TValue[plugin.Resource]
+	// optional: if you define mqlRegistrykeyPropertyInternal it will be used here
+	Path plugin.TValue[string]
+	Name plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Value plugin.TValue[string]
+	Type plugin.TValue[string]
+	Data plugin.TValue[interface{}]
 }
 
-// createPort creates a new instance of this resource
-func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPort{
+// createRegistrykeyProperty creates a new instance of this resource
+func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykeyProperty{
 		MqlRuntime: runtime,
 	}
 
@@ -10534,7 +15727,7 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("port", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey.property", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10544,101 +15737,62 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlPort) MqlName() string {
-	return "port"
+func (c *mqlRegistrykeyProperty) MqlName() string {
+	return "registrykey.property"
 }
 
-func (c *mqlPort) MqlID() string {
+func (c *mqlRegistrykeyProperty) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
-}
-
-func (c *mqlPort) GetPort() *plugin.TValue[int64] {
-	return &c.Port
-}
-
-func (c *mqlPort) GetAddress() *plugin.TValue[string] {
-	return &c.Address
+func (c *mqlRegistrykeyProperty) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
-	return &c.User
+func (c *mqlRegistrykeyProperty) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
-	return plugin.GetOrCompute[*mqlProcess](&c.Process, func() (*mqlProcess, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "process")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlProcess), nil
-			}
-		}
-
-		return c.process()
+func (c *mqlRegistrykeyProperty) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
 	})
 }
 
-func (c *mqlPort) GetState() *plugin.TValue[string] {
-	return &c.State
-}
-
-func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
-	return &c.RemoteAddress
+func (c *mqlRegistrykeyProperty) GetValue() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
+		return c.value()
+	})
 }
 
-func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
-	return &c.RemotePort
+func (c *mqlRegistrykeyProperty) GetType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
+		return c.compute_type()
+	})
 }
 
-func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(plugin.Resource), nil
-			}
-		}
-
-		vargAddress := c.GetAddress()
-		if vargAddress.Error != nil {
-			return nil, vargAddress.Error
-		}
-
-		vargPort := c.GetPort()
-		if vargPort.Error != nil {
-			return nil, vargPort.Error
-		}
-
-		vargProtocol := c.GetProtocol()
-		if vargProtocol.Error != nil {
-			return nil, vargProtocol.Error
-		}
-
-		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
+func (c *mqlRegistrykeyProperty) GetData() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Data, func() (interface{}, error) {
+		return c.data()
 	})
 }
 
-// mqlPorts for the ports resource
-type mqlPorts struct {
+// mqlContainerImage for the container.image resource
+type mqlContainerImage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlPortsInternal
-	Listening plugin.TValue[[]interface{}]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlContainerImageInternal it will be used here
+	Reference plugin.TValue[string]
+	Name plugin.TValue[string]
+	Identifier plugin.TValue[string]
+	IdentifierType plugin.TValue[string]
+	Repository plugin.TValue[*mqlContainerRepository]
+	Layers plugin.TValue[[]interface{}]
 }
 
-// createPorts creates a new instance of this resource
-func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPorts{
+// createContainerImage creates a new instance of this resource
+func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerImage{
 		MqlRuntime: runtime,
 	}
 
@@ -10647,10 +15801,15 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ports", res.__id)
+		args, err = runtime.ResourceFromRecording("container.image", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10660,34 +15819,50 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlPorts) MqlName() string {
-	return "ports"
+func (c *mqlContainerImage) MqlName() string {
+	return "container.image"
 }
 
-func (c *mqlPorts) MqlID() string {
+func (c *mqlContainerImage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPorts) GetListening() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Listening, func() ([]interface{}, error) {
+func (c *mqlContainerImage) GetReference() *plugin.TValue[string] {
+	return &c.Reference
+}
+
+func (c *mqlContainerImage) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlContainerImage) GetIdentifier() *plugin.TValue[string] {
+	return &c.Identifier
+}
+
+func (c *mqlContainerImage) GetIdentifierType() *plugin.TValue[string] {
+	return &c.IdentifierType
+}
+
+func (c *mqlContainerImage) GetRepository() *plugin.TValue[*mqlContainerRepository] {
+	return plugin.GetOrCompute[*mqlContainerRepository](&c.Repository, func() (*mqlContainerRepository, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("container.image", c.__id, "repository")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlContainerRepository), nil
 			}
 		}
 
-		return c.listening()
+		return c.repository()
 	})
 }
 
-func (c *mqlPorts) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlContainerImage) GetLayers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Layers, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("container.image", c.__id, "layers")
 			if err != nil {
 				return nil, err
 			}
@@ -10696,21 +15871,24 @@ func (c *mqlPorts) GetList() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.list()
+		return c.layers()
 	})
 }
 
-// mqlAuditpol for the auditpol resource
-type mqlAuditpol struct {
+// mqlContainerImageLayer for the container.image.layer resource
+type mqlContainerImageLayer struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditpolInternal it will be used here
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlContainerImageLayerInternal it will be used here
+	Index plugin.TValue[int64]
+	Digest plugin.TValue[string]
+	CreatedBy plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
 }
 
-// createAuditpol creates a new instance of this resource
-func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpol{
+// createContainerImageLayer creates a new instance of this resource
+func createContainerImageLayer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerImageLayer{
 		MqlRuntime: runtime,
 	}
 
@@ -10719,10 +15897,15 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		args, err = runtime.ResourceFromRecording("container.image.layer", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10732,46 +15915,44 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAuditpol) MqlName() string {
-	return "auditpol"
+func (c *mqlContainerImageLayer) MqlName() string {
+	return "container.image.layer"
 }
 
-func (c *mqlAuditpol) MqlID() string {
+func (c *mqlContainerImageLayer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpol) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlContainerImageLayer) GetIndex() *plugin.TValue[int64] {
+	return &c.Index
+}
 
-		return c.list()
-	})
+func (c *mqlContainerImageLayer) GetDigest() *plugin.TValue[string] {
+	return &c.Digest
 }
 
-// mqlAuditpolEntry for the auditpol.entry resource
-type mqlAuditpolEntry struct {
+func (c *mqlContainerImageLayer) GetCreatedBy() *plugin.TValue[string] {
+	return &c.CreatedBy
+}
+
+func (c *mqlContainerImageLayer) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+// mqlContainerRepository for the container.repository resource
+type mqlContainerRepository struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlAuditpolEntryInternal it will be used here
-	Machinename plugin.TValue[string]
-	Policytarget plugin.TValue[string]
-	Subcategory plugin.TValue[string]
-	Subcategoryguid plugin.TValue[string]
-	Inclusionsetting plugin.TValue[string]
-	Exclusionsetting plugin.TValue[string]
+	// optional: if you define mqlContainerRepositoryInternal it will be used here
+	Name plugin.TValue[string]
+	Scheme plugin.TValue[string]
+	FullName plugin.TValue[string]
+	Registry plugin.TValue[string]
 }
 
-// createAuditpolEntry creates a new instance of this resource
-func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpolEntry{
+// createContainerRepository creates a new instance of this resource
+func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerRepository{
 		MqlRuntime: runtime,
 	}
 
@@ -10788,7 +15969,7 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("container.repository", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10798,52 +15979,43 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlAuditpolEntry) MqlName() string {
-	return "auditpol.entry"
+func (c *mqlContainerRepository) MqlName() string {
+	return "container.repository"
 }
 
-func (c *mqlAuditpolEntry) MqlID() string {
+func (c *mqlContainerRepository) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
-	return &c.Machinename
-}
-
-func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
-	return &c.Policytarget
-}
-
-func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
-	return &c.Subcategory
+func (c *mqlContainerRepository) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
-	return &c.Subcategoryguid
+func (c *mqlContainerRepository) GetScheme() *plugin.TValue[string] {
+	return &c.Scheme
 }
 
-func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
-	return &c.Inclusionsetting
+func (c *mqlContainerRepository) GetFullName() *plugin.TValue[string] {
+	return &c.FullName
 }
 
-func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
-	return &c.Exclusionsetting
+func (c *mqlContainerRepository) GetRegistry() *plugin.TValue[string] {
+	return &c.Registry
 }
 
-// mqlSecpol for the secpol resource
-type mqlSecpol struct {
+// mqlKubelet for the kubelet resource
+type mqlKubelet struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlSecpolInternal
-	Systemaccess plugin.TValue[map[string]interface{}]
-	Eventaudit plugin.TValue[map[string]interface{}]
-	Registryvalues plugin.TValue[map[string]interface{}]
-	Privilegerights plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlKubeletInternal it will be used here
+	ConfigFile plugin.TValue[*mqlFile]
+	Process plugin.TValue[*mqlProcess]
+	Configuration plugin.TValue[interface{}]
 }
 
-// createSecpol creates a new instance of this resource
-func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSecpol{
+// createKubelet creates a new instance of this resource
+func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKubelet{
 		MqlRuntime: runtime,
 	}
 
@@ -10855,7 +16027,7 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("secpol", res.__id)
+		args, err = runtime.ResourceFromRecording("kubelet", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10865,54 +16037,41 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlSecpol) MqlName() string {
-	return "secpol"
+func (c *mqlKubelet) MqlName() string {
+	return "kubelet"
 }
 
-func (c *mqlSecpol) MqlID() string {
+func (c *mqlKubelet) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Systemaccess, func() (map[string]interface{}, error) {
-		return c.systemaccess()
-	})
-}
-
-func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Eventaudit, func() (map[string]interface{}, error) {
-		return c.eventaudit()
-	})
+func (c *mqlKubelet) GetConfigFile() *plugin.TValue[*mqlFile] {
+	return &c.ConfigFile
 }
 
-func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Registryvalues, func() (map[string]interface{}, error) {
-		return c.registryvalues()
-	})
+func (c *mqlKubelet) GetProcess() *plugin.TValue[*mqlProcess] {
+	return &c.Process
 }
 
-func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Privilegerights, func() (map[string]interface{}, error) {
-		return c.privilegerights()
+func (c *mqlKubelet) GetConfiguration() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Configuration, func() (interface{}, error) {
+		return c.configuration()
 	})
 }
 
-// mqlNtpConf for the ntp.conf resource
-type mqlNtpConf struct {
+// mqlPython for the python resource
+type mqlPython struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlNtpConfInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Settings plugin.TValue[[]interface{}]
-	Servers plugin.TValue[[]interface{}]
-	Restrict plugin.TValue[[]interface{}]
-	Fudge plugin.TValue[[]interface{}]
+	// optional: if you define mqlPythonInternal it will be used here
+	Path plugin.TValue[string]
+	Packages plugin.TValue[[]interface{}]
+	Toplevel plugin.TValue[[]interface{}]
 }
 
-// createNtpConf creates a new instance of this resource
-func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNtpConf{
+// createPython creates a new instance of this resource
+func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPython{
 		MqlRuntime: runtime,
 	}
 
@@ -10929,7 +16088,7 @@ func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ntp.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("python", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -10939,99 +16098,72 @@ func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlNtpConf) MqlName() string {
-	return "ntp.conf"
+func (c *mqlPython) MqlName() string {
+	return "python"
 }
 
-func (c *mqlNtpConf) MqlID() string {
+func (c *mqlPython) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNtpConf) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlPython) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlPython) GetPackages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Packages, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ntp.conf", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "packages")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.file()
-	})
-}
-
-func (c *mqlNtpConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetSettings() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Settings, func() ([]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.settings(vargContent.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetServers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Servers, func() ([]interface{}, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
-		}
-
-		return c.servers(vargSettings.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetRestrict() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Restrict, func() ([]interface{}, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
-		}
-
-		return c.restrict(vargSettings.Data)
+		return c.packages()
 	})
 }
 
-func (c *mqlNtpConf) GetFudge() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Fudge, func() ([]interface{}, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
+func (c *mqlPython) GetToplevel() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Toplevel, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "toplevel")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.fudge(vargSettings.Data)
+		return c.toplevel()
 	})
 }
 
-// mqlRsyslogConf for the rsyslog.conf resource
-type mqlRsyslogConf struct {
+// mqlPythonPackage for the python.package resource
+type mqlPythonPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlRsyslogConfInternal it will be used here
-	Path plugin.TValue[string]
-	Files plugin.TValue[[]interface{}]
-	Content plugin.TValue[string]
-	Settings plugin.TValue[[]interface{}]
+	// optional: if you define mqlPythonPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Version plugin.TValue[string]
+	License plugin.TValue[string]
+	Author plugin.TValue[string]
+	AuthorEmail plugin.TValue[string]
+	Summary plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Dependencies plugin.TValue[[]interface{}]
+	Layer plugin.TValue[*mqlContainerImageLayer]
 }
 
-// createRsyslogConf creates a new instance of this resource
-func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRsyslogConf{
+// createPythonPackage creates a new instance of this resource
+func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPythonPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -11048,7 +16180,7 @@ func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("rsyslog.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("python.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11058,24 +16190,68 @@ func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlRsyslogConf) MqlName() string {
-	return "rsyslog.conf"
+func (c *mqlPythonPackage) MqlName() string {
+	return "python.package"
 }
 
-func (c *mqlRsyslogConf) MqlID() string {
+func (c *mqlPythonPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRsyslogConf) GetPath() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Path, func() (string, error) {
-		return c.path()
+func (c *mqlPythonPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlPythonPackage) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
 	})
 }
 
-func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+func (c *mqlPythonPackage) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlPythonPackage) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlPythonPackage) GetLicense() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.License, func() (string, error) {
+		return c.license()
+	})
+}
+
+func (c *mqlPythonPackage) GetAuthor() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Author, func() (string, error) {
+		return c.author()
+	})
+}
+
+func (c *mqlPythonPackage) GetAuthorEmail() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.AuthorEmail, func() (string, error) {
+		return c.authorEmail()
+	})
+}
+
+func (c *mqlPythonPackage) GetSummary() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Summary, func() (string, error) {
+		return c.summary()
+	})
+}
+
+func (c *mqlPythonPackage) GetPurl() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
+		return c.purl()
+	})
+}
+
+func (c *mqlPythonPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("rsyslog.conf", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "cpes")
 			if err != nil {
 				return nil, err
 			}
@@ -11084,50 +16260,57 @@ func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
-		}
-
-		return c.files(vargPath.Data)
+		return c.cpes()
 	})
 }
 
-func (c *mqlRsyslogConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
+func (c *mqlPythonPackage) GetDependencies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Dependencies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "dependencies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.content(vargFiles.Data)
+		return c.dependencies()
 	})
 }
 
-func (c *mqlRsyslogConf) GetSettings() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Settings, func() ([]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
+func (c *mqlPythonPackage) GetLayer() *plugin.TValue[*mqlContainerImageLayer] {
+	return plugin.GetOrCompute[*mqlContainerImageLayer](&c.Layer, func() (*mqlContainerImageLayer, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "layer")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlContainerImageLayer), nil
+			}
 		}
 
-		return c.settings(vargContent.Data)
+		return c.layer()
 	})
 }
 
-// mqlLogindefs for the logindefs resource
-type mqlLogindefs struct {
+// mqlNpmPackages for the npm.packages resource
+type mqlNpmPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlLogindefsInternal it will be used here
-	File plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
+	mqlNpmPackagesInternal
+	Path plugin.TValue[string]
+	Root plugin.TValue[*mqlNpmPackage]
+	DirectDependencies plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createLogindefs creates a new instance of this resource
-func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLogindefs{
+// createNpmPackages creates a new instance of this resource
+func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -11144,7 +16327,7 @@ func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("logindefs", res.__id)
+		args, err = runtime.ResourceFromRecording("npm.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11154,101 +16337,70 @@ func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlLogindefs) MqlName() string {
-	return "logindefs"
+func (c *mqlNpmPackages) MqlName() string {
+	return "npm.packages"
 }
 
-func (c *mqlLogindefs) MqlID() string {
+func (c *mqlNpmPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLogindefs) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlNpmPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlNpmPackages) GetRoot() *plugin.TValue[*mqlNpmPackage] {
+	return plugin.GetOrCompute[*mqlNpmPackage](&c.Root, func() (*mqlNpmPackage, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("logindefs", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "root")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.(*mqlNpmPackage), nil
 			}
 		}
 
-		return c.file()
+		return c.root()
 	})
 }
 
-func (c *mqlLogindefs) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
+func (c *mqlNpmPackages) GetDirectDependencies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.DirectDependencies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "directDependencies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.content(vargFile.Data)
+		return c.directDependencies()
 	})
 }
 
-func (c *mqlLogindefs) GetParams() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Params, func() (map[string]interface{}, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
+func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
 
-		return c.params(vargContent.Data)
+		return c.files()
 	})
 }
 
-// mqlLsblk for the lsblk resource
-type mqlLsblk struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlLsblkInternal it will be used here
-	List plugin.TValue[[]interface{}]
-}
-
-// createLsblk creates a new instance of this resource
-func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLsblk{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("lsblk", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlLsblk) MqlName() string {
-	return "lsblk"
-}
-
-func (c *mqlLsblk) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlLsblk) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlNpmPackages) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("lsblk", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -11261,21 +16413,27 @@ func (c *mqlLsblk) GetList() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlLsblkEntry for the lsblk.entry resource
-type mqlLsblkEntry struct {
+// mqlNpmPackage for the npm.package resource
+type mqlNpmPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlLsblkEntryInternal it will be used here
+	// optional: if you define mqlNpmPackageInternal it will be used here
+	Id plugin.TValue[string]
 	Name plugin.TValue[string]
-	Fstype plugin.TValue[string]
-	Label plugin.TValue[string]
-	Uuid plugin.TValue[string]
-	Mountpoints plugin.TValue[[]interface{}]
+	Version plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	License plugin.TValue[string]
+	Files plugin.TValue[[]interface{}]
+	Dev plugin.TValue[bool]
+	Optional plugin.TValue[bool]
+	Dependencies plugin.TValue[[]interface{}]
+	Layer plugin.TValue[*mqlContainerImageLayer]
 }
 
-// createLsblkEntry creates a new instance of this resource
-func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLsblkEntry{
+// createNpmPackage creates a new instance of this resource
+func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -11292,7 +16450,7 @@ func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("lsblk.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("npm.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11302,110 +16460,131 @@ func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlLsblkEntry) MqlName() string {
-	return "lsblk.entry"
+func (c *mqlNpmPackage) MqlName() string {
+	return "npm.package"
 }
 
-func (c *mqlLsblkEntry) MqlID() string {
+func (c *mqlNpmPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLsblkEntry) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlNpmPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlLsblkEntry) GetFstype() *plugin.TValue[string] {
-	return &c.Fstype
+func (c *mqlNpmPackage) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
 }
 
-func (c *mqlLsblkEntry) GetLabel() *plugin.TValue[string] {
-	return &c.Label
+func (c *mqlNpmPackage) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
 }
 
-func (c *mqlLsblkEntry) GetUuid() *plugin.TValue[string] {
-	return &c.Uuid
+func (c *mqlNpmPackage) GetPurl() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
+		return c.purl()
+	})
 }
 
-func (c *mqlLsblkEntry) GetMountpoints() *plugin.TValue[[]interface{}] {
-	return &c.Mountpoints
-}
+func (c *mqlNpmPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "cpes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-// mqlMount for the mount resource
-type mqlMount struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlMountInternal it will be used here
-	List plugin.TValue[[]interface{}]
+		return c.cpes()
+	})
 }
 
-// createMount creates a new instance of this resource
-func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMount{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlNpmPackage) GetLicense() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.License, func() (string, error) {
+		return c.license()
+	})
+}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
+func (c *mqlNpmPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
-	}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mount", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+		return c.files()
+	})
+}
 
-	return res, nil
+func (c *mqlNpmPackage) GetDev() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Dev, func() (bool, error) {
+		return c.dev()
+	})
 }
 
-func (c *mqlMount) MqlName() string {
-	return "mount"
+func (c *mqlNpmPackage) GetOptional() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Optional, func() (bool, error) {
+		return c.optional()
+	})
 }
 
-func (c *mqlMount) MqlID() string {
-	return c.__id
+func (c *mqlNpmPackage) GetDependencies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Dependencies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "dependencies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.dependencies()
+	})
 }
 
-func (c *mqlMount) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+func (c *mqlNpmPackage) GetLayer() *plugin.TValue[*mqlContainerImageLayer] {
+	return plugin.GetOrCompute[*mqlContainerImageLayer](&c.Layer, func() (*mqlContainerImageLayer, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("mount", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "layer")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]interface{}), nil
+				return d.Value.(*mqlContainerImageLayer), nil
 			}
 		}
 
-		return c.list()
+		return c.layer()
 	})
 }
 
-// mqlMountPoint for the mount.point resource
-type mqlMountPoint struct {
+// mqlJavaPackages for the java.packages resource
+type mqlJavaPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlMountPointInternal it will be used here
-	Device plugin.TValue[string]
+	mqlJavaPackagesInternal
 	Path plugin.TValue[string]
-	Fstype plugin.TValue[string]
-	Options plugin.TValue[map[string]interface{}]
-	Mounted plugin.TValue[bool]
+	Files plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createMountPoint creates a new instance of this resource
-func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMountPoint{
+// createJavaPackages creates a new instance of this resource
+func createJavaPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -11422,7 +16601,7 @@ func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mount.point", res.__id)
+		args, err = runtime.ResourceFromRecording("java.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11432,83 +16611,38 @@ func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlMountPoint) MqlName() string {
-	return "mount.point"
+func (c *mqlJavaPackages) MqlName() string {
+	return "java.packages"
 }
 
-func (c *mqlMountPoint) MqlID() string {
+func (c *mqlJavaPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMountPoint) GetDevice() *plugin.TValue[string] {
-	return &c.Device
-}
-
-func (c *mqlMountPoint) GetPath() *plugin.TValue[string] {
+func (c *mqlJavaPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlMountPoint) GetFstype() *plugin.TValue[string] {
-	return &c.Fstype
-}
-
-func (c *mqlMountPoint) GetOptions() *plugin.TValue[map[string]interface{}] {
-	return &c.Options
-}
-
-func (c *mqlMountPoint) GetMounted() *plugin.TValue[bool] {
-	return &c.Mounted
-}
-
-// mqlShadow for the shadow resource
-type mqlShadow struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlShadowInternal it will be used here
-	List plugin.TValue[[]interface{}]
-}
-
-// createShadow creates a new instance of this resource
-func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlShadow{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("shadow", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlJavaPackages) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("java.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
 		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlShadow) MqlName() string {
-	return "shadow"
-}
 
-func (c *mqlShadow) MqlID() string {
-	return c.__id
+		return c.files()
+	})
 }
 
-func (c *mqlShadow) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlJavaPackages) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("shadow", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("java.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -11521,25 +16655,23 @@ func (c *mqlShadow) GetList() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlShadowEntry for the shadow.entry resource
-type mqlShadowEntry struct {
+// mqlJavaPackage for the java.package resource
+type mqlJavaPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlShadowEntryInternal it will be used here
-	User plugin.TValue[string]
-	Password plugin.TValue[string]
-	Lastchanged plugin.TValue[*time.Time]
-	Mindays plugin.TValue[int64]
-	Maxdays plugin.TValue[int64]
-	Warndays plugin.TValue[int64]
-	Inactivedays plugin.TValue[int64]
-	Expirydates plugin.TValue[string]
-	Reserved plugin.TValue[string]
+	// optional: if you define mqlJavaPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	GroupId plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
 }
 
-// createShadowEntry creates a new instance of this resource
-func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlShadowEntry{
+// createJavaPackage creates a new instance of this resource
+func createJavaPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -11556,7 +16688,7 @@ func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("shadow.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("java.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11566,62 +16698,55 @@ func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlShadowEntry) MqlName() string {
-	return "shadow.entry"
+func (c *mqlJavaPackage) MqlName() string {
+	return "java.package"
 }
 
-func (c *mqlShadowEntry) MqlID() string {
+func (c *mqlJavaPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlShadowEntry) GetUser() *plugin.TValue[string] {
-	return &c.User
-}
-
-func (c *mqlShadowEntry) GetPassword() *plugin.TValue[string] {
-	return &c.Password
-}
-
-func (c *mqlShadowEntry) GetLastchanged() *plugin.TValue[*time.Time] {
-	return &c.Lastchanged
+func (c *mqlJavaPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlShadowEntry) GetMindays() *plugin.TValue[int64] {
-	return &c.Mindays
+func (c *mqlJavaPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlShadowEntry) GetMaxdays() *plugin.TValue[int64] {
-	return &c.Maxdays
+func (c *mqlJavaPackage) GetGroupId() *plugin.TValue[string] {
+	return &c.GroupId
 }
 
-func (c *mqlShadowEntry) GetWarndays() *plugin.TValue[int64] {
-	return &c.Warndays
+func (c *mqlJavaPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlShadowEntry) GetInactivedays() *plugin.TValue[int64] {
-	return &c.Inactivedays
+func (c *mqlJavaPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlShadowEntry) GetExpirydates() *plugin.TValue[string] {
-	return &c.Expirydates
+func (c *mqlJavaPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
 }
 
-func (c *mqlShadowEntry) GetReserved() *plugin.TValue[string] {
-	return &c.Reserved
+func (c *mqlJavaPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
-// mqlYum for the yum resource
-type mqlYum struct {
+// mqlGolangBinaries for the golang.binaries resource
+type mqlGolangBinaries struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlYumInternal it will be used here
-	Vars plugin.TValue[map[string]interface{}]
-	Repos plugin.TValue[[]interface{}]
+	mqlGolangBinariesInternal
+	Path plugin.TValue[string]
+	Packages plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createYum creates a new instance of this resource
-func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlYum{
+// createGolangBinaries creates a new instance of this resource
+func createGolangBinaries(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGolangBinaries{
 		MqlRuntime: runtime,
 	}
 
@@ -11638,7 +16763,7 @@ func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("yum", res.__id)
+		args, err = runtime.ResourceFromRecording("golang.binaries", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11648,24 +16773,38 @@ func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlYum) MqlName() string {
-	return "yum"
+func (c *mqlGolangBinaries) MqlName() string {
+	return "golang.binaries"
 }
 
-func (c *mqlYum) MqlID() string {
+func (c *mqlGolangBinaries) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlYum) GetVars() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Vars, func() (map[string]interface{}, error) {
-		return c.vars()
+func (c *mqlGolangBinaries) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlGolangBinaries) GetPackages() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Packages, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("golang.binaries", c.__id, "packages")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.packages()
 	})
 }
 
-func (c *mqlYum) GetRepos() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Repos, func() ([]interface{}, error) {
+func (c *mqlGolangBinaries) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("yum", c.__id, "repos")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("golang.binaries", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -11674,32 +16813,25 @@ func (c *mqlYum) GetRepos() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.repos()
+		return c.list()
 	})
 }
 
-// mqlYumRepo for the yum.repo resource
-type mqlYumRepo struct {
+// mqlGolangBinary for the golang.binary resource
+type mqlGolangBinary struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlYumRepoInternal it will be used here
-	Id plugin.TValue[string]
-	Name plugin.TValue[string]
-	Status plugin.TValue[string]
-	Baseurl plugin.TValue[[]interface{}]
-	Expire plugin.TValue[string]
-	Filename plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
-	Revision plugin.TValue[string]
-	Pkgs plugin.TValue[string]
-	Size plugin.TValue[string]
-	Mirrors plugin.TValue[string]
-	Enabled plugin.TValue[bool]
+	// optional: if you define mqlGolangBinaryInternal it will be used here
+	Path plugin.TValue[string]
+	GoVersion plugin.TValue[string]
+	MainModule plugin.TValue[*mqlGolangPackage]
+	Dependencies plugin.TValue[[]interface{}]
+	Settings plugin.TValue[map[string]interface{}]
 }
 
-// createYumRepo creates a new instance of this resource
-func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlYumRepo{
+// createGolangBinary creates a new instance of this resource
+func createGolangBinary(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGolangBinary{
 		MqlRuntime: runtime,
 	}
 
@@ -11708,97 +16840,63 @@ func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("yum.repo", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlYumRepo) MqlName() string {
-	return "yum.repo"
-}
-
-func (c *mqlYumRepo) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlYumRepo) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlYumRepo) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlYumRepo) GetStatus() *plugin.TValue[string] {
-	return &c.Status
-}
+	// to override __id implement: id() (string, error)
 
-func (c *mqlYumRepo) GetBaseurl() *plugin.TValue[[]interface{}] {
-	return &c.Baseurl
-}
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("golang.binary", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
 
-func (c *mqlYumRepo) GetExpire() *plugin.TValue[string] {
-	return &c.Expire
+	return res, nil
 }
 
-func (c *mqlYumRepo) GetFilename() *plugin.TValue[string] {
-	return &c.Filename
+func (c *mqlGolangBinary) MqlName() string {
+	return "golang.binary"
 }
 
-func (c *mqlYumRepo) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlGolangBinary) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlYumRepo) GetRevision() *plugin.TValue[string] {
-	return &c.Revision
+func (c *mqlGolangBinary) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlYumRepo) GetPkgs() *plugin.TValue[string] {
-	return &c.Pkgs
+func (c *mqlGolangBinary) GetGoVersion() *plugin.TValue[string] {
+	return &c.GoVersion
 }
 
-func (c *mqlYumRepo) GetSize() *plugin.TValue[string] {
-	return &c.Size
+func (c *mqlGolangBinary) GetMainModule() *plugin.TValue[*mqlGolangPackage] {
+	return &c.MainModule
 }
 
-func (c *mqlYumRepo) GetMirrors() *plugin.TValue[string] {
-	return &c.Mirrors
+func (c *mqlGolangBinary) GetDependencies() *plugin.TValue[[]interface{}] {
+	return &c.Dependencies
 }
 
-func (c *mqlYumRepo) GetEnabled() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
-		return c.enabled()
-	})
+func (c *mqlGolangBinary) GetSettings() *plugin.TValue[map[string]interface{}] {
+	return &c.Settings
 }
 
-// mqlRegistrykey for the registrykey resource
-type mqlRegistrykey struct {
+// mqlGolangPackage for the golang.package resource
+type mqlGolangPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlRegistrykeyInternal it will be used here
-	Path plugin.TValue[string]
-	Exists plugin.TValue[bool]
-	Properties plugin.TValue[map[string]interface{}]
-	Items plugin.TValue[[]interface{}]
-	Children plugin.TValue[[]interface{}]
+	// optional: if you define mqlGolangPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
 }
 
-// createRegistrykey creates a new instance of this resource
-func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRegistrykey{
+// createGolangPackage creates a new instance of this resource
+func createGolangPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGolangPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -11815,7 +16913,7 @@ func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("registrykey", res.__id)
+		args, err = runtime.ResourceFromRecording("golang.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11825,68 +16923,51 @@ func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlRegistrykey) MqlName() string {
-	return "registrykey"
+func (c *mqlGolangPackage) MqlName() string {
+	return "golang.package"
 }
 
-func (c *mqlRegistrykey) MqlID() string {
+func (c *mqlGolangPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRegistrykey) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlGolangPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlRegistrykey) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		return c.exists()
-	})
+func (c *mqlGolangPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlRegistrykey) GetProperties() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Properties, func() (map[string]interface{}, error) {
-		return c.properties()
-	})
+func (c *mqlGolangPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlRegistrykey) GetItems() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Items, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("registrykey", c.__id, "items")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlGolangPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
+}
 
-		return c.items()
-	})
+func (c *mqlGolangPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
 }
 
-func (c *mqlRegistrykey) GetChildren() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Children, func() ([]interface{}, error) {
-		return c.children()
-	})
+func (c *mqlGolangPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
-// mqlRegistrykeyProperty for the registrykey.property resource
-type mqlRegistrykeyProperty struct {
+// mqlRustPackages for the rust.packages resource
+type mqlRustPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlRegistrykeyPropertyInternal it will be used here
+	mqlRustPackagesInternal
 	Path plugin.TValue[string]
-	Name plugin.TValue[string]
-	Exists plugin.TValue[bool]
-	Value plugin.TValue[string]
-	Type plugin.TValue[string]
-	Data plugin.TValue[interface{}]
+	Files plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createRegistrykeyProperty creates a new instance of this resource
-func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRegistrykeyProperty{
+// createRustPackages creates a new instance of this resource
+func createRustPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRustPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -11903,7 +16984,7 @@ func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("registrykey.property", res.__id)
+		args, err = runtime.ResourceFromRecording("rust.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11913,61 +16994,66 @@ func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlRegistrykeyProperty) MqlName() string {
-	return "registrykey.property"
+func (c *mqlRustPackages) MqlName() string {
+	return "rust.packages"
 }
 
-func (c *mqlRegistrykeyProperty) MqlID() string {
+func (c *mqlRustPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRegistrykeyProperty) GetPath() *plugin.TValue[string] {
+func (c *mqlRustPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlRegistrykeyProperty) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlRegistrykeyProperty) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		return c.exists()
-	})
-}
+func (c *mqlRustPackages) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rust.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlRegistrykeyProperty) GetValue() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
-		return c.value()
+		return c.files()
 	})
 }
 
-func (c *mqlRegistrykeyProperty) GetType() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
-		return c.compute_type()
-	})
-}
+func (c *mqlRustPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rust.packages", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlRegistrykeyProperty) GetData() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Data, func() (interface{}, error) {
-		return c.data()
+		return c.list()
 	})
 }
 
-// mqlContainerImage for the container.image resource
-type mqlContainerImage struct {
+// mqlRustPackage for the rust.package resource
+type mqlRustPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlContainerImageInternal it will be used here
-	Reference plugin.TValue[string]
+	// optional: if you define mqlRustPackageInternal it will be used here
+	Id plugin.TValue[string]
 	Name plugin.TValue[string]
-	Identifier plugin.TValue[string]
-	IdentifierType plugin.TValue[string]
-	Repository plugin.TValue[*mqlContainerRepository]
+	Version plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
 }
 
-// createContainerImage creates a new instance of this resource
-func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerImage{
+// createRustPackage creates a new instance of this resource
+func createRustPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRustPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -11984,7 +17070,7 @@ func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("container.image", res.__id)
+		args, err = runtime.ResourceFromRecording("rust.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -11994,60 +17080,51 @@ func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlContainerImage) MqlName() string {
-	return "container.image"
+func (c *mqlRustPackage) MqlName() string {
+	return "rust.package"
 }
 
-func (c *mqlContainerImage) MqlID() string {
+func (c *mqlRustPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerImage) GetReference() *plugin.TValue[string] {
-	return &c.Reference
+func (c *mqlRustPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlContainerImage) GetName() *plugin.TValue[string] {
+func (c *mqlRustPackage) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlContainerImage) GetIdentifier() *plugin.TValue[string] {
-	return &c.Identifier
+func (c *mqlRustPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlContainerImage) GetIdentifierType() *plugin.TValue[string] {
-	return &c.IdentifierType
+func (c *mqlRustPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlContainerImage) GetRepository() *plugin.TValue[*mqlContainerRepository] {
-	return plugin.GetOrCompute[*mqlContainerRepository](&c.Repository, func() (*mqlContainerRepository, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("container.image", c.__id, "repository")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlContainerRepository), nil
-			}
-		}
+func (c *mqlRustPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
+}
 
-		return c.repository()
-	})
+func (c *mqlRustPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
-// mqlContainerRepository for the container.repository resource
-type mqlContainerRepository struct {
+// mqlRubyPackages for the ruby.packages resource
+type mqlRubyPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlContainerRepositoryInternal it will be used here
-	Name plugin.TValue[string]
-	Scheme plugin.TValue[string]
-	FullName plugin.TValue[string]
-	Registry plugin.TValue[string]
+	mqlRubyPackagesInternal
+	Path plugin.TValue[string]
+	Files plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createContainerRepository creates a new instance of this resource
-func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerRepository{
+// createRubyPackages creates a new instance of this resource
+func createRubyPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -12064,7 +17141,7 @@ func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("container.repository", res.__id)
+		args, err = runtime.ResourceFromRecording("ruby.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12074,43 +17151,66 @@ func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlContainerRepository) MqlName() string {
-	return "container.repository"
+func (c *mqlRubyPackages) MqlName() string {
+	return "ruby.packages"
 }
 
-func (c *mqlContainerRepository) MqlID() string {
+func (c *mqlRubyPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerRepository) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlRubyPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlContainerRepository) GetScheme() *plugin.TValue[string] {
-	return &c.Scheme
-}
+func (c *mqlRubyPackages) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ruby.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlContainerRepository) GetFullName() *plugin.TValue[string] {
-	return &c.FullName
+		return c.files()
+	})
 }
 
-func (c *mqlContainerRepository) GetRegistry() *plugin.TValue[string] {
-	return &c.Registry
+func (c *mqlRubyPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ruby.packages", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
 }
 
-// mqlKubelet for the kubelet resource
-type mqlKubelet struct {
+// mqlRubyPackage for the ruby.package resource
+type mqlRubyPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlKubeletInternal it will be used here
-	ConfigFile plugin.TValue[*mqlFile]
-	Process plugin.TValue[*mqlProcess]
-	Configuration plugin.TValue[interface{}]
-}
-
-// createKubelet creates a new instance of this resource
-func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKubelet{
+	// optional: if you define mqlRubyPackageInternal it will be used here
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl plugin.TValue[string]
+	Cpes plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
+}
+
+// createRubyPackage creates a new instance of this resource
+func createRubyPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -12119,10 +17219,15 @@ func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kubelet", res.__id)
+		args, err = runtime.ResourceFromRecording("ruby.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12132,41 +17237,51 @@ func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlKubelet) MqlName() string {
-	return "kubelet"
+func (c *mqlRubyPackage) MqlName() string {
+	return "ruby.package"
 }
 
-func (c *mqlKubelet) MqlID() string {
+func (c *mqlRubyPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKubelet) GetConfigFile() *plugin.TValue[*mqlFile] {
-	return &c.ConfigFile
+func (c *mqlRubyPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlKubelet) GetProcess() *plugin.TValue[*mqlProcess] {
-	return &c.Process
+func (c *mqlRubyPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlKubelet) GetConfiguration() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Configuration, func() (interface{}, error) {
-		return c.configuration()
-	})
+func (c *mqlRubyPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-// mqlPython for the python resource
-type mqlPython struct {
+func (c *mqlRubyPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
+}
+
+func (c *mqlRubyPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
+}
+
+func (c *mqlRubyPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
+}
+
+// mqlPhpPackages for the php.packages resource
+type mqlPhpPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPythonInternal it will be used here
+	mqlPhpPackagesInternal
 	Path plugin.TValue[string]
-	Packages plugin.TValue[[]interface{}]
-	Toplevel plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createPython creates a new instance of this resource
-func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPython{
+// createPhpPackages creates a new instance of this resource
+func createPhpPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPhpPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -12183,7 +17298,7 @@ func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("python", res.__id)
+		args, err = runtime.ResourceFromRecording("php.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12193,22 +17308,22 @@ func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlPython) MqlName() string {
-	return "python"
+func (c *mqlPhpPackages) MqlName() string {
+	return "php.packages"
 }
 
-func (c *mqlPython) MqlID() string {
+func (c *mqlPhpPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPython) GetPath() *plugin.TValue[string] {
+func (c *mqlPhpPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlPython) GetPackages() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Packages, func() ([]interface{}, error) {
+func (c *mqlPhpPackages) GetFiles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "packages")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("php.packages", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -12217,14 +17332,14 @@ func (c *mqlPython) GetPackages() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.packages()
+		return c.files()
 	})
 }
 
-func (c *mqlPython) GetToplevel() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Toplevel, func() ([]interface{}, error) {
+func (c *mqlPhpPackages) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "toplevel")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("php.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -12233,31 +17348,26 @@ func (c *mqlPython) GetToplevel() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.toplevel()
+		return c.list()
 	})
 }
 
-// mqlPythonPackage for the python.package resource
-type mqlPythonPackage struct {
+// mqlPhpPackage for the php.package resource
+type mqlPhpPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPythonPackageInternal it will be used here
+	// optional: if you define mqlPhpPackageInternal it will be used here
 	Id plugin.TValue[string]
 	Name plugin.TValue[string]
-	File plugin.TValue[*mqlFile]
 	Version plugin.TValue[string]
-	License plugin.TValue[string]
-	Author plugin.TValue[string]
-	AuthorEmail plugin.TValue[string]
-	Summary plugin.TValue[string]
 	Purl plugin.TValue[string]
 	Cpes plugin.TValue[[]interface{}]
-	Dependencies plugin.TValue[[]interface{}]
+	Files plugin.TValue[[]interface{}]
 }
 
-// createPythonPackage creates a new instance of this resource
-func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPythonPackage{
+// createPhpPackage creates a new instance of this resource
+func createPhpPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPhpPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -12274,7 +17384,7 @@ func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("python.package", res.__id)
+		args, err = runtime.ResourceFromRecording("php.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12284,111 +17394,51 @@ func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlPythonPackage) MqlName() string {
-	return "python.package"
+func (c *mqlPhpPackage) MqlName() string {
+	return "php.package"
 }
 
-func (c *mqlPythonPackage) MqlID() string {
+func (c *mqlPhpPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPythonPackage) GetId() *plugin.TValue[string] {
+func (c *mqlPhpPackage) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlPythonPackage) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
-}
-
-func (c *mqlPythonPackage) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
-}
-
-func (c *mqlPythonPackage) GetVersion() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
-		return c.version()
-	})
-}
-
-func (c *mqlPythonPackage) GetLicense() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.License, func() (string, error) {
-		return c.license()
-	})
-}
-
-func (c *mqlPythonPackage) GetAuthor() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Author, func() (string, error) {
-		return c.author()
-	})
-}
-
-func (c *mqlPythonPackage) GetAuthorEmail() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.AuthorEmail, func() (string, error) {
-		return c.authorEmail()
-	})
+func (c *mqlPhpPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlPythonPackage) GetSummary() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Summary, func() (string, error) {
-		return c.summary()
-	})
+func (c *mqlPhpPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlPythonPackage) GetPurl() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
-		return c.purl()
-	})
+func (c *mqlPhpPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlPythonPackage) GetCpes() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "cpes")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.cpes()
-	})
+func (c *mqlPhpPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
 }
 
-func (c *mqlPythonPackage) GetDependencies() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Dependencies, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "dependencies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.dependencies()
-	})
+func (c *mqlPhpPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
-// mqlNpmPackages for the npm.packages resource
-type mqlNpmPackages struct {
+// mqlDotnetPackages for the dotnet.packages resource
+type mqlDotnetPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlNpmPackagesInternal
+	mqlDotnetPackagesInternal
 	Path plugin.TValue[string]
-	Root plugin.TValue[*mqlNpmPackage]
-	DirectDependencies plugin.TValue[[]interface{}]
 	Files plugin.TValue[[]interface{}]
 	List plugin.TValue[[]interface{}]
 }
 
-// createNpmPackages creates a new instance of this resource
-func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNpmPackages{
+// createDotnetPackages creates a new instance of this resource
+func createDotnetPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDotnetPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -12405,7 +17455,7 @@ func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("npm.packages", res.__id)
+		args, err = runtime.ResourceFromRecording("dotnet.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12415,54 +17465,22 @@ func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlNpmPackages) MqlName() string {
-	return "npm.packages"
+func (c *mqlDotnetPackages) MqlName() string {
+	return "dotnet.packages"
 }
 
-func (c *mqlNpmPackages) MqlID() string {
+func (c *mqlDotnetPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNpmPackages) GetPath() *plugin.TValue[string] {
+func (c *mqlDotnetPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlNpmPackages) GetRoot() *plugin.TValue[*mqlNpmPackage] {
-	return plugin.GetOrCompute[*mqlNpmPackage](&c.Root, func() (*mqlNpmPackage, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "root")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlNpmPackage), nil
-			}
-		}
-
-		return c.root()
-	})
-}
-
-func (c *mqlNpmPackages) GetDirectDependencies() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.DirectDependencies, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "directDependencies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.directDependencies()
-	})
-}
-
-func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]interface{}] {
+func (c *mqlDotnetPackages) GetFiles() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dotnet.packages", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -12475,10 +17493,10 @@ func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]interface{}] {
 	})
 }
 
-func (c *mqlNpmPackages) GetList() *plugin.TValue[[]interface{}] {
+func (c *mqlDotnetPackages) GetList() *plugin.TValue[[]interface{}] {
 	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dotnet.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -12491,11 +17509,11 @@ func (c *mqlNpmPackages) GetList() *plugin.TValue[[]interface{}] {
 	})
 }
 
-// mqlNpmPackage for the npm.package resource
-type mqlNpmPackage struct {
+// mqlDotnetPackage for the dotnet.package resource
+type mqlDotnetPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlNpmPackageInternal it will be used here
+	// optional: if you define mqlDotnetPackageInternal it will be used here
 	Id plugin.TValue[string]
 	Name plugin.TValue[string]
 	Version plugin.TValue[string]
@@ -12504,9 +17522,9 @@ type mqlNpmPackage struct {
 	Files plugin.TValue[[]interface{}]
 }
 
-// createNpmPackage creates a new instance of this resource
-func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNpmPackage{
+// createDotnetPackage creates a new instance of this resource
+func createDotnetPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDotnetPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -12523,7 +17541,7 @@ func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("npm.package", res.__id)
+		args, err = runtime.ResourceFromRecording("dotnet.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -12533,66 +17551,36 @@ func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlNpmPackage) MqlName() string {
-	return "npm.package"
+func (c *mqlDotnetPackage) MqlName() string {
+	return "dotnet.package"
 }
 
-func (c *mqlNpmPackage) MqlID() string {
+func (c *mqlDotnetPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNpmPackage) GetId() *plugin.TValue[string] {
+func (c *mqlDotnetPackage) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlNpmPackage) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
+func (c *mqlDotnetPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlNpmPackage) GetVersion() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
-		return c.version()
-	})
+func (c *mqlDotnetPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlNpmPackage) GetPurl() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
-		return c.purl()
-	})
+func (c *mqlDotnetPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlNpmPackage) GetCpes() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Cpes, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "cpes")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.cpes()
-	})
+func (c *mqlDotnetPackage) GetCpes() *plugin.TValue[[]interface{}] {
+	return &c.Cpes
 }
 
-func (c *mqlNpmPackage) GetFiles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Files, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "files")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.files()
-	})
+func (c *mqlDotnetPackage) GetFiles() *plugin.TValue[[]interface{}] {
+	return &c.Files
 }
 
 // mqlMacos for the macos resource
@@ -0,0 +1,102 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package osv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd/cvss"
+)
+
+// weights of the CVSS v3 base metrics
+// see https://www.first.org/cvss/v3.1/specification-document#7-4-Metric-Values
+var cvss3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"PR": {"N": 0.85, "L": 0.62, "H": 0.27},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// newCvss converts the CVSS v3 vector of an OSV entry, which does not include
+// the score, into the score/vector notation of MVD
+func newCvss(vector string) (*cvss.Cvss, error) {
+	score, err := cvss3BaseScore(vector)
+	if err != nil {
+		return nil, err
+	}
+	return cvss.New(fmt.Sprintf("%.1f/%s", score, vector))
+}
+
+// cvss3BaseScore calculates the base score of a CVSS v3 vector
+// see https://www.first.org/cvss/v3.1/specification-document#7-1-Base-Metrics-Equations
+func cvss3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(vector), "/")
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return 0, errors.New("unsupported cvss vector: " + vector)
+	}
+
+	metrics := map[string]string{}
+	for _, part := range parts[1:] {
+		key, value, ok := strings.Cut(part, ":")
+		if ok {
+			metrics[key] = value
+		}
+	}
+
+	values := map[string]float64{}
+	for metric, weights := range cvss3Weights {
+		weight, ok := weights[metrics[metric]]
+		if !ok {
+			return 0, errors.New("invalid cvss vector: " + vector)
+		}
+		values[metric] = weight
+	}
+
+	changed := false
+	switch metrics["S"] {
+	case "U":
+	case "C":
+		changed = true
+		// privileges matter more if the scope changes
+		switch metrics["PR"] {
+		case "L":
+			values["PR"] = 0.68
+		case "H":
+			values["PR"] = 0.5
+		}
+	default:
+		return 0, errors.New("invalid cvss vector: " + vector)
+	}
+
+	iss := 1 - (1-values["C"])*(1-values["I"])*(1-values["A"])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	exploitability := 8.22 * values["AV"] * values["AC"] * values["PR"] * values["UI"]
+
+	if impact <= 0 {
+		return 0, nil
+	}
+	if changed {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// roundUp returns the smallest number with one decimal that is equal or
+// higher than the input, see Appendix A of the CVSS v3.1 specification
+func roundUp(f float64) float64 {
+	i := int64(math.Round(f * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
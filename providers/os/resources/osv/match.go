@@ -0,0 +1,163 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package osv
+
+import (
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd"
+	"go.mondoo.com/cnquery/v11/utils/versions/generic"
+)
+
+// Ecosystem returns the OSV ecosystem of the platform and the package format
+// that is used to compare its versions. It returns an empty ecosystem if the
+// platform is not covered by OSV.
+func Ecosystem(platform *mvd.Platform) (string, string) {
+	if platform == nil {
+		return "", ""
+	}
+
+	release := strings.Split(platform.Release, ".")
+	major := release[0]
+	minor := major
+	if len(release) > 1 {
+		minor = release[0] + "." + release[1]
+	}
+
+	switch platform.Name {
+	case "debian":
+		return "Debian:" + major, "deb"
+	case "ubuntu":
+		return "Ubuntu:" + minor, "deb"
+	case "alpine":
+		return "Alpine:v" + minor, "apk"
+	case "wolfi":
+		return "Wolfi", "apk"
+	case "chainguard":
+		return "Chainguard", "apk"
+	case "rockylinux":
+		return "Rocky Linux:" + major, "rpm"
+	case "almalinux":
+		return "AlmaLinux:" + major, "rpm"
+	case "redhat":
+		return "Red Hat:enterprise_linux:" + major, "rpm"
+	case "opensuse-leap":
+		return "openSUSE:Leap " + minor, "rpm"
+	default:
+		return "", ""
+	}
+}
+
+// matchEcosystem checks if the ecosystem of an OSV entry belongs to the given
+// ecosystem. OSV adds details to some ecosystems, e.g. Ubuntu:22.04:LTS or
+// Red Hat:enterprise_linux:9::appstream
+func matchEcosystem(ecosystem string, want string) bool {
+	return ecosystem == want || strings.HasPrefix(ecosystem, want+":")
+}
+
+// affects checks if the version is affected and returns the version that
+// fixes it, if one is known
+func (a *Affected) affects(version string, format string) (bool, string) {
+	for _, v := range a.Versions {
+		if v == version {
+			return true, ""
+		}
+	}
+
+	for i := range a.Ranges {
+		r := a.Ranges[i]
+		rangeFormat := format
+		switch r.Type {
+		case RangeTypeEcosystem:
+		case RangeTypeSemver:
+			rangeFormat = "semver"
+		default:
+			// git ranges refer to commits and cannot be matched to package versions
+			continue
+		}
+
+		affected, fixed, err := r.affects(version, rangeFormat)
+		if err == nil && affected {
+			return true, fixed
+		}
+	}
+	return false, ""
+}
+
+// affects evaluates the events of a range as described in
+// https://ossf.github.io/osv-schema/#evaluation
+func (r *Range) affects(version string, format string) (bool, string, error) {
+	var cmpErr error
+	cmp := func(a, b string) int {
+		res, err := generic.Compare(format, a, b)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return res
+	}
+
+	// limit events only restrict git ranges
+	events := make([]Event, 0, len(r.Events))
+	for _, e := range r.Events {
+		if e.version() != "" {
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[j].Introduced == "0" {
+			return false
+		}
+		if events[i].Introduced == "0" {
+			return true
+		}
+		return cmp(events[i].version(), events[j].version()) < 0
+	})
+	if cmpErr != nil {
+		return false, "", cmpErr
+	}
+
+	affected := false
+	for _, e := range events {
+		switch {
+		case e.Introduced != "":
+			if e.Introduced == "0" || cmp(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if cmp(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if cmp(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		}
+	}
+	if cmpErr != nil {
+		return false, "", cmpErr
+	}
+	if !affected {
+		return false, "", nil
+	}
+
+	// the next fix after the installed version resolves the vulnerability
+	for _, e := range events {
+		if e.Fixed != "" && cmp(version, e.Fixed) < 0 {
+			return true, e.Fixed, nil
+		}
+	}
+	return true, "", nil
+}
+
+func (e Event) version() string {
+	switch {
+	case e.Introduced != "":
+		return e.Introduced
+	case e.Fixed != "":
+		return e.Fixed
+	default:
+		return e.LastAffected
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package osv matches packages against a local database in the Open Source
+// Vulnerability format, see https://ossf.github.io/osv-schema/
+package osv
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Vulnerability is an entry of the OSV database
+type Vulnerability struct {
+	ID         string      `json:"id"`
+	Summary    string      `json:"summary,omitempty"`
+	Details    string      `json:"details,omitempty"`
+	Aliases    []string    `json:"aliases,omitempty"`
+	Upstream   []string    `json:"upstream,omitempty"`
+	Published  string      `json:"published,omitempty"`
+	Modified   string      `json:"modified,omitempty"`
+	Withdrawn  string      `json:"withdrawn,omitempty"`
+	Severity   []Severity  `json:"severity,omitempty"`
+	Affected   []Affected  `json:"affected,omitempty"`
+	References []Reference `json:"references,omitempty"`
+}
+
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected lists the affected versions of a package
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Purl      string `json:"purl,omitempty"`
+}
+
+const (
+	RangeTypeEcosystem = "ECOSYSTEM"
+	RangeTypeSemver    = "SEMVER"
+	RangeTypeGit       = "GIT"
+)
+
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event of a range, only one of the fields is set
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+type Reference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// walkDatabase calls fn for every vulnerability of the database. The database
+// is either a single JSON file, a zip file as published on
+// https://osv-vulnerabilities.storage.googleapis.com/ or a directory of both.
+func walkDatabase(path string, fn func(*Vulnerability) error) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("cannot open OSV database: %w", err)
+	}
+
+	if !stat.IsDir() {
+		return walkFile(path, fn)
+	}
+
+	return filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		return walkFile(path, fn)
+	})
+}
+
+func walkFile(path string, fn func(*Vulnerability) error) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".zip":
+		return walkZip(path, fn)
+	case ".json":
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return decode(path, f, fn)
+	default:
+		// other files like checksums are ignored
+		return nil
+	}
+}
+
+func walkZip(path string, fn func(*Vulnerability) error) error {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("cannot open OSV database %s: %w", path, err)
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || strings.ToLower(filepath.Ext(f.Name)) != ".json" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = decode(path+"/"+f.Name, rc, fn)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decode(name string, r io.Reader, fn func(*Vulnerability) error) error {
+	vuln := &Vulnerability{}
+	if err := json.NewDecoder(r).Decode(vuln); err != nil {
+		return fmt.Errorf("cannot parse OSV entry %s: %w", name, err)
+	}
+	if vuln.Withdrawn != "" {
+		return nil
+	}
+	return fn(vuln)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package osv
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd"
+)
+
+func TestEcosystem(t *testing.T) {
+	tests := []struct {
+		platform  *mvd.Platform
+		ecosystem string
+		format    string
+	}{
+		{&mvd.Platform{Name: "debian", Release: "12.5"}, "Debian:12", "deb"},
+		{&mvd.Platform{Name: "ubuntu", Release: "22.04"}, "Ubuntu:22.04", "deb"},
+		{&mvd.Platform{Name: "alpine", Release: "3.19.1"}, "Alpine:v3.19", "apk"},
+		{&mvd.Platform{Name: "rockylinux", Release: "9.3"}, "Rocky Linux:9", "rpm"},
+		{&mvd.Platform{Name: "redhat", Release: "9.3"}, "Red Hat:enterprise_linux:9", "rpm"},
+		{&mvd.Platform{Name: "windows", Release: "10"}, "", ""},
+		{nil, "", ""},
+	}
+	for _, test := range tests {
+		ecosystem, format := Ecosystem(test.platform)
+		assert.Equal(t, test.ecosystem, ecosystem)
+		assert.Equal(t, test.format, format)
+	}
+
+	assert.True(t, matchEcosystem("Ubuntu:22.04:LTS", "Ubuntu:22.04"))
+	assert.True(t, matchEcosystem("Red Hat:enterprise_linux:9::appstream", "Red Hat:enterprise_linux:9"))
+	assert.False(t, matchEcosystem("Debian:11", "Debian:1"))
+	assert.False(t, matchEcosystem("Ubuntu:Pro:22.04:LTS", "Ubuntu:22.04"))
+}
+
+func TestRangeAffects(t *testing.T) {
+	r := Range{
+		Type: RangeTypeEcosystem,
+		Events: []Event{
+			{Fixed: "1.2.0-r1"},
+			{Introduced: "0"},
+			{Introduced: "2.0.0-r0"},
+			{LastAffected: "2.1.0-r0"},
+		},
+	}
+	tests := []struct {
+		version  string
+		affected bool
+		fixed    string
+	}{
+		{"1.0.0-r0", true, "1.2.0-r1"},
+		{"1.2.0-r0", true, "1.2.0-r1"},
+		{"1.2.0-r1", false, ""},
+		{"1.9.0-r0", false, ""},
+		{"2.0.0-r0", true, ""},
+		{"2.1.0-r0", true, ""},
+		{"2.1.0-r1", false, ""},
+	}
+	for _, test := range tests {
+		affected, fixed, err := r.affects(test.version, "apk")
+		require.NoError(t, err)
+		assert.Equal(t, test.affected, affected, test.version)
+		assert.Equal(t, test.fixed, fixed, test.version)
+	}
+
+	semver := Affected{Ranges: []Range{{
+		Type:   RangeTypeSemver,
+		Events: []Event{{Introduced: "1.0.0"}, {Fixed: "1.4.2"}, {Limit: "*"}},
+	}}}
+	affected, fixed := semver.affects("1.4.1", "deb")
+	assert.True(t, affected)
+	assert.Equal(t, "1.4.2", fixed)
+	affected, _ = semver.affects("0.9.0", "deb")
+	assert.False(t, affected)
+
+	git := Affected{Ranges: []Range{{
+		Type:   RangeTypeGit,
+		Events: []Event{{Introduced: "0"}},
+	}}}
+	affected, _ = git.affects("1.0.0", "deb")
+	assert.False(t, affected)
+}
+
+func TestCvss3BaseScore(t *testing.T) {
+	tests := []struct {
+		vector string
+		score  float64
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N", 7.5},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N", 6.4},
+		{"CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N", 5.5},
+		{"CVSS:3.1/AV:N/AC:H/PR:N/UI:R/S:C/C:L/I:N/A:N/E:P", 3.4},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0},
+	}
+	for _, test := range tests {
+		score, err := cvss3BaseScore(test.vector)
+		require.NoError(t, err, test.vector)
+		assert.Equal(t, test.score, score, test.vector)
+	}
+
+	_, err := cvss3BaseScore("AV:N/AC:L/Au:N/C:P/I:P/A:P")
+	assert.Error(t, err)
+	_, err = cvss3BaseScore("CVSS:3.1/AV:N/AC:L")
+	assert.Error(t, err)
+
+	c, err := newCvss("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	require.NoError(t, err)
+	assert.Equal(t, float32(9.8), c.Score)
+	assert.Equal(t, "9.8/CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", c.Vector)
+}
+
+func testRequest() *mvd.AnalyseAssetRequest {
+	return &mvd.AnalyseAssetRequest{
+		Platform: &mvd.Platform{Name: "debian", Release: "12.5", Arch: "x86_64"},
+		Packages: []*mvd.Package{
+			{Name: "openssl", Version: "3.0.11-1~deb12u1", Arch: "amd64", Format: "deb"},
+			{Name: "libssl3", Version: "3.0.11-1~deb12u1", Arch: "amd64", Format: "deb", Origin: "openssl"},
+			{Name: "curl", Version: "7.88.1-10+deb12u5", Arch: "amd64", Format: "deb"},
+			{Name: "zlib1g", Version: "1:1.2.13.dfsg-1", Arch: "amd64", Format: "deb", Origin: "zlib (1.2.13.dfsg-1)"},
+			{Name: "bash", Version: "5.2.15-2+b2", Arch: "amd64", Format: "deb"},
+		},
+	}
+}
+
+func assertDebianReport(t *testing.T, report *mvd.VulnReport) {
+	require.Len(t, report.Advisories, 2)
+
+	dsa := report.Advisories[0]
+	assert.Equal(t, "DSA-5532-1", dsa.ID)
+	assert.Equal(t, "openssl - security update", dsa.Title)
+	assert.Equal(t, int32(75), dsa.Score)
+	assert.Equal(t, "2023-10-24T00:00:00Z", dsa.Published)
+	require.Len(t, dsa.Affected, 2)
+	assert.Equal(t, "openssl", dsa.Affected[0].Name)
+	assert.Equal(t, "libssl3", dsa.Affected[1].Name)
+	assert.Equal(t, "3.0.11-1~deb12u2", dsa.Affected[1].Available)
+	require.Len(t, dsa.Fixed, 2)
+	assert.Equal(t, "3.0.11-1~deb12u2", dsa.Fixed[0].Version)
+	require.Len(t, dsa.Cves, 1)
+	assert.Equal(t, "CVE-2023-5363", dsa.Cves[0].ID)
+	assert.Equal(t, float32(7.5), dsa.Cves[0].WorstScore.Score)
+	require.Len(t, dsa.Refs, 1)
+	assert.Equal(t, "https://www.debian.org/security/2023/dsa-5532", dsa.Refs[0].Url)
+
+	zlib := report.Advisories[1]
+	assert.Equal(t, "CVE-2023-45853", zlib.ID)
+	assert.Equal(t, "CVE-2023-45853", zlib.Title)
+	assert.True(t, zlib.Unscored)
+	assert.Nil(t, zlib.WorstScore)
+	require.Len(t, zlib.Affected, 1)
+	assert.Equal(t, "zlib1g", zlib.Affected[0].Name)
+	assert.Empty(t, zlib.Fixed)
+	require.Len(t, zlib.Cves, 1)
+	assert.Equal(t, "MiniZip in zlib through 1.3 has an integer overflow.", zlib.Cves[0].Summary)
+
+	require.Len(t, report.Packages, 5)
+	affected := map[string]bool{}
+	for _, pkg := range report.Packages {
+		affected[pkg.Name] = pkg.Affected
+	}
+	assert.Equal(t, map[string]bool{
+		"openssl": true,
+		"libssl3": true,
+		"curl":    false,
+		"zlib1g":  true,
+		"bash":    false,
+	}, affected)
+	assert.Equal(t, int32(75), report.Packages[1].Score)
+	assert.Equal(t, "3.0.11-1~deb12u2", report.Packages[1].Available)
+
+	assert.Equal(t, &mvd.ReportStats{
+		Score:      75,
+		Affected:   true,
+		Advisories: &mvd.ReportStatsAdvisories{Total: 2, High: 1, Unknown: 1},
+		Cves:       &mvd.ReportStatsCves{Total: 2, High: 1, Unknown: 1},
+		Packages:   &mvd.ReportStatsPackages{Total: 5, Affected: 3, High: 2, Unknown: 1},
+		Exploits:   &mvd.ReportStatsExploits{},
+	}, report.Stats)
+}
+
+func TestAnalyseAsset(t *testing.T) {
+	report, err := AnalyseAsset("./testdata/debian", testRequest())
+	require.NoError(t, err)
+	assertDebianReport(t, report)
+
+	t.Run("zip database", func(t *testing.T) {
+		dir := t.TempDir()
+		f, err := os.Create(filepath.Join(dir, "all.zip"))
+		require.NoError(t, err)
+		w := zip.NewWriter(f)
+		files, err := filepath.Glob("./testdata/debian/*.json")
+		require.NoError(t, err)
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			fw, err := w.Create(filepath.Base(file))
+			require.NoError(t, err)
+			_, err = fw.Write(data)
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+		require.NoError(t, f.Close())
+
+		report, err := AnalyseAsset(filepath.Join(dir, "all.zip"), testRequest())
+		require.NoError(t, err)
+		assertDebianReport(t, report)
+
+		// directories of zip files are supported too
+		report, err = AnalyseAsset(dir, testRequest())
+		require.NoError(t, err)
+		assertDebianReport(t, report)
+	})
+
+	t.Run("other release", func(t *testing.T) {
+		req := testRequest()
+		req.Platform.Release = "11.9"
+		report, err := AnalyseAsset("./testdata/debian", req)
+		require.NoError(t, err)
+		require.Len(t, report.Advisories, 1)
+		assert.Equal(t, "CVE-2022-3715", report.Advisories[0].ID)
+		assert.Equal(t, "bash", report.Advisories[0].Affected[0].Name)
+	})
+
+	t.Run("unsupported platform", func(t *testing.T) {
+		req := testRequest()
+		req.Platform.Name = "windows"
+		_, err := AnalyseAsset("./testdata/debian", req)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing database", func(t *testing.T) {
+		_, err := AnalyseAsset("./testdata/missing", testRequest())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package osv
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/mvd/cvss"
+	"go.mondoo.com/cnquery/v11/utils/versions/generic"
+)
+
+// AnalyseAsset matches the packages of the scan job against the OSV database
+// at the given path. It is the offline counterpart to the MVD advisory scanner
+// and returns the same report.
+func AnalyseAsset(path string, req *mvd.AnalyseAssetRequest) (*mvd.VulnReport, error) {
+	ecosystem, format := Ecosystem(req.Platform)
+	if ecosystem == "" {
+		name := ""
+		if req.Platform != nil {
+			name = req.Platform.Name
+		}
+		return nil, errors.New("platform '" + name + "' is not supported by the OSV database")
+	}
+
+	// OSV uses source package names for most distributions, so we look up
+	// packages by binary and source name
+	packages := make([]*mvd.Package, len(req.Packages))
+	byName := map[string][]int{}
+	for i := range req.Packages {
+		pkg := req.Packages[i]
+		packages[i] = &mvd.Package{
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			Arch:    pkg.Arch,
+			Format:  pkg.Format,
+			Origin:  pkg.Origin,
+		}
+		byName[pkg.Name] = append(byName[pkg.Name], i)
+		if source := sourceName(pkg.Origin); source != "" && source != pkg.Name {
+			byName[source] = append(byName[source], i)
+		}
+	}
+
+	advisories := []*mvd.Advisory{}
+	err := walkDatabase(path, func(vuln *Vulnerability) error {
+		advisory := matchVulnerability(vuln, ecosystem, format, packages, byName)
+		if advisory != nil {
+			advisories = append(advisories, advisory)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Str("ecosystem", ecosystem).Int("advisories", len(advisories)).Msg("osv> matched packages")
+
+	sort.SliceStable(advisories, func(i, j int) bool {
+		if advisories[i].Score == advisories[j].Score {
+			return advisories[i].ID < advisories[j].ID
+		}
+		return advisories[i].Score > advisories[j].Score
+	})
+
+	return &mvd.VulnReport{
+		Platform:   req.Platform,
+		Packages:   packages,
+		Advisories: advisories,
+		Stats:      mvd.NewReportStats(packages, advisories),
+	}, nil
+}
+
+// sourceName strips the version from a source package, e.g. for Debian
+// packages the source is given as "openssl (3.0.11-1~deb12u2)"
+func sourceName(origin string) string {
+	name, _, _ := strings.Cut(origin, " ")
+	return name
+}
+
+func matchVulnerability(vuln *Vulnerability, ecosystem string, format string, packages []*mvd.Package, byName map[string][]int) *mvd.Advisory {
+	var advisory *mvd.Advisory
+	matched := map[int]struct{}{}
+
+	for i := range vuln.Affected {
+		affected := &vuln.Affected[i]
+		if !matchEcosystem(affected.Package.Ecosystem, ecosystem) {
+			continue
+		}
+
+		for _, idx := range byName[affected.Package.Name] {
+			if _, ok := matched[idx]; ok {
+				continue
+			}
+			pkg := packages[idx]
+			ok, fixed := affected.affects(pkg.Version, format)
+			if !ok {
+				continue
+			}
+			matched[idx] = struct{}{}
+
+			if advisory == nil {
+				advisory = newAdvisory(vuln)
+			}
+			advisory.Affected = append(advisory.Affected, &mvd.Package{
+				Name:      pkg.Name,
+				Version:   pkg.Version,
+				Arch:      pkg.Arch,
+				Format:    pkg.Format,
+				Origin:    pkg.Origin,
+				Available: fixed,
+				Affected:  true,
+				Score:     advisory.Score,
+			})
+			if fixed != "" {
+				advisory.Fixed = append(advisory.Fixed, &mvd.Package{
+					Name:    pkg.Name,
+					Version: fixed,
+					Arch:    pkg.Arch,
+					Format:  pkg.Format,
+				})
+			}
+			updatePackage(pkg, advisory.Score, fixed, format)
+		}
+	}
+
+	return advisory
+}
+
+// updatePackage marks the package as affected, it keeps the worst score and
+// the version that fixes all vulnerabilities
+func updatePackage(pkg *mvd.Package, score int32, fixed string, format string) {
+	pkg.Affected = true
+	if score > pkg.Score {
+		pkg.Score = score
+	}
+	if fixed == "" {
+		return
+	}
+	if pkg.Available == "" {
+		pkg.Available = fixed
+	} else if cmp, err := generic.Compare(format, fixed, pkg.Available); err == nil && cmp > 0 {
+		pkg.Available = fixed
+	}
+}
+
+func newAdvisory(vuln *Vulnerability) *mvd.Advisory {
+	title := vuln.Summary
+	if title == "" {
+		title = vuln.ID
+	}
+
+	scores := []*cvss.Cvss{}
+	for _, severity := range vuln.Severity {
+		if severity.Type != "CVSS_V3" {
+			continue
+		}
+		score, err := newCvss(severity.Score)
+		if err != nil {
+			log.Debug().Err(err).Str("id", vuln.ID).Msg("osv> ignore cvss score")
+			continue
+		}
+		scores = append(scores, score)
+	}
+
+	var worstScore *cvss.Cvss
+	if len(scores) > 0 {
+		worstScore, _ = cvss.MaxScore(scores)
+	}
+
+	advisory := &mvd.Advisory{
+		ID:          vuln.ID,
+		Title:       title,
+		Description: vuln.Details,
+		Published:   vuln.Published,
+		Modified:    vuln.Modified,
+		WorstScore:  worstScore,
+		Unscored:    worstScore == nil,
+	}
+	if worstScore != nil {
+		advisory.Score = int32(math.Round(float64(worstScore.Score) * 10))
+	}
+
+	for _, ref := range vuln.References {
+		advisory.Refs = append(advisory.Refs, &mvd.Reference{
+			Url:    ref.URL,
+			Source: ref.Type,
+		})
+	}
+
+	summary := vuln.Summary
+	if summary == "" {
+		summary = vuln.Details
+	}
+	seen := map[string]struct{}{}
+	ids := append([]string{vuln.ID}, vuln.Aliases...)
+	ids = append(ids, vuln.Upstream...)
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || !strings.HasPrefix(id, "CVE-") {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		cve := &mvd.CVE{
+			ID:         id,
+			Summary:    summary,
+			Published:  vuln.Published,
+			Modified:   vuln.Modified,
+			WorstScore: worstScore,
+			Unscored:   worstScore == nil,
+			Cvss:       scores,
+			Url:        "https://nvd.nist.gov/vuln/detail/" + id,
+		}
+		if worstScore != nil {
+			cve.Score = worstScore.Score
+		}
+		advisory.Cves = append(advisory.Cves, cve)
+	}
+
+	return advisory
+}
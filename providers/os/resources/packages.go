@@ -12,6 +12,7 @@ import (
 	"go.mondoo.com/cnquery/v11/llx"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/tar"
 	"go.mondoo.com/cnquery/v11/providers/os/resources/packages"
 	"go.mondoo.com/cnquery/v11/types"
 	"go.mondoo.com/cnquery/v11/utils/multierr"
@@ -74,6 +75,7 @@ func initPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 	res.Arch.State = plugin.StateIsSet | plugin.StateIsNull
 	res.Format.State = plugin.StateIsSet | plugin.StateIsNull
 	res.Origin.State = plugin.StateIsSet | plugin.StateIsNull
+	res.License.State = plugin.StateIsSet | plugin.StateIsNull
 	res.Status.State = plugin.StateIsSet | plugin.StateIsNull
 	res.Files.State = plugin.StateIsSet | plugin.StateIsNull
 	return nil, res, nil
@@ -94,27 +96,50 @@ func (p *mqlPackage) origin() (string, error) {
 	return "", nil
 }
 
-func (p *mqlPackage) files() ([]interface{}, error) {
+func (p *mqlPackage) license() (string, error) {
+	// the license was not included in the package list, some package managers
+	// allow us to retrieve it on-demand
+	conn := p.MqlRuntime.Connection.(shared.Connection)
+	pm, err := packages.ResolveSystemPkgManager(conn)
+	if pm == nil || err != nil {
+		return "", errors.New("could not detect suitable package manager for platform")
+	}
+	lpm, ok := pm.(packages.OperatingSystemPkgLicenses)
+	if !ok {
+		return "", nil
+	}
+	return lpm.License(p.Name.Data, p.Version.Data, p.Arch.Data)
+}
+
+// fileRecords returns the files of the package, they are retrieved from the
+// package manager if they were not included in the package list
+func (p *mqlPackage) fileRecords() ([]packages.FileRecord, error) {
 	if p.filesState == packages.PkgFilesNotAvailable {
 		return nil, nil
 	}
 
-	var filesOnDisk []packages.FileRecord
-
 	if p.filesState == packages.PkgFilesIncluded {
 		// we already have the data
-		filesOnDisk = p.filesOnDisks
-	} else {
-		// we need to retrieve the data on-demand
-		conn := p.MqlRuntime.Connection.(shared.Connection)
-		pm, err := packages.ResolveSystemPkgManager(conn)
-		if pm == nil || err != nil {
-			return nil, errors.New("could not detect suitable package manager for platform")
-		}
-		filesOnDisk, err = pm.Files(p.Name.Data, p.Version.Data, p.Arch.Data)
-		if err != nil {
-			return nil, err
-		}
+		return p.filesOnDisks, nil
+	}
+
+	// we need to retrieve the data on-demand
+	conn := p.MqlRuntime.Connection.(shared.Connection)
+	pm, err := packages.ResolveSystemPkgManager(conn)
+	if pm == nil || err != nil {
+		return nil, errors.New("could not detect suitable package manager for platform")
+	}
+	return pm.Files(p.Name.Data, p.Version.Data, p.Arch.Data)
+}
+
+func (p *mqlPackage) files() ([]interface{}, error) {
+	if p.filesState == packages.PkgFilesNotAvailable {
+		return nil, nil
+	}
+
+	filesOnDisk, err := p.fileRecords()
+	if err != nil {
+		return nil, err
 	}
 
 	var pkgFiles []interface{}
@@ -130,6 +155,41 @@ func (p *mqlPackage) files() ([]interface{}, error) {
 	return pkgFiles, nil
 }
 
+func (p *mqlPackage) layer() (*mqlContainerImageLayer, error) {
+	conn, ok := p.MqlRuntime.Connection.(*tar.Connection)
+	if !ok {
+		p.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	var paths []string
+	if p.Format.Data == packages.DpkgPkgFormat {
+		// dpkg writes the file list of a package whenever it is installed or
+		// upgraded, which makes it the best evidence for the layer
+		paths = []string{"/var/lib/dpkg/info/" + p.Name.Data + ".list"}
+		if p.Arch.Data != "" {
+			paths = append(paths, "/var/lib/dpkg/info/"+p.Name.Data+":"+p.Arch.Data+".list")
+		}
+	} else {
+		files, err := p.fileRecords()
+		if err != nil {
+			return nil, err
+		}
+		for i := range files {
+			paths = append(paths, tar.Abs(files[i].Path))
+		}
+	}
+
+	layer, err := newMqlContainerImageLayer(p.MqlRuntime, conn.FilesLayer(paths))
+	if err != nil {
+		return nil, err
+	}
+	if layer == nil {
+		p.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+	}
+	return layer, nil
+}
+
 type mqlPackagesInternal struct {
 	lock           sync.Mutex
 	packagesByName map[string]*mqlPackage
@@ -188,7 +248,7 @@ func (x *mqlPackages) list() ([]interface{}, error) {
 			cpes = append(cpes, cpe)
 		}
 
-		pkg, err := CreateResource(x.MqlRuntime, "package", map[string]*llx.RawData{
+		args := map[string]*llx.RawData{
 			"name":        llx.StringData(osPkg.Name),
 			"version":     llx.StringData(osPkg.Version),
 			"available":   llx.StringData(available),
@@ -201,7 +261,12 @@ func (x *mqlPackages) list() ([]interface{}, error) {
 			"epoch":       llx.StringData(osPkg.Epoch),
 			"purl":        llx.StringData(osPkg.PUrl),
 			"cpes":        llx.ArrayData(cpes, types.Resource("cpe")),
-		})
+		}
+		if osPkg.License != "" {
+			args["license"] = llx.StringData(osPkg.License)
+		}
+
+		pkg, err := CreateResource(x.MqlRuntime, "package", args)
 		if err != nil {
 			return nil, err
 		}
@@ -44,6 +44,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "musl",
 		PUrl:           "pkg:apk/alpine/musl@1510953106%3A1.1.18-r2?arch=x86_64&distro=alpine-3.7.0&epoch=1510953106",
 		CPE:            "cpe:2.3:a:musl:musl:1510953106:x86_64:*:*:*:*:x86_64:*",
+		License:        "MIT",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
@@ -66,6 +67,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "libressl",
 		PUrl:           "pkg:apk/alpine/libressl2.6-libcrypto@1510257703%3A2.6.3-r0?arch=x86_64&distro=alpine-3.7.0&epoch=1510257703",
 		CPE:            "cpe:2.3:a:libressl2.6-libcrypto:libressl2.6-libcrypto:1510257703:x86_64:*:*:*:*:x86_64:*",
+		License:        "custom",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
@@ -103,6 +105,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "libressl",
 		PUrl:           "pkg:apk/alpine/libressl2.6-libssl@1510257703%3A2.6.3-r0?arch=x86_64&distro=alpine-3.7.0&epoch=1510257703",
 		CPE:            "cpe:2.3:a:libressl2.6-libssl:libressl2.6-libssl:1510257703:x86_64:*:*:*:*:x86_64:*",
+		License:        "custom",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
@@ -131,6 +134,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "apk-tools",
 		PUrl:           "pkg:apk/alpine/apk-tools@1515485577%3A2.8.2-r0?arch=x86_64&distro=alpine-3.7.0&epoch=1515485577",
 		CPE:            "cpe:2.3:a:apk-tools:apk-tools:1515485577:x86_64:*:*:*:*:x86_64:*",
+		License:        "GPL2",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
@@ -150,6 +154,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "busybox",
 		PUrl:           "pkg:apk/alpine/busybox@1513075346%3A1.27.2-r7?arch=x86_64&distro=alpine-3.7.0&epoch=1513075346",
 		CPE:            "cpe:2.3:a:busybox:busybox:1513075346:x86_64:*:*:*:*:x86_64:*",
+		License:        "GPL2",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
@@ -184,6 +189,7 @@ func TestAlpineApkdbParser(t *testing.T) {
 		Origin:         "alpine-baselayout",
 		PUrl:           "pkg:apk/alpine/alpine-baselayout@1510075862%3A3.0.5-r2?arch=x86_64&distro=alpine-3.7.0&epoch=1510075862",
 		CPE:            "cpe:2.3:a:alpine-baselayout:alpine-baselayout:1510075862:x86_64:*:*:*:*:x86_64:*",
+		License:        "GPL2",
 		Format:         AlpinePkgFormat,
 		FilesAvailable: PkgFilesIncluded,
 		Files: []FileRecord{
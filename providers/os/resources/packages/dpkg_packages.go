@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/package-url/packageurl-go"
@@ -247,3 +248,61 @@ func (dpm *DebPkgManager) Files(name string, version string, arch string) ([]Fil
 
 	return fileRecords, nil
 }
+
+// License returns the license of a package from its machine-readable copyright
+// file, see https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+func (dpm *DebPkgManager) License(name string, version string, arch string) (string, error) {
+	fs := dpm.conn.FileSystem()
+	f, err := fs.Open("/usr/share/doc/" + name + "/copyright")
+	if err != nil {
+		// not every package ships a copyright file
+		log.Debug().Err(err).Str("package", name).Msg("mql[packages]> could not read package copyright")
+		return "", nil
+	}
+	defer f.Close()
+	return ParseDpkgCopyright(f)
+}
+
+// ParseDpkgCopyright returns the licenses of a machine-readable debian copyright
+// file. Multiple licenses are combined into a single license expression. Files
+// that do not follow the machine-readable format do not have any license.
+func ParseDpkgCopyright(input io.Reader) (string, error) {
+	licenses := []string{}
+	machineReadable := false
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "Format:") {
+			machineReadable = true
+			continue
+		}
+		license, ok := strings.CutPrefix(line, "License:")
+		if !ok {
+			continue
+		}
+		license = strings.TrimSpace(license)
+		if license == "" {
+			continue
+		}
+
+		// debian uses lowercase operators
+		license = strings.ReplaceAll(license, " or ", " OR ")
+		license = strings.ReplaceAll(license, " and ", " AND ")
+		if strings.Contains(license, " ") {
+			license = "(" + license + ")"
+		}
+		if !slices.Contains(licenses, license) {
+			licenses = append(licenses, license)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if !machineReadable {
+		return "", nil
+	}
+	if len(licenses) == 1 {
+		return strings.TrimSuffix(strings.TrimPrefix(licenses[0], "("), ")"), nil
+	}
+	return strings.Join(licenses, " AND "), nil
+}
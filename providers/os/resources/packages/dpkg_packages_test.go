@@ -4,6 +4,7 @@
 package packages
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,6 +103,36 @@ It was originally inspired by the Multics SubSystem library.`,
 	assert.Equal(t, 11, len(pkgFiles), "detected the right amount of package files")
 	assert.Contains(t, pkgFiles, FileRecord{Path: "/lib/aarch64-linux-gnu/libss.so.2.0"})
 	assert.Contains(t, pkgFiles, FileRecord{Path: "/lib/aarch64-linux-gnu/libss.so.2"})
+
+	// fetch package license
+	license, err := mgr.License(p.Name, p.Version, p.Arch)
+	require.NoError(t, err)
+	assert.Equal(t, "GPL-2 AND MIT-US-export AND (BSD-3-Clause OR GPL-2)", license)
+
+	// packages without copyright file have no license
+	license, err = mgr.License("libaudit-common", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "", license)
+}
+
+func TestDpkgCopyrightParser(t *testing.T) {
+	license, err := ParseDpkgCopyright(strings.NewReader(`Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+Upstream-Name: zlib
+
+Files: *
+Copyright: 1995-2013 Jean-loup Gailly and Mark Adler
+License: Zlib
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "Zlib", license)
+
+	// copyright files that are not machine-readable are ignored
+	license, err = ParseDpkgCopyright(strings.NewReader(`This is the Debian prepackaged version of the zlib library.
+
+License: see below
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "", license)
 }
 
 func TestDpkgParserStatusD(t *testing.T) {
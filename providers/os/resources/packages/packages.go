@@ -5,6 +5,7 @@ package packages
 
 import (
 	"github.com/cockroachdb/errors"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/sbom"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
 )
 
@@ -42,6 +43,9 @@ type Package struct {
 	// Package CPE
 	CPE string `json:"cpe,omitempty"`
 
+	// Package license (optional, only for some package managers)
+	License string `json:"license,omitempty"`
+
 	// Package files (optional, only for some package managers)
 	FilesAvailable PkgFilesAvailable `json:"files_available,omitempty"`
 	Files          []FileRecord      `json:"files,omitempty"`
@@ -85,6 +89,13 @@ type OperatingSystemPkgManager interface {
 	Files(name string, version string, arch string) ([]FileRecord, error)
 }
 
+// OperatingSystemPkgLicenses is implemented by package managers that do not
+// include the license in the package list, the license is looked up on-demand
+type OperatingSystemPkgLicenses interface {
+	// License returns the license of a given package
+	License(name string, version string, arch string) (string, error)
+}
+
 // this will find the right package manager for the operating system
 func ResolveSystemPkgManager(conn shared.Connection) (OperatingSystemPkgManager, error) {
 	var pm OperatingSystemPkgManager
@@ -95,6 +106,8 @@ func ResolveSystemPkgManager(conn shared.Connection) (OperatingSystemPkgManager,
 	}
 
 	switch {
+	case conn.Type() == shared.Type_Sbom: // packages of an imported sbom
+		pm = &SbomPkgManager{conn: conn.(*sbom.Connection)}
 	case asset.Platform.IsFamily("arch"): // arch family
 		pm = &PacmanPkgManager{conn: conn, platform: asset.Platform}
 	case asset.Platform.IsFamily("debian"): // debian family
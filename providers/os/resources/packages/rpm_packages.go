@@ -29,9 +29,14 @@ const (
 	RpmPkgFormat = "rpm"
 )
 
-var RPM_REGEX = regexp.MustCompile(`^([\w-+]*)\s(\d*|\(none\)):([\w\d-+.:]+)\s([\w\d]*|\(none\))\s(.*)$`)
+// RPM_REGEX matches the package lines of the queryformat. The license is
+// optional and separated by a tab, since both the license and the summary
+// may contain spaces.
+var RPM_REGEX = regexp.MustCompile(`^([\w-+]*)\s(\d*|\(none\)):([\w\d-+.:]+)\s([\w\d]*|\(none\))\s(?:([^\t]*)\t)?(.*)$`)
 
 // ParseRpmPackages parses output from:
+// rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{LICENSE}\t%{SUMMARY}\n'
+// The license may be omitted, e.g.
 // rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\n'
 func ParseRpmPackages(pf *inventory.Platform, input io.Reader) []Package {
 	pkgs := []Package{}
@@ -57,7 +62,10 @@ func ParseRpmPackages(pf *inventory.Platform, input io.Reader) []Package {
 			if arch == "(none)" {
 				arch = ""
 			}
-			pkg := newRpmPackage(pf, name, version, arch, epoch, m[5])
+			pkg := newRpmPackage(pf, name, version, arch, epoch, m[6])
+			if license := strings.TrimSpace(m[5]); license != "(none)" {
+				pkg.License = license
+			}
 			pkg.FilesAvailable = PkgFilesAsync // when we use commands we need to fetch the files async
 			pkgs = append(pkgs, pkg)
 
@@ -153,14 +161,14 @@ func (rpm *RpmPkgManager) queryFormat() string {
 	// this format should work everywhere
 	// fall-back to epoch instead of epochnum for 6 ish platforms, latest 6 platforms also support epochnum, but we
 	// save 1 call by not detecting the available keyword via rpm --querytags
-	format := "%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n"
+	format := "%{NAME} %{EPOCH}:%{VERSION}-%{RELEASE} %{ARCH} %{LICENSE}\\t%{SUMMARY}\\n"
 
 	// ATTENTION: EPOCHNUM is only available since later version of rpm in RedHat 6 and Suse 12
 	// we can only expect if for rhel 7+, therefore we need to run an extra test
 	// be aware that this method is also used for non-redhat systems like suse
 	i, err := strconv.ParseInt(rpm.platform.Version, 0, 32)
 	if err == nil && (rpm.platform.Name == "centos" || rpm.platform.Name == "redhat") && i >= 7 {
-		format = "%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{SUMMARY}\\n"
+		format = "%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{LICENSE}\\t%{SUMMARY}\\n"
 	}
 
 	return format
@@ -263,6 +271,7 @@ func (rpm *RpmPkgManager) staticList() ([]Package, error) {
 		}
 
 		rpmPkg := newRpmPackage(rpm.platform, pkg.Name, version, pkg.Arch, strconv.Itoa(pkg.EpochNum()), pkg.Summary)
+		rpmPkg.License = pkg.License
 
 		// determine all files attached
 		records := []FileRecord{}
@@ -127,6 +127,18 @@ func TestRedhat7Parser(t *testing.T) {
 	assert.Equal(t, 4, len(pkgFiles), "detected the right amount of package files")
 	assert.Contains(t, pkgFiles, FileRecord{Path: "/usr/bin/dnsdomainname"})
 	assert.Contains(t, pkgFiles, FileRecord{Path: "/usr/share/man/man1/ypdomainname.1.gz"})
+
+	// the license is part of the package list
+	c, err = mock.RunCommand("rpm -qa --queryformat '%{NAME} %{EPOCHNUM}:%{VERSION}-%{RELEASE} %{ARCH} %{LICENSE}\\t%{SUMMARY}\\n'")
+	require.NoError(t, err)
+	m = ParseRpmPackages(pf, c.Stdout)
+	require.Equal(t, 5, len(m))
+	assert.Equal(t, "GPLv2+", findPkg(m, "hostname").License)
+	assert.Equal(t, "Utility to set/show the host name or domain name", findPkg(m, "hostname").Description)
+	assert.Equal(t, "zlib and Boost", findPkg(m, "zlib").License)
+	assert.Equal(t, "Public Domain", findPkg(m, "tzdata").License)
+	assert.Equal(t, "pubkey", findPkg(m, "gpg-pubkey").License)
+	assert.Equal(t, "", findPkg(m, "gpg-pubkey").Arch)
 }
 
 func TestRedhat6Parser(t *testing.T) {
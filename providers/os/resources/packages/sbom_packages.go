@@ -0,0 +1,80 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package packages
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/sbom"
+)
+
+// package formats of the operating system packages by package url type
+var sbomPkgFormats = map[string]string{
+	packageurl.TypeDebian: DpkgPkgFormat,
+	packageurl.TypeRPM:    RpmPkgFormat,
+	packageurl.TypeApk:    AlpinePkgFormat,
+	// the sbom uses the package format as type for arch linux packages
+	PacmanPkgFormat: PacmanPkgFormat,
+}
+
+// SbomPkgManager lists the operating system packages of an imported SBOM
+type SbomPkgManager struct {
+	conn *sbom.Connection
+}
+
+func (spm *SbomPkgManager) Name() string {
+	return "SBOM Package Manager"
+}
+
+func (spm *SbomPkgManager) List() ([]Package, error) {
+	pkgs := []Package{}
+	for _, p := range spm.conn.Bom.Packages {
+		format, ok := sbomPkgFormats[p.Type]
+		if !ok {
+			continue
+		}
+
+		pkg := Package{
+			Name:        p.Name,
+			Version:     p.Version,
+			Epoch:       purlEpoch(p.Purl),
+			Arch:        p.Architecture,
+			Description: p.Description,
+			Format:      format,
+			PUrl:        p.Purl,
+			License:     strings.Join(p.Licenses, " AND "),
+		}
+		if len(p.Cpes) > 0 {
+			pkg.CPE = p.Cpes[0]
+		}
+		if locations := p.FileLocations(); len(locations) > 0 {
+			pkg.FilesAvailable = PkgFilesIncluded
+			for _, location := range locations {
+				pkg.Files = append(pkg.Files, FileRecord{Path: location})
+			}
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
+// purlEpoch returns the epoch qualifier of a package url
+func purlEpoch(purl string) string {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return ""
+	}
+	return p.Qualifiers.Map()["epoch"]
+}
+
+func (spm *SbomPkgManager) Available() (map[string]PackageUpdate, error) {
+	return nil, errors.New("cannot determine available packages from an sbom")
+}
+
+func (spm *SbomPkgManager) Files(name string, version string, arch string) ([]FileRecord, error) {
+	// all files are already included in the package list
+	return nil, nil
+}
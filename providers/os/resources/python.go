@@ -11,13 +11,17 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/package-url/packageurl-go"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/v11/llx"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/sbom"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/tar"
 	"go.mondoo.com/cnquery/v11/providers/os/resources/python"
+	cnquerysbom "go.mondoo.com/cnquery/v11/sbom"
 	"go.mondoo.com/cnquery/v11/types"
 )
 
@@ -135,6 +139,10 @@ func (r *mqlPython) getAllPackages() ([]python.PackageDetails, error) {
 	if !ok {
 		return nil, fmt.Errorf("provider is not an operating system provider")
 	}
+	if sbomConn, ok := conn.(*sbom.Connection); ok {
+		// all packages are taken from the imported sbom
+		return pythonPackagesFromSbom(sbomConn.Bom), nil
+	}
 	afs := &afero.Afero{Fs: conn.FileSystem()}
 
 	if r.Path.Error != nil {
@@ -164,6 +172,44 @@ func (r *mqlPython) getAllPackages() ([]python.PackageDetails, error) {
 	return allResults, nil
 }
 
+func pythonPackagesFromSbom(bom *cnquerysbom.Sbom) []python.PackageDetails {
+	pkgs := bom.PackagesByType(packageurl.TypePyPi)
+
+	// python dependencies are referenced by name
+	names := map[string]string{}
+	required := map[string]bool{}
+	for _, p := range pkgs {
+		names[p.Purl] = p.Name
+		for _, dep := range p.Dependencies {
+			required[dep] = true
+		}
+	}
+
+	res := []python.PackageDetails{}
+	for _, p := range pkgs {
+		ppd := python.PackageDetails{
+			Name:    p.Name,
+			Version: p.Version,
+			Summary: p.Description,
+			License: strings.Join(p.Licenses, " AND "),
+			Purl:    p.Purl,
+			Cpes:    p.Cpes,
+			// packages that no other package depends on were installed explicitly
+			IsLeaf: !required[p.Purl],
+		}
+		if locations := p.FileLocations(); len(locations) > 0 {
+			ppd.File = locations[0]
+		}
+		for _, dep := range p.Dependencies {
+			if name, ok := names[dep]; ok {
+				ppd.Dependencies = append(ppd.Dependencies, name)
+			}
+		}
+		res = append(res, ppd)
+	}
+	return res
+}
+
 func pythonPackageDetailsWithDependenciesToResource(
 	runtime *plugin.Runtime,
 	newPyPkgDetails python.PackageDetails,
@@ -451,8 +497,14 @@ func newMqlPythonPackage(runtime *plugin.Runtime, ppd python.PackageDetails, dep
 		cpes = append(cpes, cpe)
 	}
 
+	// packages from an sbom may not include their location
+	id := ppd.File
+	if id == "" {
+		id = ppd.Purl
+	}
+
 	r, err := CreateResource(runtime, "python.package", map[string]*llx.RawData{
-		"id":           llx.StringData(ppd.File),
+		"id":           llx.StringData(id),
 		"name":         llx.StringData(ppd.Name),
 		"version":      llx.StringData(ppd.Version),
 		"author":       llx.StringData(ppd.Author),
@@ -571,6 +623,25 @@ func (r *mqlPythonPackage) dependencies() ([]interface{}, error) {
 	return r.Dependencies.Data, nil
 }
 
+func (r *mqlPythonPackage) layer() (*mqlContainerImageLayer, error) {
+	conn, ok := r.MqlRuntime.Connection.(*tar.Connection)
+	file := r.GetFile()
+	if !ok || file.Data == nil {
+		r.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	// the metadata file is written whenever the package is installed or upgraded
+	layer, err := newMqlContainerImageLayer(r.MqlRuntime, conn.FileLayer(file.Data.Path.Data))
+	if err != nil {
+		return nil, err
+	}
+	if layer == nil {
+		r.Layer.State = plugin.StateIsSet | plugin.StateIsNull
+	}
+	return layer, nil
+}
+
 func (r *mqlPythonPackage) populateData() error {
 	file := r.GetFile()
 	if file.Error != nil {
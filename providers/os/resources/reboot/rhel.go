@@ -8,9 +8,9 @@ import (
 	"io"
 	"strings"
 
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/rpm"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
 	"go.mondoo.com/cnquery/v11/providers/os/resources/packages"
+	"go.mondoo.com/cnquery/v11/utils/versions/rpm"
 )
 
 // RpmNewestKernel works on all machines running rpm
@@ -0,0 +1,203 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+)
+
+// searchSkipDirs are never descended into while searching for packages
+var searchSkipDirs = map[string]struct{}{
+	"/dev":  {},
+	"/proc": {},
+	"/sys":  {},
+}
+
+// fileSearch describes the files that contain the packages of one ecosystem
+type fileSearch struct {
+	roots []string
+	// skipDirNames are directories that are not searched below any root
+	skipDirNames map[string]struct{}
+	// match is called for regular files below the roots
+	match func(afs *afero.Afero, path string, info os.FileInfo) bool
+}
+
+// in returns the same search below other roots
+func (s *fileSearch) in(roots ...string) *fileSearch {
+	res := *s
+	res.roots = roots
+	return &res
+}
+
+// covers returns true if path is below one of the roots. Directories are
+// also covered if they contain a root.
+func (s *fileSearch) covers(path string, isDir bool) bool {
+	for _, root := range s.roots {
+		if isBelow(path, root) || (isDir && isBelow(root, path)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBelow returns true if path is dir or inside of it
+func isBelow(path string, dir string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// searchRoots returns the roots of all searches, without the ones that are
+// inside of another root
+func searchRoots(searches []*fileSearch) []string {
+	all := []string{}
+	for _, s := range searches {
+		all = append(all, s.roots...)
+	}
+	slices.Sort(all)
+
+	res := []string{}
+	for _, root := range all {
+		if len(res) > 0 && isBelow(root, res[len(res)-1]) {
+			continue
+		}
+		res = append(res, root)
+	}
+	return res
+}
+
+// searchFiles walks the roots of all searches at once and returns the
+// sorted files that each search matched
+func searchFiles(afs *afero.Afero, searches ...*fileSearch) [][]string {
+	found := make([]map[string]struct{}, len(searches))
+	for i := range found {
+		found[i] = map[string]struct{}{}
+	}
+
+	for _, root := range searchRoots(searches) {
+		ok, _ := afs.Exists(root)
+		if !ok {
+			continue
+		}
+
+		// skipped is the directory below which a search ignores all files
+		skipped := make([]string, len(searches))
+		err := afs.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				// unreadable directories are skipped, everything else is still searched
+				log.Debug().Err(err).Str("path", path).Msg("could not search for packages")
+				return nil
+			}
+
+			if info.IsDir() {
+				if _, ok := searchSkipDirs[path]; ok {
+					return filepath.SkipDir
+				}
+				descend := false
+				for i, s := range searches {
+					if skipped[i] != "" && isBelow(path, skipped[i]) {
+						continue
+					}
+					skipped[i] = ""
+					if !s.covers(path, true) {
+						continue
+					}
+					if _, ok := s.skipDirNames[info.Name()]; ok {
+						skipped[i] = path
+						continue
+					}
+					descend = true
+				}
+				if !descend {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			for i, s := range searches {
+				if skipped[i] != "" && isBelow(path, skipped[i]) {
+					continue
+				}
+				if s.covers(path, false) && s.match(afs, path, info) {
+					found[i][path] = struct{}{}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Debug().Err(err).Str("path", root).Msg("could not search for packages")
+		}
+	}
+
+	res := make([][]string, len(searches))
+	for i := range found {
+		res[i] = make([]string, 0, len(found[i]))
+		for path := range found[i] {
+			res[i] = append(res[i], path)
+		}
+		slices.Sort(res[i])
+	}
+	return res
+}
+
+// defaultFileSearches run together below their default roots, so that all
+// package resources of an asset share one walk of the filesystem
+var defaultFileSearches = []*fileSearch{
+	javaSearch,
+	golangSearch,
+	rustSearch.files,
+	rubySearch.files,
+	phpSearch.files,
+	dotnetSearch.files,
+}
+
+type mqlPkgFileSearchInternal struct {
+	lock  sync.Mutex
+	found map[*fileSearch][]string
+}
+
+func (s *mqlPkgFileSearch) id() (string, error) {
+	return "pkgFileSearch", nil
+}
+
+// defaultSearchFiles returns the files that the search matched below its
+// default roots. The first call searches for the files of all
+// defaultFileSearches.
+func defaultSearchFiles(runtime *plugin.Runtime, search *fileSearch) ([]string, error) {
+	obj, err := CreateResource(runtime, "pkgFileSearch", map[string]*llx.RawData{})
+	if err != nil {
+		return nil, err
+	}
+	s := obj.(*mqlPkgFileSearch)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.found == nil {
+		conn := runtime.Connection.(shared.Connection)
+		found := searchFiles(&afero.Afero{Fs: conn.FileSystem()}, defaultFileSearches...)
+		s.found = make(map[*fileSearch][]string, len(defaultFileSearches))
+		for i := range defaultFileSearches {
+			s.found[defaultFileSearches[i]] = found[i]
+		}
+	}
+
+	files, ok := s.found[search]
+	if !ok {
+		return nil, errors.New("no default search for these packages")
+	}
+	return files, nil
+}
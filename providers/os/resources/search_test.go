@@ -0,0 +1,62 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFiles(t *testing.T) {
+	afs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	for _, path := range []string{
+		"/opt/app/lib/a.jar",
+		"/opt/app/Cargo.lock",
+		"/opt/app/node_modules/dep/Cargo.lock",
+		"/opt/app/node_modules/dep/b.jar",
+		"/proc/1/c.jar",
+		"/usr/lib/d.jar",
+		"/usr/lib/rust/Cargo.lock",
+		"/usr/local/Cargo.lock",
+	} {
+		require.NoError(t, afs.WriteFile(path, []byte("x"), 0o644))
+	}
+
+	byName := func(name string) func(afs *afero.Afero, path string, info os.FileInfo) bool {
+		return func(afs *afero.Afero, path string, info os.FileInfo) bool {
+			return filepath.Ext(path) == name || filepath.Base(path) == name
+		}
+	}
+	jars := &fileSearch{
+		roots: []string{"/", "/opt"},
+		match: byName(".jar"),
+	}
+	locks := &fileSearch{
+		roots:        []string{"/opt", "/usr/lib/rust"},
+		skipDirNames: map[string]struct{}{"node_modules": {}},
+		match:        byName("Cargo.lock"),
+	}
+
+	found := searchFiles(afs, jars, locks)
+	assert.Equal(t, []string{
+		"/opt/app/lib/a.jar",
+		"/opt/app/node_modules/dep/b.jar",
+		"/usr/lib/d.jar",
+	}, found[0])
+	assert.Equal(t, []string{
+		"/opt/app/Cargo.lock",
+		"/usr/lib/rust/Cargo.lock",
+	}, found[1])
+
+	found = searchFiles(afs, locks.in("/usr"))
+	assert.Equal(t, []string{
+		"/usr/lib/rust/Cargo.lock",
+		"/usr/local/Cargo.lock",
+	}, found[0])
+}
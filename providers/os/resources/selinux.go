@@ -0,0 +1,115 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"os"
+
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/selinux"
+)
+
+func initSelinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if len(args) > 0 {
+		return args, nil, nil
+	}
+
+	conn := runtime.Connection.(shared.Connection)
+	status, err := selinux.ReadStatus(conn.FileSystem())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args["enabled"] = llx.BoolData(status.Enabled)
+	args["mode"] = llx.StringData(status.Mode)
+	args["configMode"] = llx.StringData(status.ConfigMode)
+	args["policyType"] = llx.StringData(status.PolicyType)
+	args["policyVersion"] = llx.IntData(status.PolicyVersion)
+	return args, nil, nil
+}
+
+func (s *mqlSelinux) id() (string, error) {
+	return "selinux", nil
+}
+
+func (s *mqlSelinux) booleans() ([]any, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	booleans, err := selinux.ReadBooleans(conn.FileSystem(), s.PolicyType.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(booleans))
+	for i, b := range booleans {
+		obj, err := CreateResource(s.MqlRuntime, "selinux.boolean", map[string]*llx.RawData{
+			"__id":    llx.StringData("selinux.boolean/" + b.Name),
+			"name":    llx.StringData(b.Name),
+			"value":   llx.BoolData(b.Value),
+			"pending": llx.BoolData(b.Pending),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func (s *mqlSelinux) modules() ([]any, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	modules, err := selinux.ReadModules(conn.FileSystem(), s.PolicyType.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(modules))
+	for i, m := range modules {
+		obj, err := CreateResource(s.MqlRuntime, "selinux.module", map[string]*llx.RawData{
+			"__id":     llx.StringData("selinux.module/" + m.Name),
+			"name":     llx.StringData(m.Name),
+			"priority": llx.IntData(m.Priority),
+			"enabled":  llx.BoolData(m.Enabled),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func initSelinuxFileContext(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	x, ok := args["path"]
+	if !ok {
+		return nil, nil, errors.New("missing 'path' in selinux.fileContext initialization")
+	}
+	if _, ok := x.Value.(string); !ok {
+		return nil, nil, errors.New("wrong type for 'path' in selinux.fileContext initialization, it must be a string")
+	}
+	return args, nil, nil
+}
+
+func (s *mqlSelinuxFileContext) id() (string, error) {
+	return s.Path.Data, nil
+}
+
+func (s *mqlSelinuxFileContext) context() (string, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	fs := conn.FileSystem()
+
+	status, err := selinux.ReadStatus(fs)
+	if err != nil {
+		return "", err
+	}
+
+	// the policy may assign different contexts to files and directories
+	var mode os.FileMode
+	if stat, err := fs.Stat(s.Path.Data); err == nil {
+		mode = stat.Mode()
+	}
+	return selinux.LookupFileContext(fs, status.PolicyType, s.Path.Data, mode)
+}
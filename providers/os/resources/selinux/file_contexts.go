@@ -0,0 +1,168 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selinux
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// FileContext is an entry of the file_contexts of a policy
+type FileContext struct {
+	// Regex that matches the full path
+	Regex string
+	// FileType restricts the entry to a type of file, e.g. -d for directories
+	FileType string
+	// Context assigned to matching paths, <<none>> for paths without a label
+	Context string
+}
+
+// ParseFileContexts parses a file_contexts file
+func ParseFileContexts(r io.Reader) ([]FileContext, error) {
+	res := []FileContext{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 2:
+			res = append(res, FileContext{Regex: fields[0], Context: fields[1]})
+		case 3:
+			res = append(res, FileContext{Regex: fields[0], FileType: fields[1], Context: fields[2]})
+		}
+	}
+	return res, scanner.Err()
+}
+
+// ParseSubstitutions parses a file_contexts.subs file, which maps paths to
+// equivalent paths, e.g. /srv/www to /var/www
+func ParseSubstitutions(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		res[fields[0]] = fields[1]
+	}
+	return res, scanner.Err()
+}
+
+// LookupFileContext returns the context the policy assigns to a path, like
+// matchpathcon does. A mode of 0 matches entries for all types of files. The
+// context is empty if the policy does not label the path.
+func LookupFileContext(fs afero.Fs, policyType string, p string, mode os.FileMode) (string, error) {
+	if policyType == "" {
+		return "", nil
+	}
+	filesPath := path.Join(policyPath, policyType, "contexts", "files")
+
+	p = path.Clean(p)
+	for _, name := range []string{"file_contexts.subs_dist", "file_contexts.subs"} {
+		subs, err := readFile(fs, path.Join(filesPath, name), ParseSubstitutions)
+		if err != nil {
+			return "", err
+		}
+		p = substitute(p, subs)
+	}
+
+	contexts := []FileContext{}
+	for _, name := range []string{"file_contexts", "file_contexts.homedirs", "file_contexts.local"} {
+		entries, err := readFile(fs, path.Join(filesPath, name), ParseFileContexts)
+		if err != nil {
+			return "", err
+		}
+		contexts = append(contexts, entries...)
+	}
+
+	// like libselinux, entries with exact paths take precedence over the ones
+	// with regular expressions and the last matching entry wins
+	sort.SliceStable(contexts, func(i, j int) bool {
+		return hasMetaChars(contexts[i].Regex) && !hasMetaChars(contexts[j].Regex)
+	})
+	fileType := fileTypeOf(mode)
+	for i := len(contexts) - 1; i >= 0; i-- {
+		entry := contexts[i]
+		if fileType != "" && entry.FileType != "" && entry.FileType != fileType {
+			continue
+		}
+		re, err := regexp.Compile("^(?:" + entry.Regex + ")$")
+		if err != nil {
+			continue
+		}
+		if !re.MatchString(p) {
+			continue
+		}
+		if entry.Context == "<<none>>" {
+			return "", nil
+		}
+		return entry.Context, nil
+	}
+	return "", nil
+}
+
+func readFile[T any](fs afero.Fs, p string, parse func(io.Reader) (T, error)) (T, error) {
+	var res T
+	f, err := fs.Open(p)
+	if os.IsNotExist(err) {
+		return res, nil
+	}
+	if err != nil {
+		return res, err
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+func substitute(p string, subs map[string]string) string {
+	for from, to := range subs {
+		if p == from {
+			return to
+		}
+		if rest, ok := strings.CutPrefix(p, from+"/"); ok {
+			return path.Join(to, rest)
+		}
+	}
+	return p
+}
+
+func hasMetaChars(regex string) bool {
+	return strings.ContainsAny(regex, `.^$?*+|[({\`)
+}
+
+func fileTypeOf(mode os.FileMode) string {
+	switch {
+	case mode == 0:
+		return ""
+	case mode.IsDir():
+		return "-d"
+	case mode&os.ModeSymlink != 0:
+		return "-l"
+	case mode&os.ModeNamedPipe != 0:
+		return "-p"
+	case mode&os.ModeSocket != 0:
+		return "-s"
+	case mode&os.ModeCharDevice != 0:
+		return "-c"
+	case mode&os.ModeDevice != 0:
+		return "-b"
+	default:
+		return "--"
+	}
+}
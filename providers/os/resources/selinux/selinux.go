@@ -0,0 +1,287 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selinux
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	ModeEnforcing  = "enforcing"
+	ModePermissive = "permissive"
+	ModeDisabled   = "disabled"
+)
+
+const (
+	ConfigPath    = "/etc/selinux/config"
+	selinuxfsPath = "/sys/fs/selinux"
+	policyPath    = "/etc/selinux"
+	storePath     = "/var/lib/selinux"
+)
+
+// Config is the SELinux configuration in /etc/selinux/config
+type Config struct {
+	// Mode is the mode SELinux starts in: enforcing, permissive, or disabled
+	Mode string
+	// Type is the name of the policy, e.g. targeted
+	Type string
+}
+
+// ParseConfig parses the SELinux configuration
+func ParseConfig(r io.Reader) (*Config, error) {
+	res := &Config{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		switch strings.TrimSpace(key) {
+		case "SELINUX":
+			res.Mode = strings.ToLower(value)
+		case "SELINUXTYPE":
+			res.Type = value
+		}
+	}
+	return res, scanner.Err()
+}
+
+// Status is the state of SELinux on the system
+type Status struct {
+	Enabled bool
+	// Mode is the current mode: enforcing, permissive, or disabled
+	Mode string
+	// ConfigMode is the mode configured in /etc/selinux/config
+	ConfigMode string
+	// PolicyType is the name of the configured policy, e.g. targeted
+	PolicyType string
+	// PolicyVersion of the loaded policy, 0 if no policy is loaded
+	PolicyVersion int64
+}
+
+// ReadStatus reads the status from the selinuxfs and the configuration. If
+// the selinuxfs is not available on a running system, SELinux is disabled.
+// Filesystem snapshots have no running kernel, so the configured mode is used.
+func ReadStatus(fs afero.Fs) (*Status, error) {
+	res := &Status{
+		Mode: ModeDisabled,
+	}
+
+	f, err := fs.Open(ConfigPath)
+	if err == nil {
+		defer f.Close()
+		config, err := ParseConfig(f)
+		if err != nil {
+			return nil, err
+		}
+		res.ConfigMode = config.Mode
+		res.PolicyType = config.Type
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	enforce, err := afero.ReadFile(fs, path.Join(selinuxfsPath, "enforce"))
+	if err == nil {
+		res.Enabled = true
+		if strings.TrimSpace(string(enforce)) == "1" {
+			res.Mode = ModeEnforcing
+		} else {
+			res.Mode = ModePermissive
+		}
+
+		policyVersion, err := afero.ReadFile(fs, path.Join(selinuxfsPath, "policyvers"))
+		if err == nil {
+			res.PolicyVersion, _ = strconv.ParseInt(strings.TrimSpace(string(policyVersion)), 10, 64)
+		}
+		return res, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// a mounted procfs means that this is a running system
+	if ok, _ := afero.DirExists(fs, "/proc/1"); ok {
+		return res, nil
+	}
+
+	if res.ConfigMode == ModeEnforcing || res.ConfigMode == ModePermissive {
+		res.Enabled = true
+		res.Mode = res.ConfigMode
+	}
+	return res, nil
+}
+
+// Boolean is an SELinux policy boolean
+type Boolean struct {
+	Name string
+	// Value is the current value
+	Value bool
+	// Pending is the value that is applied on the next commit
+	Pending bool
+}
+
+// ReadBooleans reads the booleans of the loaded policy from the selinuxfs. If
+// no policy is loaded, the locally changed booleans of the policy store are
+// returned, since the defaults are only available in the compiled policy.
+func ReadBooleans(fs afero.Fs, policyType string) ([]Boolean, error) {
+	entries, err := afero.ReadDir(fs, path.Join(selinuxfsPath, "booleans"))
+	if os.IsNotExist(err) {
+		return readLocalBooleans(fs, policyType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Boolean{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := afero.ReadFile(fs, path.Join(selinuxfsPath, "booleans", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		// booleans hold the current and the pending value, e.g. 1 1
+		values := strings.Fields(string(data))
+		if len(values) == 0 {
+			continue
+		}
+		b := Boolean{
+			Name:  entry.Name(),
+			Value: values[0] == "1",
+		}
+		b.Pending = b.Value
+		if len(values) > 1 {
+			b.Pending = values[1] == "1"
+		}
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+func readLocalBooleans(fs afero.Fs, policyType string) ([]Boolean, error) {
+	if policyType == "" {
+		return []Boolean{}, nil
+	}
+
+	f, err := fs.Open(path.Join(storePath, policyType, "active", "booleans.local"))
+	if os.IsNotExist(err) {
+		return []Boolean{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := []Boolean{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.ToLower(strings.TrimSpace(value))
+		enabled := value == "1" || value == "true" || value == "on"
+		res = append(res, Boolean{
+			Name:    strings.TrimSpace(name),
+			Value:   enabled,
+			Pending: enabled,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, scanner.Err()
+}
+
+// Module is a policy module installed in the policy store
+type Module struct {
+	Name string
+	// Priority of the module, the module with the highest priority is used
+	Priority int64
+	Enabled  bool
+}
+
+// ReadModules reads the modules of the policy store. Modules are installed
+// in directories per priority, only the module with the highest priority is
+// returned for every name.
+func ReadModules(fs afero.Fs, policyType string) ([]Module, error) {
+	if policyType == "" {
+		return []Module{}, nil
+	}
+
+	modulesPath := path.Join(storePath, policyType, "active", "modules")
+	priorities, err := afero.ReadDir(fs, modulesPath)
+	if os.IsNotExist(err) {
+		return []Module{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modules := map[string]*Module{}
+	disabled := map[string]struct{}{}
+	for _, priority := range priorities {
+		if !priority.IsDir() {
+			continue
+		}
+
+		entries, err := afero.ReadDir(fs, path.Join(modulesPath, priority.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if priority.Name() == "disabled" {
+			for _, entry := range entries {
+				disabled[entry.Name()] = struct{}{}
+			}
+			continue
+		}
+
+		p, err := strconv.ParseInt(priority.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			if m, ok := modules[entry.Name()]; ok && m.Priority > p {
+				continue
+			}
+			modules[entry.Name()] = &Module{
+				Name:     entry.Name(),
+				Priority: p,
+			}
+		}
+	}
+
+	res := make([]Module, 0, len(modules))
+	for name, m := range modules {
+		_, isDisabled := disabled[name]
+		m.Enabled = !isDisabled
+		res = append(res, *m)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
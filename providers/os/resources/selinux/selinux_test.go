@@ -0,0 +1,131 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selinux
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers/os/fs"
+)
+
+func TestParseConfig(t *testing.T) {
+	config, err := ParseConfig(strings.NewReader(`
+# comment
+SELINUX=Permissive
+SELINUXTYPE="mls"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &Config{Mode: ModePermissive, Type: "mls"}, config)
+}
+
+func TestReadStatus(t *testing.T) {
+	t.Run("running system", func(t *testing.T) {
+		status, err := ReadStatus(fs.NewMountedFs("testdata"))
+		require.NoError(t, err)
+		assert.Equal(t, &Status{
+			Enabled:       true,
+			Mode:          ModeEnforcing,
+			ConfigMode:    ModeEnforcing,
+			PolicyType:    "targeted",
+			PolicyVersion: 33,
+		}, status)
+	})
+
+	t.Run("disabled on a running system", func(t *testing.T) {
+		mfs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(mfs, ConfigPath, []byte("SELINUX=enforcing\nSELINUXTYPE=targeted\n"), 0o644))
+		require.NoError(t, mfs.MkdirAll("/proc/1", 0o755))
+		status, err := ReadStatus(mfs)
+		require.NoError(t, err)
+		assert.False(t, status.Enabled)
+		assert.Equal(t, ModeDisabled, status.Mode)
+		assert.Equal(t, ModeEnforcing, status.ConfigMode)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		mfs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(mfs, ConfigPath, []byte("SELINUX=permissive\nSELINUXTYPE=targeted\n"), 0o644))
+		status, err := ReadStatus(mfs)
+		require.NoError(t, err)
+		assert.Equal(t, &Status{
+			Enabled:    true,
+			Mode:       ModePermissive,
+			ConfigMode: ModePermissive,
+			PolicyType: "targeted",
+		}, status)
+	})
+
+	t.Run("not installed", func(t *testing.T) {
+		status, err := ReadStatus(afero.NewMemMapFs())
+		require.NoError(t, err)
+		assert.Equal(t, &Status{Mode: ModeDisabled}, status)
+	})
+}
+
+func TestReadBooleans(t *testing.T) {
+	booleans, err := ReadBooleans(fs.NewMountedFs("testdata"), "targeted")
+	require.NoError(t, err)
+	assert.Equal(t, []Boolean{
+		{Name: "httpd_can_network_connect", Value: true, Pending: true},
+		{Name: "ssh_sysadm_login", Value: false, Pending: true},
+		{Name: "virt_use_nfs", Value: false, Pending: false},
+	}, booleans)
+
+	// without a loaded policy, only the local changes are known
+	mfs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(mfs, "/var/lib/selinux/targeted/active/booleans.local", []byte("virt_use_nfs=1\nhttpd_can_network_connect=0\n"), 0o644))
+	booleans, err = ReadBooleans(mfs, "targeted")
+	require.NoError(t, err)
+	assert.Equal(t, []Boolean{
+		{Name: "httpd_can_network_connect", Value: false, Pending: false},
+		{Name: "virt_use_nfs", Value: true, Pending: true},
+	}, booleans)
+}
+
+func TestReadModules(t *testing.T) {
+	modules, err := ReadModules(fs.NewMountedFs("testdata"), "targeted")
+	require.NoError(t, err)
+	assert.Equal(t, []Module{
+		{Name: "apache", Priority: 400, Enabled: true},
+		{Name: "ssh", Priority: 100, Enabled: true},
+		{Name: "zabbix", Priority: 100, Enabled: false},
+	}, modules)
+
+	modules, err = ReadModules(fs.NewMountedFs("testdata"), "mls")
+	require.NoError(t, err)
+	assert.Empty(t, modules)
+}
+
+func TestLookupFileContext(t *testing.T) {
+	tfs := fs.NewMountedFs("testdata")
+	tests := []struct {
+		path     string
+		mode     os.FileMode
+		expected string
+	}{
+		{"/etc/ssh/sshd_config", 0o600, "system_u:object_r:sshd_config_t:s0"},
+		// the entry is restricted to regular files
+		{"/etc/ssh/sshd_config", os.ModeDir | 0o755, "system_u:object_r:etc_t:s0"},
+		{"/etc/ssh/ssh_config", 0, "system_u:object_r:etc_t:s0"},
+		{"/etc/shadow", 0o000, "system_u:object_r:shadow_t:s0"},
+		{"/var/www/cgi-bin/index.cgi", 0o755, "system_u:object_r:httpd_sys_script_exec_t:s0"},
+		{"/srv/www/html/index.html", 0o644, "system_u:object_r:httpd_sys_content_t:s0"},
+		{"/srv/app/data", 0o644, "system_u:object_r:httpd_sys_rw_content_t:s0"},
+		{"/usr/sbin/sshd", 0o755, "system_u:object_r:sshd_exec_t:s0"},
+		{"/opt/tool", 0o755, "system_u:object_r:default_t:s0"},
+		{"/proc/1", os.ModeDir | 0o555, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			context, err := LookupFileContext(tfs, "targeted", tc.path, tc.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, context)
+		})
+	}
+}
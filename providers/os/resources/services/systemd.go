@@ -21,7 +21,7 @@ import (
 
 var (
 	SYSTEMD_LIST_UNITS_REGEX = regexp.MustCompile(`(?m)^(?:[^\S\n]{2}|●[^\S\n]|)(\S+)(?:[^\S\n])+(loaded|not-found|masked)(?:[^\S\n])+(\S+)(?:[^\S\n])+(\S+)(?:[^\S\n])+(.+)$`)
-	serviceNameRegex         = regexp.MustCompile(`(.*)\.(service|target|socket)$`)
+	serviceNameRegex         = regexp.MustCompile(`(.*)\.(service|target|socket|timer)$`)
 	errIgnored               = errors.New("ignored")
 )
 
@@ -169,9 +169,14 @@ type unitInfo struct {
 	// isDep is true of this unit is found in the dependency tree starting
 	// from the default.target
 	isDep bool
-	// service is only set for socket units. It contains an optional name.target.
-	// If not provided, socketname.service is activated for the socket
+	// service is only set for socket and timer units. It contains an optional
+	// name.type. If not provided, name.service is activated for the unit
 	service string
+	// onCalendar and monotonic are the triggers of timer units
+	onCalendar []string
+	monotonic  []string
+	// persistent is set for timer units that catch up on missed triggers
+	persistent bool
 }
 
 type stackEntry struct {
@@ -223,8 +228,8 @@ func (s *SystemdFSServiceManager) List() ([]*Service, error) {
 }
 
 // traverse traverses the root target and finds units. This implementation is
-// incomplete. It only looks at targets, services, and sockets, so at least
-// mounts and timers are missing. Also, handling of templates is probably not
+// incomplete. It only looks at targets, services, sockets, and timers, so at
+// least mounts are missing. Also, handling of templates is probably not
 // fully correct. The implicit and default dependencies for types are also
 // not accounted for
 func (s *SystemdFSServiceManager) traverse() (map[string]*unitInfo, error) {
@@ -411,6 +416,8 @@ func (s *SystemdFSServiceManager) readUnit(unitPath string, uInfo *unitInfo) err
 			}
 		} else if o.Section == "Socket" && o.Name == "Service" {
 			uInfo.service = o.Value
+		} else if o.Section == "Timer" {
+			readTimerOption(o, uInfo)
 		}
 	}
 
@@ -0,0 +1,670 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ExecSettings are the effective execution and sandboxing settings of a
+// service, with the defaults of systemd for settings that are not set
+type ExecSettings struct {
+	ExecStart           []string
+	User                string
+	Group               string
+	DynamicUser         bool
+	SupplementaryGroups []string
+	// ProtectSystem is no, yes, full, or strict
+	ProtectSystem string
+	// ProtectHome is no, yes, read-only, or tmpfs
+	ProtectHome            string
+	PrivateTmp             bool
+	PrivateDevices         bool
+	PrivateNetwork         bool
+	PrivateUsers           bool
+	PrivateMounts          bool
+	ProtectKernelTunables  bool
+	ProtectKernelModules   bool
+	ProtectKernelLogs      bool
+	ProtectControlGroups   bool
+	ProtectClock           bool
+	ProtectHostname        bool
+	NoNewPrivileges        bool
+	RestrictRealtime       bool
+	RestrictSUIDSGID       bool
+	LockPersonality        bool
+	MemoryDenyWriteExecute bool
+	RemoveIPC              bool
+	// CapabilityBoundingSet are the capabilities the service may retain
+	CapabilityBoundingSet []string
+	AmbientCapabilities   []string
+	// RestrictAddressFamilies, RestrictNamespaces, and SystemCallFilter are
+	// the configured assignments, a leading ~ denies the listed entries
+	RestrictAddressFamilies []string
+	RestrictNamespaces      []string
+	SystemCallFilter        []string
+	SystemCallArchitectures []string
+	IPAddressDeny           []string
+	IPAddressAllow          []string
+	DevicePolicy            string
+	DeviceAllow             []string
+	UMask                   string
+	KeyringMode             string
+	NotifyAccess            string
+	Delegate                bool
+	ProtectProc             string
+	RootDirectory           string
+}
+
+// capabilities are all Linux capabilities, which services retain by default
+var capabilities = []string{
+	"CAP_CHOWN", "CAP_DAC_OVERRIDE", "CAP_DAC_READ_SEARCH", "CAP_FOWNER", "CAP_FSETID",
+	"CAP_KILL", "CAP_SETGID", "CAP_SETUID", "CAP_SETPCAP", "CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE", "CAP_NET_BROADCAST", "CAP_NET_ADMIN", "CAP_NET_RAW", "CAP_IPC_LOCK",
+	"CAP_IPC_OWNER", "CAP_SYS_MODULE", "CAP_SYS_RAWIO", "CAP_SYS_CHROOT", "CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT", "CAP_SYS_ADMIN", "CAP_SYS_BOOT", "CAP_SYS_NICE", "CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME", "CAP_SYS_TTY_CONFIG", "CAP_MKNOD", "CAP_LEASE", "CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL", "CAP_SETFCAP", "CAP_MAC_OVERRIDE", "CAP_MAC_ADMIN", "CAP_SYSLOG",
+	"CAP_WAKE_ALARM", "CAP_BLOCK_SUSPEND", "CAP_AUDIT_READ", "CAP_PERFMON", "CAP_BPF",
+	"CAP_CHECKPOINT_RESTORE",
+}
+
+// ExecSettings returns the effective execution settings of a service unit
+func (u *Unit) ExecSettings() *ExecSettings {
+	value := func(name string) string { return u.Value("Service", name) }
+	values := func(name string) []string { return splitValues(u.Values("Service", name)) }
+	boolean := func(name string) bool { return parseSystemdBool(value(name)) }
+
+	res := &ExecSettings{
+		ExecStart:               u.Values("Service", "ExecStart"),
+		User:                    value("User"),
+		Group:                   value("Group"),
+		DynamicUser:             boolean("DynamicUser"),
+		SupplementaryGroups:     values("SupplementaryGroups"),
+		ProtectSystem:           normalizeSystemdBool(value("ProtectSystem")),
+		ProtectHome:             normalizeSystemdBool(value("ProtectHome")),
+		PrivateTmp:              boolean("PrivateTmp"),
+		PrivateDevices:          boolean("PrivateDevices"),
+		PrivateNetwork:          boolean("PrivateNetwork"),
+		PrivateUsers:            boolean("PrivateUsers"),
+		PrivateMounts:           boolean("PrivateMounts"),
+		ProtectKernelTunables:   boolean("ProtectKernelTunables"),
+		ProtectKernelModules:    boolean("ProtectKernelModules"),
+		ProtectKernelLogs:       boolean("ProtectKernelLogs"),
+		ProtectControlGroups:    boolean("ProtectControlGroups"),
+		ProtectClock:            boolean("ProtectClock"),
+		ProtectHostname:         boolean("ProtectHostname"),
+		NoNewPrivileges:         boolean("NoNewPrivileges"),
+		RestrictRealtime:        boolean("RestrictRealtime"),
+		RestrictSUIDSGID:        boolean("RestrictSUIDSGID"),
+		LockPersonality:         boolean("LockPersonality"),
+		MemoryDenyWriteExecute:  boolean("MemoryDenyWriteExecute"),
+		RemoveIPC:               boolean("RemoveIPC"),
+		CapabilityBoundingSet:   capabilitySet(u.Values("Service", "CapabilityBoundingSet")),
+		AmbientCapabilities:     values("AmbientCapabilities"),
+		RestrictAddressFamilies: u.Values("Service", "RestrictAddressFamilies"),
+		RestrictNamespaces:      u.Values("Service", "RestrictNamespaces"),
+		SystemCallFilter:        u.Values("Service", "SystemCallFilter"),
+		SystemCallArchitectures: values("SystemCallArchitectures"),
+		IPAddressDeny:           values("IPAddressDeny"),
+		IPAddressAllow:          values("IPAddressAllow"),
+		DevicePolicy:            value("DevicePolicy"),
+		DeviceAllow:             u.Values("Service", "DeviceAllow"),
+		UMask:                   value("UMask"),
+		KeyringMode:             value("KeyringMode"),
+		NotifyAccess:            value("NotifyAccess"),
+		Delegate:                boolean("Delegate"),
+		ProtectProc:             value("ProtectProc"),
+		RootDirectory:           value("RootDirectory"),
+	}
+
+	if res.User == "" {
+		res.User = "root"
+	}
+	if res.ProtectSystem == "" {
+		res.ProtectSystem = "no"
+	}
+	if res.ProtectHome == "" {
+		res.ProtectHome = "no"
+	}
+	if res.DevicePolicy == "" {
+		res.DevicePolicy = "auto"
+	}
+	if res.UMask == "" {
+		res.UMask = "0022"
+	}
+	if res.KeyringMode == "" {
+		res.KeyringMode = "private"
+	}
+	if res.NotifyAccess == "" {
+		res.NotifyAccess = "none"
+	}
+	if res.ProtectProc == "" {
+		res.ProtectProc = "default"
+	}
+	if res.RootDirectory == "" {
+		res.RootDirectory = value("RootImage")
+	}
+
+	// dynamic users imply a set of sandboxing settings
+	if res.DynamicUser {
+		if res.ProtectSystem == "no" {
+			res.ProtectSystem = "strict"
+		}
+		if res.ProtectHome == "no" {
+			res.ProtectHome = "read-only"
+		}
+		res.PrivateTmp = true
+		res.RemoveIPC = true
+		res.NoNewPrivileges = true
+		res.RestrictSUIDSGID = true
+	}
+
+	return res
+}
+
+// normalizeSystemdBool returns yes and no for boolean values of options that
+// also have other values, e.g. ProtectSystem=full
+func normalizeSystemdBool(s string) string {
+	switch strings.ToLower(s) {
+	case "":
+		return ""
+	case "1", "yes", "y", "true", "t", "on":
+		return "yes"
+	case "0", "no", "n", "false", "f", "off":
+		return "no"
+	default:
+		return s
+	}
+}
+
+// splitValues splits whitespace separated values, empty assignments are
+// already handled when the unit is read
+func splitValues(values []string) []string {
+	res := []string{}
+	for _, v := range values {
+		res = append(res, strings.Fields(v)...)
+	}
+	return res
+}
+
+// capabilitySet returns the capabilities of the bounding set. A list
+// restricts the set to its entries, a list starting with ~ removes the entries.
+func capabilitySet(values []string) []string {
+	set := slices.Clone(capabilities)
+	initial := true
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, "~") {
+			for _, c := range strings.Fields(strings.TrimPrefix(v, "~")) {
+				set = slices.DeleteFunc(set, func(s string) bool { return strings.EqualFold(s, c) })
+			}
+			continue
+		}
+		if initial {
+			set = []string{}
+			initial = false
+		}
+		for _, c := range strings.Fields(v) {
+			c = strings.ToUpper(c)
+			if !slices.Contains(set, c) {
+				set = append(set, c)
+			}
+		}
+	}
+	// the empty assignment resets the set to no capabilities
+	if values != nil && len(values) == 0 {
+		return []string{}
+	}
+	return set
+}
+
+// SecurityCheck is the result of one check of the exposure assessment
+type SecurityCheck struct {
+	// Name of the check, e.g. PrivateTmp= or CapabilityBoundingSet=~CAP_SYS_ADMIN
+	Name        string
+	Description string
+	Weight      uint64
+	// Badness of the setting between 0 and Range
+	Badness uint64
+	Range   uint64
+}
+
+// Exposure is the assessment of how exposed a service is, like systemd-analyze
+// security does it
+type Exposure struct {
+	// Score from 0.0 to 10.0, higher is more exposed
+	Score float64
+	// Level is PERFECT, SAFE, OK, MEDIUM, EXPOSED, UNSAFE, or DANGEROUS
+	Level  string
+	Checks []SecurityCheck
+}
+
+type securityAssessor struct {
+	name        string
+	description string
+	weight      uint64
+	rangeN      uint64
+	assess      func(e *ExecSettings) uint64
+}
+
+func boolAssessor(name string, description string, weight uint64, get func(e *ExecSettings) bool) securityAssessor {
+	return securityAssessor{
+		name:        name + "=",
+		description: description,
+		weight:      weight,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if get(e) {
+				return 0
+			}
+			return 1
+		},
+	}
+}
+
+func capabilityAssessor(weight uint64, caps ...string) securityAssessor {
+	return securityAssessor{
+		name:        "CapabilityBoundingSet=~" + strings.Join(caps, "|"),
+		description: "Service may not acquire " + strings.Join(caps, ", "),
+		weight:      weight,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			for _, c := range caps {
+				if slices.Contains(e.CapabilityBoundingSet, c) {
+					return 1
+				}
+			}
+			return 0
+		},
+	}
+}
+
+func addressFamilyAssessor(name string, weight uint64, families ...string) securityAssessor {
+	return securityAssessor{
+		name:        "RestrictAddressFamilies=~" + name,
+		description: "Service cannot allocate " + name + " sockets",
+		weight:      weight,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if len(e.RestrictAddressFamilies) == 1 && e.RestrictAddressFamilies[0] == "none" {
+				return 0
+			}
+			for _, family := range families {
+				if listAllows(e.RestrictAddressFamilies, family, nil) {
+					return 1
+				}
+			}
+			return 0
+		},
+	}
+}
+
+func namespaceAssessor(name string, namespace string) securityAssessor {
+	weight := uint64(500)
+	if namespace == "user" {
+		weight = 1500
+	}
+	return securityAssessor{
+		name:        "RestrictNamespaces=~" + name,
+		description: "Service cannot create " + namespace + " namespaces",
+		weight:      weight,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if len(e.RestrictNamespaces) == 1 {
+				switch normalizeSystemdBool(e.RestrictNamespaces[0]) {
+				case "yes":
+					return 0
+				case "no":
+					return 1
+				}
+			}
+			if listAllows(e.RestrictNamespaces, namespace, nil) {
+				return 1
+			}
+			return 0
+		},
+	}
+}
+
+// systemCallGroups are the groups of system calls that are included in
+// other groups, as far as they are relevant for the assessment
+var systemCallGroups = map[string][]string{
+	"@system-service": {"@privileged", "@resources"},
+	"@privileged":     {"@clock", "@module", "@raw-io", "@reboot", "@swap"},
+	"@known":          {"@clock", "@cpu-emulation", "@debug", "@module", "@mount", "@obsolete", "@privileged", "@raw-io", "@reboot", "@resources", "@swap"},
+}
+
+func systemCallAssessor(group string, weight uint64) securityAssessor {
+	return securityAssessor{
+		name:        "SystemCallFilter=~" + group,
+		description: "System call filter blocks the " + group + " system calls",
+		weight:      weight,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if listAllows(e.SystemCallFilter, group, systemCallGroups) {
+				return 1
+			}
+			return 0
+		},
+	}
+}
+
+// listAllows evaluates allow and deny lists like systemd does. The first
+// assignment determines if the option is an allow list or a deny list, later
+// assignments add entries to it or remove them. Groups include their members.
+func listAllows(assignments []string, entry string, groups map[string][]string) bool {
+	if len(assignments) == 0 {
+		return true
+	}
+
+	// everything that is not on a deny list is allowed
+	allowed := strings.HasPrefix(strings.TrimSpace(assignments[0]), "~")
+	for _, assignment := range assignments {
+		assignment = strings.TrimSpace(assignment)
+		deny := strings.HasPrefix(assignment, "~")
+		for _, field := range strings.Fields(strings.TrimPrefix(assignment, "~")) {
+			if strings.EqualFold(field, entry) || slices.Contains(groups[field], entry) {
+				allowed = !deny
+			}
+		}
+	}
+	return allowed
+}
+
+// securityAssessors are the checks of systemd-analyze security with its weights
+var securityAssessors = []securityAssessor{
+	{
+		name:        "User=/DynamicUser=",
+		description: "Service runs as an unprivileged or dynamic user",
+		weight:      2000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			switch {
+			case e.DynamicUser:
+				return 0
+			case e.User == "root" || e.User == "0":
+				return 10
+			case e.User == "nobody" || e.User == "65534":
+				return 9
+			default:
+				return 0
+			}
+		},
+	},
+	{
+		name:        "SupplementaryGroups=",
+		description: "Service runs without supplementary groups",
+		weight:      200,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if len(e.SupplementaryGroups) > 0 {
+				return 1
+			}
+			return 0
+		},
+	},
+	boolAssessor("PrivateDevices", "Service has no access to hardware devices", 1000, func(e *ExecSettings) bool { return e.PrivateDevices }),
+	boolAssessor("PrivateMounts", "Service cannot install system mounts", 1000, func(e *ExecSettings) bool { return e.PrivateMounts }),
+	boolAssessor("PrivateNetwork", "Service has no access to the host's network", 2500, func(e *ExecSettings) bool { return e.PrivateNetwork }),
+	boolAssessor("PrivateTmp", "Service has no access to other software's temporary files", 1000, func(e *ExecSettings) bool { return e.PrivateTmp }),
+	boolAssessor("PrivateUsers", "Service does not have access to other users", 1000, func(e *ExecSettings) bool { return e.PrivateUsers }),
+	boolAssessor("ProtectControlGroups", "Service cannot modify the control group file system", 1000, func(e *ExecSettings) bool { return e.ProtectControlGroups }),
+	boolAssessor("ProtectKernelModules", "Service cannot load or read kernel modules", 1000, func(e *ExecSettings) bool { return e.ProtectKernelModules }),
+	boolAssessor("ProtectKernelTunables", "Service cannot alter kernel tunables (/proc/sys, …)", 1000, func(e *ExecSettings) bool { return e.ProtectKernelTunables }),
+	boolAssessor("ProtectKernelLogs", "Service cannot read from or write to the kernel log ring buffer", 1000, func(e *ExecSettings) bool { return e.ProtectKernelLogs }),
+	boolAssessor("ProtectClock", "Service cannot write to the hardware clock or system clock", 1000, func(e *ExecSettings) bool { return e.ProtectClock }),
+	boolAssessor("ProtectHostname", "Service cannot change system host/domainname", 50, func(e *ExecSettings) bool { return e.ProtectHostname }),
+	boolAssessor("NoNewPrivileges", "Service processes cannot acquire new privileges", 1000, func(e *ExecSettings) bool { return e.NoNewPrivileges }),
+	boolAssessor("RestrictRealtime", "Service realtime scheduling access is restricted", 500, func(e *ExecSettings) bool { return e.RestrictRealtime }),
+	boolAssessor("RestrictSUIDSGID", "SUID/SGID file creation by service is restricted", 1000, func(e *ExecSettings) bool { return e.RestrictSUIDSGID }),
+	boolAssessor("LockPersonality", "Service cannot change ABI personality", 100, func(e *ExecSettings) bool { return e.LockPersonality }),
+	boolAssessor("MemoryDenyWriteExecute", "Service cannot create writable executable memory mappings", 100, func(e *ExecSettings) bool { return e.MemoryDenyWriteExecute }),
+	boolAssessor("RemoveIPC", "Service user cannot leave SysV IPC objects around", 100, func(e *ExecSettings) bool { return e.RemoveIPC }),
+	boolAssessor("Delegate", "Service does not maintain its own delegated control group subtree", 100, func(e *ExecSettings) bool { return !e.Delegate }),
+	boolAssessor("RootDirectory=/RootImage", "Service runs within a chroot or image", 200, func(e *ExecSettings) bool { return e.RootDirectory != "" }),
+	boolAssessor("KeyringMode", "Service doesn't share key material with other services", 1000, func(e *ExecSettings) bool { return e.KeyringMode != "shared" }),
+	boolAssessor("NotifyAccess", "Service child processes cannot alter service state", 1000, func(e *ExecSettings) bool { return e.NotifyAccess != "all" }),
+	boolAssessor("ProtectProc", "Service has restricted access to process tree (/proc hidepid=)", 1000, func(e *ExecSettings) bool {
+		return e.ProtectProc == "invisible" || e.ProtectProc == "noaccess" || e.ProtectProc == "ptraceable"
+	}),
+	{
+		name:        "ProtectSystem=",
+		description: "Service has strict read-only access to the OS file hierarchy",
+		weight:      1000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			switch e.ProtectSystem {
+			case "strict":
+				return 0
+			case "full":
+				return 3
+			case "yes":
+				return 5
+			default:
+				return 10
+			}
+		},
+	},
+	{
+		name:        "ProtectHome=",
+		description: "Service has no access to home directories",
+		weight:      1000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			switch e.ProtectHome {
+			case "yes":
+				return 0
+			case "tmpfs":
+				return 1
+			case "read-only":
+				return 5
+			default:
+				return 10
+			}
+		},
+	},
+	{
+		name:        "SystemCallArchitectures=",
+		description: "Service may execute system calls only with the native ABI",
+		weight:      1000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			if len(e.SystemCallArchitectures) == 1 && e.SystemCallArchitectures[0] == "native" {
+				return 0
+			}
+			return 10
+		},
+	},
+	{
+		name:        "IPAddressDeny=",
+		description: "Service defines an IP address allow list",
+		weight:      1000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			denyAll := slices.Contains(e.IPAddressDeny, "any") ||
+				(slices.Contains(e.IPAddressDeny, "0.0.0.0/0") && slices.Contains(e.IPAddressDeny, "::/0"))
+			switch {
+			case !denyAll:
+				return 10
+			case len(e.IPAddressAllow) == 0:
+				return 0
+			default:
+				return 4
+			}
+		},
+	},
+	{
+		name:        "DeviceAllow=",
+		description: "Service has a minimal device access allow list",
+		weight:      1000,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			if e.DevicePolicy != "strict" && e.DevicePolicy != "closed" {
+				return 10
+			}
+			if len(e.DeviceAllow) == 0 {
+				return 0
+			}
+			return 5
+		},
+	},
+	{
+		name:        "UMask=",
+		description: "Files created by service are not accessible by other users",
+		weight:      100,
+		rangeN:      10,
+		assess: func(e *ExecSettings) uint64 {
+			mask, err := strconv.ParseUint(e.UMask, 8, 32)
+			if err != nil {
+				return 10
+			}
+			switch {
+			case mask&0o002 == 0:
+				return 10
+			case mask&0o004 == 0:
+				return 5
+			case mask&0o020 == 0:
+				return 2
+			default:
+				return 0
+			}
+		},
+	},
+	capabilityAssessor(1500, "CAP_SYS_ADMIN"),
+	capabilityAssessor(1500, "CAP_SETUID", "CAP_SETGID", "CAP_SETPCAP"),
+	capabilityAssessor(1500, "CAP_SYS_PTRACE"),
+	capabilityAssessor(1000, "CAP_SYS_TIME"),
+	capabilityAssessor(1500, "CAP_NET_ADMIN"),
+	capabilityAssessor(1000, "CAP_SYS_RAWIO"),
+	capabilityAssessor(1500, "CAP_SYS_MODULE"),
+	capabilityAssessor(500, "CAP_AUDIT_CONTROL", "CAP_AUDIT_READ", "CAP_AUDIT_WRITE"),
+	capabilityAssessor(1500, "CAP_SYSLOG"),
+	capabilityAssessor(500, "CAP_SYS_NICE", "CAP_SYS_RESOURCE"),
+	capabilityAssessor(500, "CAP_MKNOD"),
+	capabilityAssessor(1500, "CAP_CHOWN", "CAP_FSETID", "CAP_SETFCAP"),
+	capabilityAssessor(1500, "CAP_DAC_OVERRIDE", "CAP_DAC_READ_SEARCH", "CAP_FOWNER", "CAP_IPC_OWNER"),
+	capabilityAssessor(500, "CAP_KILL"),
+	capabilityAssessor(500, "CAP_NET_BIND_SERVICE", "CAP_NET_BROADCAST", "CAP_NET_RAW"),
+	capabilityAssessor(100, "CAP_SYS_BOOT"),
+	capabilityAssessor(1500, "CAP_MAC_ADMIN", "CAP_MAC_OVERRIDE"),
+	capabilityAssessor(1000, "CAP_LINUX_IMMUTABLE"),
+	capabilityAssessor(500, "CAP_IPC_LOCK"),
+	capabilityAssessor(500, "CAP_SYS_CHROOT"),
+	capabilityAssessor(25, "CAP_BLOCK_SUSPEND"),
+	capabilityAssessor(25, "CAP_WAKE_ALARM"),
+	capabilityAssessor(25, "CAP_LEASE"),
+	capabilityAssessor(1000, "CAP_SYS_TTY_CONFIG"),
+	capabilityAssessor(1000, "CAP_SYS_PACCT"),
+	capabilityAssessor(1500, "CAP_BPF"),
+	{
+		name:        "AmbientCapabilities=",
+		description: "Service process does not receive ambient capabilities",
+		weight:      500,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			if len(e.AmbientCapabilities) > 0 {
+				return 1
+			}
+			return 0
+		},
+	},
+	addressFamilyAssessor("AF_(INET|INET6)", 1500, "AF_INET", "AF_INET6"),
+	addressFamilyAssessor("AF_UNIX", 25, "AF_UNIX"),
+	addressFamilyAssessor("AF_NETLINK", 200, "AF_NETLINK"),
+	addressFamilyAssessor("AF_PACKET", 1000, "AF_PACKET"),
+	{
+		name:        "RestrictAddressFamilies=~…",
+		description: "Service cannot allocate exotic sockets",
+		weight:      1250,
+		rangeN:      1,
+		assess: func(e *ExecSettings) uint64 {
+			list := e.RestrictAddressFamilies
+			if len(list) == 0 || strings.HasPrefix(strings.TrimSpace(list[0]), "~") {
+				return 1
+			}
+			for _, assignment := range list {
+				if strings.HasPrefix(strings.TrimSpace(assignment), "~") {
+					continue
+				}
+				for _, family := range strings.Fields(assignment) {
+					switch family {
+					case "none", "AF_INET", "AF_INET6", "AF_UNIX", "AF_NETLINK", "AF_PACKET":
+					default:
+						return 1
+					}
+				}
+			}
+			return 0
+		},
+	},
+	namespaceAssessor("CLONE_NEWUSER", "user"),
+	namespaceAssessor("CLONE_NEWNS", "mnt"),
+	namespaceAssessor("CLONE_NEWIPC", "ipc"),
+	namespaceAssessor("CLONE_NEWPID", "pid"),
+	namespaceAssessor("CLONE_NEWCGROUP", "cgroup"),
+	namespaceAssessor("CLONE_NEWUTS", "uts"),
+	namespaceAssessor("CLONE_NEWNET", "net"),
+	systemCallAssessor("@swap", 1000),
+	systemCallAssessor("@obsolete", 250),
+	systemCallAssessor("@clock", 1000),
+	systemCallAssessor("@cpu-emulation", 250),
+	systemCallAssessor("@debug", 1000),
+	systemCallAssessor("@mount", 1000),
+	systemCallAssessor("@module", 1000),
+	systemCallAssessor("@raw-io", 1000),
+	systemCallAssessor("@reboot", 1000),
+	systemCallAssessor("@privileged", 700),
+	systemCallAssessor("@resources", 700),
+}
+
+// exposureLevels map the exposure to the levels of systemd-analyze security
+var exposureLevels = []struct {
+	exposure uint64
+	name     string
+}{
+	{100, "DANGEROUS"},
+	{90, "UNSAFE"},
+	{75, "EXPOSED"},
+	{50, "MEDIUM"},
+	{10, "OK"},
+	{1, "SAFE"},
+	{0, "PERFECT"},
+}
+
+// Exposure assesses the settings like systemd-analyze security. It is
+// computed from the unit files, so settings of the running service manager
+// are not taken into account.
+func (e *ExecSettings) Exposure() *Exposure {
+	res := &Exposure{}
+	var badnessSum, weightSum uint64
+	for _, a := range securityAssessors {
+		badness := a.assess(e)
+		badnessSum += divRoundUp(badness*a.weight, a.rangeN)
+		weightSum += a.weight
+		res.Checks = append(res.Checks, SecurityCheck{
+			Name:        a.name,
+			Description: a.description,
+			Weight:      a.weight,
+			Badness:     badness,
+			Range:       a.rangeN,
+		})
+	}
+
+	exposure := divRoundUp(badnessSum*100, weightSum)
+	res.Score = float64(exposure) / 10
+	for _, level := range exposureLevels {
+		if exposure >= level.exposure {
+			res.Level = level.name
+			break
+		}
+	}
+	return res
+}
+
+func divRoundUp(x uint64, y uint64) uint64 {
+	return (x + y - 1) / y
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers/os/fs"
+)
+
+func TestSystemdExposure(t *testing.T) {
+	s := SystemdFSServiceManager{
+		Fs: fs.NewMountedFs("testdata/systemd"),
+	}
+
+	t.Run("unhardened service", func(t *testing.T) {
+		u, err := s.Unit("not-enabled.service")
+		require.NoError(t, err)
+		exposure := u.ExecSettings().Exposure()
+		assert.Equal(t, 9.6, exposure.Score)
+		assert.Equal(t, "UNSAFE", exposure.Level)
+	})
+
+	t.Run("partially hardened service", func(t *testing.T) {
+		u, err := s.Unit("nginx.service")
+		require.NoError(t, err)
+		exposure := u.ExecSettings().Exposure()
+		assert.Equal(t, 5.9, exposure.Score)
+		assert.Equal(t, "MEDIUM", exposure.Level)
+	})
+
+	t.Run("hardened service", func(t *testing.T) {
+		u, err := s.Unit("hardened.service")
+		require.NoError(t, err)
+		settings := u.ExecSettings()
+		// dynamic users imply further settings
+		assert.True(t, settings.RestrictSUIDSGID)
+		assert.Empty(t, settings.CapabilityBoundingSet)
+
+		exposure := settings.Exposure()
+		assert.Equal(t, 0.1, exposure.Score)
+		assert.Equal(t, "SAFE", exposure.Level)
+		// only the AF_UNIX sockets are allowed
+		for _, check := range exposure.Checks {
+			if check.Name == "RestrictAddressFamilies=~AF_UNIX" {
+				assert.Equal(t, uint64(1), check.Badness)
+			} else {
+				assert.Equal(t, uint64(0), check.Badness, check.Name)
+			}
+		}
+	})
+}
+
+func TestListAllows(t *testing.T) {
+	filter := []string{"@system-service", "~@privileged @resources"}
+	assert.True(t, listAllows(filter, "@system-service", systemCallGroups))
+	assert.False(t, listAllows(filter, "@privileged", systemCallGroups))
+	assert.False(t, listAllows(filter, "@clock", systemCallGroups))
+	assert.False(t, listAllows(filter, "@mount", systemCallGroups))
+
+	assert.True(t, listAllows(nil, "AF_INET", nil))
+	assert.True(t, listAllows([]string{"AF_UNIX AF_INET"}, "AF_INET", nil))
+	assert.False(t, listAllows([]string{"AF_UNIX"}, "AF_INET", nil))
+	assert.False(t, listAllows([]string{"~AF_PACKET"}, "AF_PACKET", nil))
+	assert.True(t, listAllows([]string{"~AF_PACKET"}, "AF_INET", nil))
+}
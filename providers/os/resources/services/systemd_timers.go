@@ -0,0 +1,245 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/unit"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+)
+
+// systemdTimerStampPath holds the stamp files of persistent timers, their
+// modification time is the last time the timer triggered
+const systemdTimerStampPath = "/var/lib/systemd/timers"
+
+// Timer is a systemd timer unit, which activates another unit on a schedule
+type Timer struct {
+	// Name is the name of the timer including the .timer extension
+	Name        string
+	Description string
+	// Unit is the unit that is activated by the timer
+	Unit string
+	// OnCalendar are the calendar events that trigger the timer
+	OnCalendar []string
+	// Monotonic are the triggers relative to an event, e.g. OnBootSec=5min
+	Monotonic  []string
+	Persistent bool
+	Installed  bool
+	Enabled    bool
+	Masked     bool
+	// LastTrigger and NextTrigger are nil if they are unknown
+	LastTrigger *time.Time
+	NextTrigger *time.Time
+}
+
+// SystemdTimers returns the timers of the system. The trigger times are read
+// from systemd if commands can be run. Otherwise only the last trigger of
+// persistent timers is known.
+func SystemdTimers(conn shared.Connection) ([]*Timer, error) {
+	s := &SystemdFSServiceManager{Fs: conn.FileSystem()}
+	timers, err := s.Timers()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(timers) == 0 || !conn.Capabilities().Has(shared.Capability_RunCommand) {
+		return timers, nil
+	}
+
+	names := make([]string, len(timers))
+	for i := range timers {
+		names[i] = timers[i].Name
+	}
+	states, err := systemdTimerStates(conn, names)
+	if err != nil {
+		log.Debug().Err(err).Msg("could not read the state of systemd timers")
+		return timers, nil
+	}
+	for _, timer := range timers {
+		state, ok := states[timer.Name]
+		if !ok {
+			continue
+		}
+		timer.LastTrigger = parseSystemdTimestamp(state["LastTriggerUSec"])
+		timer.NextTrigger = parseSystemdTimestamp(state["NextElapseUSecRealtime"])
+	}
+	return timers, nil
+}
+
+// Timers returns the timer units in the unit search path and the timers that
+// are enabled, but not installed
+func (s *SystemdFSServiceManager) Timers() ([]*Timer, error) {
+	enabledUnits, err := s.traverse()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+	for _, p := range systemdUnitSearchPath {
+		files, err := afero.ReadDir(s.Fs, p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, f := range files {
+			// templates are only run through their instances
+			if f.IsDir() || !strings.HasSuffix(f.Name(), ".timer") || strings.HasSuffix(f.Name(), "@.timer") {
+				continue
+			}
+			names[f.Name()] = struct{}{}
+		}
+	}
+	for name, v := range enabledUnits {
+		if v.uType == "timer" {
+			names[name] = struct{}{}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	timers := make([]*Timer, 0, len(sorted))
+	for _, name := range sorted {
+		uInfo, ok := enabledUnits[name]
+		if !ok {
+			uInfo, err = s.findUnit(name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		timer := &Timer{
+			Name:        name,
+			Description: uInfo.description,
+			Unit:        uInfo.service,
+			OnCalendar:  uInfo.onCalendar,
+			Monotonic:   uInfo.monotonic,
+			Persistent:  uInfo.persistent,
+			Installed:   !uInfo.missing,
+			Enabled:     !uInfo.missing && uInfo.isDep,
+			Masked:      uInfo.masked,
+		}
+		if timer.Unit == "" {
+			timer.Unit = uInfo.name + ".service"
+		}
+		if stat, err := s.Fs.Stat(path.Join(systemdTimerStampPath, "stamp-"+name)); err == nil {
+			lastTrigger := stat.ModTime()
+			timer.LastTrigger = &lastTrigger
+		}
+		timers = append(timers, timer)
+	}
+	return timers, nil
+}
+
+// readTimerOption reads an option of the Timer section of a unit file
+func readTimerOption(o *unit.UnitOption, uInfo *unitInfo) {
+	switch o.Name {
+	case "Unit":
+		uInfo.service = o.Value
+	case "Persistent":
+		uInfo.persistent = parseSystemdBool(o.Value)
+	case "OnCalendar":
+		// an empty value resets the list
+		if o.Value == "" {
+			uInfo.onCalendar = nil
+		} else {
+			uInfo.onCalendar = append(uInfo.onCalendar, o.Value)
+		}
+	case "OnActiveSec", "OnBootSec", "OnStartupSec", "OnUnitActiveSec", "OnUnitInactiveSec":
+		if o.Value == "" {
+			uInfo.monotonic = nil
+		} else {
+			uInfo.monotonic = append(uInfo.monotonic, o.Name+"="+o.Value)
+		}
+	}
+}
+
+func parseSystemdBool(s string) bool {
+	switch strings.ToLower(s) {
+	case "1", "yes", "y", "true", "t", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+const systemdTimerProperties = "--property=Id,LastTriggerUSec,NextElapseUSecRealtime"
+
+// systemdTimerStates reads the properties of the timers from systemd, indexed by the unit name
+func systemdTimerStates(conn shared.Connection, names []string) (map[string]map[string]string, error) {
+	// older systemd versions do not support unix timestamps
+	cmd, err := conn.RunCommand("systemctl show --timestamp=unix " + systemdTimerProperties + " " + strings.Join(names, " "))
+	if err == nil && cmd.ExitStatus != 0 {
+		cmd, err = conn.RunCommand("systemctl show " + systemdTimerProperties + " " + strings.Join(names, " "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]map[string]string{}
+	for _, properties := range ParseSystemctlShow(cmd.Stdout) {
+		res[properties["Id"]] = properties
+	}
+	return res, nil
+}
+
+// ParseSystemctlShow parses the output of systemctl show. The properties of
+// multiple units are separated by empty lines.
+func ParseSystemctlShow(r io.Reader) []map[string]string {
+	res := []map[string]string{}
+	var cur map[string]string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			cur = nil
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		if cur == nil {
+			cur = map[string]string{}
+			res = append(res, cur)
+		}
+		cur[key] = value
+	}
+	return res
+}
+
+// parseSystemdTimestamp parses timestamps printed by systemctl, which are
+// either unix timestamps like @1714521603 or dates like
+// Wed 2024-05-01 00:00:03 UTC. It returns nil for unset timestamps.
+func parseSystemdTimestamp(s string) *time.Time {
+	if strings.HasPrefix(s, "@") {
+		sec, err := strconv.ParseInt(s[1:], 10, 64)
+		if err != nil || sec == 0 {
+			return nil
+		}
+		t := time.Unix(sec, 0).UTC()
+		return &t
+	}
+
+	t, err := time.Parse("Mon 2006-01-02 15:04:05 MST", s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers/os/fs"
+)
+
+func TestSystemdFSTimers(t *testing.T) {
+	s := SystemdFSServiceManager{
+		Fs: fs.NewMountedFs("testdata/systemd"),
+	}
+
+	timers, err := s.Timers()
+	require.NoError(t, err)
+	timersMap := map[string]*Timer{}
+	for _, timer := range timers {
+		timersMap[timer.Name] = timer
+	}
+	assert.Len(t, timersMap, 5)
+	assert.NotContains(t, timersMap, "template@.timer")
+
+	require.Contains(t, timersMap, "logrotate.timer")
+	logrotate := timersMap["logrotate.timer"]
+	// the stamp file of persistent timers holds the last trigger
+	assert.NotNil(t, logrotate.LastTrigger)
+	logrotate.LastTrigger = nil
+	assert.Equal(t, &Timer{
+		Name:        "logrotate.timer",
+		Description: "Daily rotation of log files",
+		Unit:        "logrotate.service",
+		OnCalendar:  []string{"daily"},
+		Persistent:  true,
+		Installed:   true,
+		Enabled:     true,
+	}, logrotate)
+
+	assert.Equal(t, &Timer{
+		Name:        "cleanup.timer",
+		Description: "Cleanup of temporary files",
+		Unit:        "tmp-cleanup.service",
+		OnCalendar:  []string{"*-*-* 03:00:00"},
+		Monotonic:   []string{"OnBootSec=5min", "OnUnitActiveSec=1h"},
+		Installed:   true,
+		Enabled:     true,
+	}, timersMap["cleanup.timer"])
+
+	assert.Equal(t, &Timer{
+		Name:        "not-enabled.timer",
+		Description: "Not Enabled Timer",
+		Unit:        "not-enabled.service",
+		OnCalendar:  []string{"hourly"},
+		Installed:   true,
+	}, timersMap["not-enabled.timer"])
+
+	assert.Equal(t, &Timer{
+		Name:      "fstrim.timer",
+		Unit:      "fstrim.service",
+		Installed: true,
+		Masked:    true,
+	}, timersMap["fstrim.timer"])
+
+	assert.Equal(t, &Timer{
+		Name: "missing.timer",
+		Unit: "missing.service",
+	}, timersMap["missing.timer"])
+}
+
+func TestParseSystemctlShow(t *testing.T) {
+	output := `Id=logrotate.timer
+LastTriggerUSec=@1714521603
+NextElapseUSecRealtime=@1714608000
+
+Id=fstrim.timer
+LastTriggerUSec=n/a
+NextElapseUSecRealtime=Mon 2024-05-06 00:40:11 UTC
+`
+	states := ParseSystemctlShow(strings.NewReader(output))
+	require.Len(t, states, 2)
+	assert.Equal(t, "logrotate.timer", states[0]["Id"])
+	assert.Equal(t, "fstrim.timer", states[1]["Id"])
+
+	last := parseSystemdTimestamp(states[0]["LastTriggerUSec"])
+	require.NotNil(t, last)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 3, 0, time.UTC), *last)
+
+	assert.Nil(t, parseSystemdTimestamp(states[1]["LastTriggerUSec"]))
+	next := parseSystemdTimestamp(states[1]["NextElapseUSecRealtime"])
+	require.NotNil(t, next)
+	assert.True(t, time.Date(2024, 5, 6, 0, 40, 11, 0, time.UTC).Equal(*next))
+}
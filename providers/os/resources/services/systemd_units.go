@@ -0,0 +1,250 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-systemd/unit"
+	"github.com/spf13/afero"
+)
+
+// Unit is a systemd unit, read from its unit file and drop-ins
+type Unit struct {
+	// Name is the name of the unit including the type extension
+	Name string
+	// Path of the unit file, empty if the unit is masked
+	Path string
+	// DropIns are the paths of the drop-in files in the order they are applied
+	DropIns []string
+	Masked  bool
+	// Options by section and name. Every assignment adds a value, empty
+	// assignments reset the list like they do in systemd.
+	Options map[string]map[string][]string
+}
+
+// Value returns the last value of the option
+func (u *Unit) Value(section string, name string) string {
+	values := u.Options[section][name]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// Values returns all values of the option
+func (u *Unit) Values(section string, name string) []string {
+	return u.Options[section][name]
+}
+
+// Type returns the type of the unit, e.g. service
+func (u *Unit) Type() string {
+	_, uType, _ := unitNameAndType(u.Name)
+	return uType
+}
+
+// Unit reads the unit file of the named unit and its drop-ins. Instances of
+// templates, like getty@tty1.service, are read from the template.
+func (s *SystemdFSServiceManager) Unit(name string) (*Unit, error) {
+	u := &Unit{
+		Name:    name,
+		Options: map[string]map[string][]string{},
+	}
+
+	names := []string{name}
+	if template, ok := unitTemplate(name); ok {
+		names = append(names, template)
+	}
+
+	for _, searchPath := range systemdUnitSearchPath {
+		for _, n := range names {
+			unitPath := path.Join(searchPath, n)
+			target, err := s.resolveLink(unitPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, err
+			}
+			if target == "/dev/null" {
+				u.Masked = true
+				return u, nil
+			}
+			if err := u.apply(s.Fs, target); err != nil {
+				return nil, err
+			}
+			u.Path = unitPath
+			break
+		}
+		if u.Path != "" {
+			break
+		}
+	}
+	if u.Path == "" {
+		return nil, errors.New("cannot find systemd unit " + name)
+	}
+
+	dropIns, err := s.findDropIns(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, dropIn := range dropIns {
+		target, err := s.resolveLink(dropIn)
+		if err != nil {
+			return nil, err
+		}
+		if target == "/dev/null" {
+			continue
+		}
+		if err := u.apply(s.Fs, target); err != nil {
+			return nil, err
+		}
+		u.DropIns = append(u.DropIns, dropIn)
+	}
+
+	return u, nil
+}
+
+// UnitFiles returns the names of all units of the given type in the unit
+// search path, without templates
+func (s *SystemdFSServiceManager) UnitFiles(uType string) ([]string, error) {
+	names := map[string]struct{}{}
+	for _, p := range systemdUnitSearchPath {
+		files, err := afero.ReadDir(s.Fs, p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, f := range files {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), "."+uType) || strings.HasSuffix(f.Name(), "@."+uType) {
+				continue
+			}
+			names[f.Name()] = struct{}{}
+		}
+	}
+
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func (u *Unit) apply(fs afero.Fs, unitPath string) error {
+	f, err := fs.Open(unitPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	opts, err := unit.Deserialize(f)
+	if err != nil {
+		return err
+	}
+	for _, o := range opts {
+		section, ok := u.Options[o.Section]
+		if !ok {
+			section = map[string][]string{}
+			u.Options[o.Section] = section
+		}
+		if o.Value == "" {
+			section[o.Name] = []string{}
+			continue
+		}
+		section[o.Name] = append(section[o.Name], o.Value)
+	}
+	return nil
+}
+
+// resolveLink returns the target of a symlink or the path itself
+func (s *SystemdFSServiceManager) resolveLink(unitPath string) (string, error) {
+	if _, err := s.Fs.Stat(unitPath); err != nil {
+		// masked units link to /dev/null, which may not exist in the file system
+		if lr, ok := s.Fs.(afero.LinkReader); ok {
+			if linkPath, lerr := lr.ReadlinkIfPossible(unitPath); lerr == nil && linkPath == "/dev/null" {
+				return linkPath, nil
+			}
+		}
+		return "", err
+	}
+
+	if lr, ok := s.Fs.(afero.LinkReader); ok {
+		linkPath, err := lr.ReadlinkIfPossible(unitPath)
+		if err == nil {
+			if linkPath == "/dev/null" || filepath.IsAbs(linkPath) {
+				return linkPath, nil
+			}
+			return filepath.Join(filepath.Dir(unitPath), linkPath), nil
+		}
+	}
+	return unitPath, nil
+}
+
+// findDropIns returns the .conf files in the drop-in directories of the units
+// and their type, e.g. foo.service.d and service.d. Files with the same name
+// in directories with higher priority override the others, all files are
+// applied in lexical order.
+func (s *SystemdFSServiceManager) findDropIns(names []string) ([]string, error) {
+	dirs := []string{}
+	if idx := strings.LastIndex(names[0], "."); idx >= 0 {
+		dirs = append(dirs, names[0][idx+1:]+".d")
+	}
+	// templates have a lower priority than their instances
+	for i := len(names) - 1; i >= 0; i-- {
+		dirs = append(dirs, names[i]+".d")
+	}
+
+	files := map[string]string{}
+	for i := len(systemdUnitSearchPath) - 1; i >= 0; i-- {
+		for _, dir := range dirs {
+			dropInDir := path.Join(systemdUnitSearchPath[i], dir)
+			entries, err := afero.ReadDir(s.Fs, dropInDir)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, err
+			}
+			for _, entry := range entries {
+				if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".conf") {
+					continue
+				}
+				files[entry.Name()] = path.Join(dropInDir, entry.Name())
+			}
+		}
+	}
+
+	names = make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]string, len(names))
+	for i, name := range names {
+		res[i] = files[name]
+	}
+	return res, nil
+}
+
+// unitTemplate returns the template of a unit instance, e.g. getty@.service for getty@tty1.service
+func unitTemplate(name string) (string, bool) {
+	prefix, rest, ok := strings.Cut(name, "@")
+	if !ok {
+		return "", false
+	}
+	idx := strings.LastIndex(rest, ".")
+	if idx <= 0 {
+		return "", false
+	}
+	return prefix + "@" + rest[idx:], true
+}
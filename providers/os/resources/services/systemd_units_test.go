@@ -0,0 +1,76 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v11/providers/os/fs"
+)
+
+func TestSystemdUnit(t *testing.T) {
+	s := SystemdFSServiceManager{
+		Fs: fs.NewMountedFs("testdata/systemd"),
+	}
+
+	t.Run("drop-ins", func(t *testing.T) {
+		u, err := s.Unit("nginx.service")
+		require.NoError(t, err)
+		assert.Equal(t, "/usr/lib/systemd/system/nginx.service", u.Path)
+		assert.Equal(t, "service", u.Type())
+		// drop-ins in /etc override the ones with the same name in /usr/lib
+		assert.Equal(t, []string{
+			"/etc/systemd/system/nginx.service.d/10-hardening.conf",
+			"/usr/lib/systemd/system/service.d/10-timeout.conf",
+			"/etc/systemd/system/nginx.service.d/20-exec.conf",
+		}, u.DropIns)
+		assert.Equal(t, "full", u.Value("Service", "ProtectSystem"))
+		assert.Equal(t, "30", u.Value("Service", "TimeoutStopSec"))
+		// empty assignments reset lists
+		assert.Equal(t, []string{"/usr/local/sbin/nginx -g 'daemon on;'"}, u.Values("Service", "ExecStart"))
+		assert.Equal(t, "A high performance web server and a reverse proxy server", u.Value("Unit", "Description"))
+		assert.Equal(t, "", u.Value("Service", "User2"))
+
+		settings := u.ExecSettings()
+		assert.Equal(t, "www-data", settings.User)
+		assert.Equal(t, "full", settings.ProtectSystem)
+		assert.Equal(t, "no", settings.ProtectHome)
+		assert.True(t, settings.PrivateTmp)
+		assert.True(t, settings.NoNewPrivileges)
+		assert.Equal(t, []string{"CAP_NET_BIND_SERVICE", "CAP_SETUID", "CAP_SETGID", "CAP_CHOWN"}, settings.CapabilityBoundingSet)
+	})
+
+	t.Run("template instance", func(t *testing.T) {
+		u, err := s.Unit("getty@tty1.service")
+		require.NoError(t, err)
+		assert.Equal(t, "/usr/lib/systemd/system/getty@.service", u.Path)
+		assert.Equal(t, []string{
+			"/usr/lib/systemd/system/service.d/10-timeout.conf",
+			"/etc/systemd/system/getty@tty1.service.d/autologin.conf",
+		}, u.DropIns)
+		assert.Equal(t, []string{"-/sbin/agetty --autologin root --noclear %I $TERM"}, u.Values("Service", "ExecStart"))
+	})
+
+	t.Run("masked", func(t *testing.T) {
+		u, err := s.Unit("masked.service")
+		require.NoError(t, err)
+		assert.True(t, u.Masked)
+		assert.Empty(t, u.Path)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := s.Unit("missing.service")
+		assert.Error(t, err)
+	})
+
+	t.Run("unit files", func(t *testing.T) {
+		names, err := s.UnitFiles("service")
+		require.NoError(t, err)
+		assert.Contains(t, names, "nginx.service")
+		assert.Contains(t, names, "masked.service")
+		assert.NotContains(t, names, "getty@.service")
+	})
+}
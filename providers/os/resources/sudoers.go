@@ -0,0 +1,240 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/sudoers"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+const defaultSudoersConfig = "/etc/sudoers"
+
+type mqlSudoersInternal struct {
+	lock sync.Mutex
+}
+
+func initSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		path, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("wrong type for 'path' in sudoers initialization, it must be a string")
+		}
+
+		f, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(path),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		args["file"] = llx.ResourceData(f, "file")
+
+		delete(args, "path")
+	}
+
+	return args, nil, nil
+}
+
+func (s *mqlSudoers) id() (string, error) {
+	file := s.GetFile()
+	if file.Error != nil {
+		return "", file.Error
+	}
+
+	return file.Data.Path.Data, nil
+}
+
+func (s *mqlSudoers) file() (*mqlFile, error) {
+	f, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(defaultSudoersConfig),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.(*mqlFile), nil
+}
+
+// sudoersReader reads the sudo configuration via file resources, so that
+// they can be returned as the files of the configuration
+type sudoersReader struct {
+	runtime  *plugin.Runtime
+	filesIdx map[string]*mqlFile
+}
+
+func (r *sudoersReader) file(path string) (*mqlFile, error) {
+	if file, ok := r.filesIdx[path]; ok {
+		return file, nil
+	}
+
+	raw, err := CreateResource(r.runtime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	file := raw.(*mqlFile)
+	r.filesIdx[path] = file
+	return file, nil
+}
+
+func (r *sudoersReader) ReadFile(path string) (string, error) {
+	file, err := r.file(path)
+	if err != nil {
+		return "", err
+	}
+	content := file.GetContent()
+	return content.Data, content.Error
+}
+
+func (r *sudoersReader) ReadDir(path string) ([]string, error) {
+	conn := r.runtime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	entries, err := afs.ReadDir(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(entries))
+	for i := range entries {
+		if !entries[i].IsDir() {
+			res = append(res, entries[i].Name())
+		}
+	}
+	return res, nil
+}
+
+func (s *mqlSudoers) parse(file *mqlFile) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if file == nil {
+		return errors.New("no base sudoers file to read")
+	}
+
+	// systems without sudo have no configuration
+	exists := file.GetExists()
+	if exists.Error != nil {
+		return exists.Error
+	}
+	if !exists.Data {
+		s.Files = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+		s.Aliases = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+		s.Defaults = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+		s.UserSpecs = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+		return nil
+	}
+
+	reader := &sudoersReader{
+		runtime:  s.MqlRuntime,
+		filesIdx: map[string]*mqlFile{file.Path.Data: file},
+	}
+	config, err := sudoers.Parse(file.Path.Data, reader)
+	if err != nil {
+		s.Files = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Aliases = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.Defaults = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		s.UserSpecs = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+		return err
+	}
+
+	files := make([]any, len(config.Files))
+	for i, path := range config.Files {
+		files[i] = reader.filesIdx[path]
+	}
+	s.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+
+	aliases := make([]any, len(config.Aliases))
+	for i, alias := range config.Aliases {
+		obj, err := CreateResource(s.MqlRuntime, "sudoers.alias", map[string]*llx.RawData{
+			"__id":       llx.StringData(sudoersEntryID(s.__id, alias.File, alias.LineNumber, i)),
+			"type":       llx.StringData(alias.Type),
+			"name":       llx.StringData(alias.Name),
+			"members":    llx.ArrayData(llx.TArr2Raw(alias.Members), types.String),
+			"file":       llx.ResourceData(reader.filesIdx[alias.File], "file"),
+			"lineNumber": llx.IntData(alias.LineNumber),
+		})
+		if err != nil {
+			return err
+		}
+		aliases[i] = obj
+	}
+	s.Aliases = plugin.TValue[[]any]{Data: aliases, State: plugin.StateIsSet}
+
+	defaults := make([]any, len(config.Defaults))
+	for i, d := range config.Defaults {
+		obj, err := CreateResource(s.MqlRuntime, "sudoers.default", map[string]*llx.RawData{
+			"__id":       llx.StringData(sudoersEntryID(s.__id, d.File, d.LineNumber, i)),
+			"binding":    llx.StringData(d.Binding),
+			"targets":    llx.ArrayData(llx.TArr2Raw(d.Targets), types.String),
+			"name":       llx.StringData(d.Name),
+			"operator":   llx.StringData(d.Operator),
+			"value":      llx.StringData(d.Value),
+			"negated":    llx.BoolData(d.Negated),
+			"file":       llx.ResourceData(reader.filesIdx[d.File], "file"),
+			"lineNumber": llx.IntData(d.LineNumber),
+		})
+		if err != nil {
+			return err
+		}
+		defaults[i] = obj
+	}
+	s.Defaults = plugin.TValue[[]any]{Data: defaults, State: plugin.StateIsSet}
+
+	specs := make([]any, len(config.UserSpecs))
+	for i, spec := range config.UserSpecs {
+		obj, err := CreateResource(s.MqlRuntime, "sudoers.userSpec", map[string]*llx.RawData{
+			"__id":        llx.StringData(sudoersEntryID(s.__id, spec.File, spec.LineNumber, i)),
+			"users":       llx.ArrayData(llx.TArr2Raw(spec.Users), types.String),
+			"hosts":       llx.ArrayData(llx.TArr2Raw(spec.Hosts), types.String),
+			"runasUsers":  llx.ArrayData(llx.TArr2Raw(spec.RunasUsers), types.String),
+			"runasGroups": llx.ArrayData(llx.TArr2Raw(spec.RunasGroups), types.String),
+			"commands":    llx.ArrayData(llx.TArr2Raw(spec.Commands), types.String),
+			"tags":        llx.ArrayData(llx.TArr2Raw(spec.Tags), types.String),
+			"noPassword":  llx.BoolData(spec.NoPassword),
+			"file":        llx.ResourceData(reader.filesIdx[spec.File], "file"),
+			"lineNumber":  llx.IntData(spec.LineNumber),
+		})
+		if err != nil {
+			return err
+		}
+		specs[i] = obj
+	}
+	s.UserSpecs = plugin.TValue[[]any]{Data: specs, State: plugin.StateIsSet}
+
+	return nil
+}
+
+// sudoersEntryID identifies an entry by its position, since a line may
+// contain multiple entries
+func sudoersEntryID(ownerID string, file string, line int, idx int) string {
+	return ownerID + "\x00" + file + ":" + strconv.Itoa(line) + "\x00" + strconv.Itoa(idx)
+}
+
+func (s *mqlSudoers) files(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlSudoers) aliases(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlSudoers) defaults(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlSudoers) userSpecs(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
@@ -0,0 +1,500 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sudoers
+
+import (
+	"errors"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// sudo stops at the same depth to detect include loops
+const maxIncludeDepth = 128
+
+// Sudoers is the parsed sudo configuration across all included files
+type Sudoers struct {
+	// Files in the order they were read
+	Files     []string
+	Aliases   []*Alias
+	Defaults  []*Default
+	UserSpecs []*UserSpec
+}
+
+// Alias is a named list of users, hosts, runas users or commands
+type Alias struct {
+	// Type of the alias, e.g. User_Alias or Cmnd_Alias
+	Type       string
+	Name       string
+	Members    []string
+	File       string
+	LineNumber int
+}
+
+// Default is an option set by a Defaults entry
+type Default struct {
+	// Binding type of the entry: "", "host", "user", "runas" or "command"
+	Binding string
+	// Hosts, users, runas users or commands the entry is bound to
+	Targets []string
+	Name    string
+	// Operator for options with a value: =, += or -=
+	Operator string
+	Value    string
+	// Negated options are disabled, e.g. !authenticate
+	Negated    bool
+	File       string
+	LineNumber int
+}
+
+// UserSpec is a user specification, i.e. the rule which users may run which
+// commands as which users on which hosts
+type UserSpec struct {
+	Users       []string
+	Hosts       []string
+	RunasUsers  []string
+	RunasGroups []string
+	Commands    []string
+	// Tags like NOPASSWD or SETENV that apply to the commands
+	Tags []string
+	// NoPassword is set if the commands can be run without authentication
+	NoPassword bool
+	File       string
+	LineNumber int
+}
+
+// Reader provides the content of sudoers files and the entries of included directories
+type Reader interface {
+	ReadFile(path string) (string, error)
+	// ReadDir returns the file names in the directory, it returns no error
+	// if the directory does not exist
+	ReadDir(path string) ([]string, error)
+}
+
+// Parse reads the sudoers file at path and all files it includes
+func Parse(path string, r Reader) (*Sudoers, error) {
+	res := &Sudoers{}
+	if err := res.parseFile(path, r, 0); err != nil {
+		return nil, err
+	}
+
+	// disabling authentication globally has the same effect as NOPASSWD
+	authenticate := true
+	for _, d := range res.Defaults {
+		if d.Binding == "" && d.Name == "authenticate" && d.Operator == "" {
+			authenticate = !d.Negated
+		}
+	}
+	if !authenticate {
+		for _, spec := range res.UserSpecs {
+			if !slices.Contains(spec.Tags, "PASSWD") {
+				spec.NoPassword = true
+			}
+		}
+	}
+
+	return res, nil
+}
+
+var reInclude = regexp.MustCompile(`^[#@](include|includedir)\s+(.+)$`)
+
+func (s *Sudoers) parseFile(path string, r Reader, depth int) error {
+	if depth > maxIncludeDepth {
+		return errors.New("too many levels of includes in " + path)
+	}
+
+	content, err := r.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	s.Files = append(s.Files, path)
+
+	for _, line := range logicalLines(content) {
+		if m := reInclude.FindStringSubmatch(line.text); m != nil {
+			include := unquote(strings.TrimSpace(m[2]))
+			// relative paths are relative to the directory of the current file
+			if !filepath.IsAbs(include) {
+				include = filepath.Join(filepath.Dir(path), include)
+			}
+
+			if m[1] == "include" {
+				if err := s.parseFile(include, r, depth+1); err != nil {
+					return err
+				}
+				continue
+			}
+
+			files, err := includedFiles(include, r)
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				if err := s.parseFile(file, r, depth+1); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		text := stripComment(line.text)
+		if text == "" {
+			continue
+		}
+		s.parseLine(text, path, line.number)
+	}
+	return nil
+}
+
+// includedFiles returns the files of an included directory in lexical order,
+// sudo skips files that end in ~ or contain a dot
+func includedFiles(dir string, r Reader) ([]string, error) {
+	names, err := r.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	res := []string{}
+	for _, name := range names {
+		if strings.HasSuffix(name, "~") || strings.Contains(name, ".") {
+			continue
+		}
+		res = append(res, filepath.Join(dir, name))
+	}
+	return res, nil
+}
+
+type logicalLine struct {
+	text   string
+	number int
+}
+
+// logicalLines joins lines that end with a backslash, the line number is the
+// one of the first line
+func logicalLines(content string) []logicalLine {
+	res := []logicalLine{}
+	var cur strings.Builder
+	start := 0
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if cur.Len() == 0 {
+			start = i + 1
+		}
+		if strings.HasSuffix(line, "\\") {
+			cur.WriteString(strings.TrimSuffix(line, "\\"))
+			cur.WriteString(" ")
+			continue
+		}
+		cur.WriteString(line)
+		res = append(res, logicalLine{text: strings.TrimSpace(cur.String()), number: start})
+		cur.Reset()
+	}
+	return res
+}
+
+// stripComment removes comments from the line. A # followed by digits is a
+// user or group id and not a comment.
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '#':
+			if i+1 < len(line) && line[i+1] >= '0' && line[i+1] <= '9' {
+				continue
+			}
+			return strings.TrimSpace(line[:i])
+		}
+	}
+	return line
+}
+
+var aliasTypes = map[string]struct{}{
+	"User_Alias":  {},
+	"Runas_Alias": {},
+	"Host_Alias":  {},
+	"Cmnd_Alias":  {},
+	"Cmd_Alias":   {},
+}
+
+var defaultsBindings = map[byte]string{
+	'@': "host",
+	':': "user",
+	'>': "runas",
+	'!': "command",
+}
+
+func (s *Sudoers) parseLine(line string, file string, lineNumber int) {
+	keyword, rest := cutField(line)
+
+	if _, ok := aliasTypes[keyword]; ok {
+		s.parseAliases(keyword, rest, file, lineNumber)
+		return
+	}
+
+	if keyword == "Defaults" {
+		s.parseDefaults("", []string{}, rest, file, lineNumber)
+		return
+	}
+	if strings.HasPrefix(keyword, "Defaults") && defaultsBindings[keyword[8]] != "" {
+		// the binding list may contain whitespace after commas
+		targets, rest := cutList(line[len("Defaults")+1:])
+		s.parseDefaults(defaultsBindings[keyword[8]], targets, rest, file, lineNumber)
+		return
+	}
+
+	s.parseUserSpec(line, file, lineNumber)
+}
+
+// parseAliases parses one or more aliases, e.g. NAME1 = a, b : NAME2 = c
+func (s *Sudoers) parseAliases(typ string, line string, file string, lineNumber int) {
+	for _, def := range splitTopLevel(line, ':') {
+		name, members, ok := strings.Cut(def, "=")
+		if !ok {
+			continue
+		}
+		s.Aliases = append(s.Aliases, &Alias{
+			Type:       typ,
+			Name:       strings.TrimSpace(name),
+			Members:    splitList(members),
+			File:       file,
+			LineNumber: lineNumber,
+		})
+	}
+}
+
+func (s *Sudoers) parseDefaults(binding string, targets []string, line string, file string, lineNumber int) {
+	for _, option := range splitTopLevel(line, ',') {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+
+		d := &Default{
+			Binding:    binding,
+			Targets:    targets,
+			File:       file,
+			LineNumber: lineNumber,
+		}
+		if idx := strings.IndexAny(option, "+-="); idx > 0 && strings.Contains(option, "=") {
+			name := option[:idx]
+			d.Operator = "="
+			if option[idx] != '=' {
+				d.Operator = option[idx : idx+2]
+			}
+			d.Name = strings.TrimSpace(name)
+			d.Value = unquote(strings.TrimSpace(option[idx+len(d.Operator):]))
+		} else {
+			d.Name = strings.TrimLeft(option, "!")
+			d.Negated = (len(option)-len(d.Name))%2 == 1
+		}
+		s.Defaults = append(s.Defaults, d)
+	}
+}
+
+var tags = map[string]struct{}{
+	"NOPASSWD": {}, "PASSWD": {},
+	"NOEXEC": {}, "EXEC": {},
+	"SETENV": {}, "NOSETENV": {},
+	"LOG_INPUT": {}, "NOLOG_INPUT": {},
+	"LOG_OUTPUT": {}, "NOLOG_OUTPUT": {},
+	"MAIL": {}, "NOMAIL": {},
+	"FOLLOW": {}, "NOFOLLOW": {},
+	"INTERCEPT": {}, "NOINTERCEPT": {},
+}
+
+// options that may precede a command after the runas specification
+var reCommandOption = regexp.MustCompile(`^(ROLE|TYPE|CHROOT|CWD|TIMEOUT|NOTBEFORE|NOTAFTER|APPARMOR_PROFILE|PRIVS|LIMITPRIVS)=\S*\s*`)
+
+// parseUserSpec parses a user specification:
+// User_List Host_List = Cmnd_Spec_List [: Host_List = Cmnd_Spec_List]...
+func (s *Sudoers) parseUserSpec(line string, file string, lineNumber int) {
+	users, rest := cutList(line)
+	if len(users) == 0 {
+		return
+	}
+
+	for _, hostSpec := range splitHostSpecs(rest) {
+		hostList, cmndSpecs, ok := strings.Cut(hostSpec, "=")
+		if !ok {
+			continue
+		}
+		hosts := splitList(hostList)
+
+		// runas and tags apply to all following commands, until they are
+		// changed. Commands with the same runas and tags form a spec.
+		var spec *UserSpec
+		runasUsers, runasGroups := []string{"root"}, []string{}
+		activeTags := []string{}
+		for _, cmnd := range splitTopLevel(cmndSpecs, ',') {
+			cmnd = strings.TrimSpace(cmnd)
+			changed := spec == nil
+
+			if strings.HasPrefix(cmnd, "(") {
+				end := strings.Index(cmnd, ")")
+				if end < 0 {
+					continue
+				}
+				runasUsers, runasGroups = parseRunas(cmnd[1:end])
+				cmnd = strings.TrimSpace(cmnd[end+1:])
+				changed = true
+			}
+
+			for {
+				if m := reCommandOption.FindString(cmnd); m != "" {
+					cmnd = cmnd[len(m):]
+					continue
+				}
+				tag, rest, ok := strings.Cut(cmnd, ":")
+				if _, isTag := tags[tag]; !ok || !isTag {
+					break
+				}
+				activeTags = setTag(activeTags, tag)
+				cmnd = strings.TrimSpace(rest)
+				changed = true
+			}
+
+			if changed {
+				spec = &UserSpec{
+					Users:       users,
+					Hosts:       hosts,
+					RunasUsers:  runasUsers,
+					RunasGroups: runasGroups,
+					Tags:        slices.Clone(activeTags),
+					NoPassword:  slices.Contains(activeTags, "NOPASSWD"),
+					File:        file,
+					LineNumber:  lineNumber,
+				}
+				s.UserSpecs = append(s.UserSpecs, spec)
+			}
+			if cmnd != "" {
+				spec.Commands = append(spec.Commands, cmnd)
+			}
+		}
+	}
+}
+
+// setTag adds the tag and removes its opposite, e.g. PASSWD for NOPASSWD
+func setTag(list []string, tag string) []string {
+	opposite := "NO" + tag
+	if strings.HasPrefix(tag, "NO") {
+		opposite = strings.TrimPrefix(tag, "NO")
+	}
+	list = slices.DeleteFunc(list, func(t string) bool { return t == tag || t == opposite })
+	return append(list, tag)
+}
+
+// parseRunas parses the runas specification (users : groups)
+func parseRunas(runas string) ([]string, []string) {
+	users, groups, _ := strings.Cut(runas, ":")
+	return splitList(users), splitList(groups)
+}
+
+// splitHostSpecs splits the host specifications of a user spec. They are
+// separated by colons, which are also used by tags and runas specifications,
+// e.g. ALL = (root:wheel) NOPASSWD: /bin/ls : host2 = ALL
+func splitHostSpecs(s string) []string {
+	res := []string{}
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ':':
+			if depth > 0 || i == 0 || !unicode.IsSpace(rune(s[i-1])) {
+				continue
+			}
+			// the next host spec has a host list followed by =
+			next, _, ok := strings.Cut(s[i+1:], "=")
+			if !ok || strings.ContainsAny(strings.TrimSpace(next), "/(:") {
+				continue
+			}
+			res = append(res, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(res, s[start:])
+}
+
+// splitTopLevel splits at the separator unless it is escaped, quoted or in parentheses
+func splitTopLevel(s string, sep byte) []string {
+	res := []string{}
+	depth := 0
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 && !quoted {
+				res = append(res, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(res, s[start:])
+}
+
+// splitList splits a comma separated list and trims its entries
+func splitList(s string) []string {
+	res := []string{}
+	for _, entry := range splitTopLevel(s, ',') {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// cutList reads a comma separated list from the start of s, entries may be
+// separated by commas and whitespace
+func cutList(s string) ([]string, string) {
+	res := []string{}
+	for {
+		var entry string
+		entry, s = cutField(s)
+		for strings.HasSuffix(entry, ",") {
+			var next string
+			next, s = cutField(s)
+			entry += next
+		}
+		res = append(res, splitList(entry)...)
+		if !strings.HasPrefix(s, ",") {
+			return res, s
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+}
+
+// cutField returns the first whitespace separated field and the trimmed rest
+func cutField(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	idx := strings.IndexFunc(s, unicode.IsSpace)
+	if idx < 0 {
+		return s, ""
+	}
+	return s[:idx], strings.TrimSpace(s[idx:])
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
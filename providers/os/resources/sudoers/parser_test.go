@@ -0,0 +1,150 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sudoers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type osReader struct{}
+
+func (osReader) ReadFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	return string(data), err
+}
+
+func (osReader) ReadDir(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, len(entries))
+	for i := range entries {
+		res[i] = entries[i].Name()
+	}
+	return res, nil
+}
+
+func TestParse(t *testing.T) {
+	s, err := Parse("testdata/sudoers", osReader{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"testdata/sudoers", "testdata/sudoers.d/ci", "testdata/sudoers.extra"}, s.Files)
+
+	t.Run("aliases", func(t *testing.T) {
+		require.Len(t, s.Aliases, 4)
+		assert.Equal(t, &Alias{Type: "Host_Alias", Name: "WEBSERVERS", Members: []string{"web1", "web2"}, File: "testdata/sudoers", LineNumber: 11}, s.Aliases[0])
+		assert.Equal(t, "DBSERVERS", s.Aliases[1].Name)
+		// continued lines and user ids
+		assert.Equal(t, &Alias{Type: "User_Alias", Name: "OPERATORS", Members: []string{"alice", "bob", "#1001"}, File: "testdata/sudoers", LineNumber: 12}, s.Aliases[2])
+		assert.Equal(t, []string{"/usr/bin/systemctl restart nginx", "/usr/bin/systemctl reload nginx"}, s.Aliases[3].Members)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		require.Len(t, s.Defaults, 7)
+		assert.Equal(t, &Default{Targets: []string{}, Name: "env_reset", File: "testdata/sudoers", LineNumber: 4}, s.Defaults[0])
+		assert.Equal(t, "secure_path", s.Defaults[2].Name)
+		assert.Equal(t, "=", s.Defaults[2].Operator)
+		assert.Equal(t, "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin", s.Defaults[2].Value)
+
+		assert.Equal(t, &Default{Binding: "user", Targets: []string{"%admin", "bob"}, Name: "lecture", Negated: true, File: "testdata/sudoers", LineNumber: 7}, s.Defaults[3])
+		assert.Equal(t, "timestamp_timeout", s.Defaults[4].Name)
+		assert.Equal(t, "0", s.Defaults[4].Value)
+
+		assert.Equal(t, &Default{Binding: "runas", Targets: []string{"root"}, Name: "set_logname", Negated: true, File: "testdata/sudoers", LineNumber: 8}, s.Defaults[5])
+		assert.Equal(t, "command", s.Defaults[6].Binding)
+		assert.Equal(t, []string{"/usr/bin/less"}, s.Defaults[6].Targets)
+	})
+
+	t.Run("user specs", func(t *testing.T) {
+		require.Len(t, s.UserSpecs, 9)
+		assert.Equal(t, &UserSpec{
+			Users:       []string{"root"},
+			Hosts:       []string{"ALL"},
+			RunasUsers:  []string{"ALL"},
+			RunasGroups: []string{"ALL"},
+			Commands:    []string{"ALL"},
+			Tags:        []string{},
+			File:        "testdata/sudoers",
+			LineNumber:  18,
+		}, s.UserSpecs[0])
+		assert.Equal(t, []string{"%admin"}, s.UserSpecs[1].Users)
+		assert.Equal(t, []string{}, s.UserSpecs[1].RunasGroups)
+
+		// tags apply until they are changed
+		assert.Equal(t, &UserSpec{
+			Users:       []string{"OPERATORS"},
+			Hosts:       []string{"WEBSERVERS"},
+			RunasUsers:  []string{"root"},
+			RunasGroups: []string{},
+			Commands:    []string{"SERVICES"},
+			Tags:        []string{"NOPASSWD"},
+			NoPassword:  true,
+			File:        "testdata/sudoers",
+			LineNumber:  23,
+		}, s.UserSpecs[2])
+		assert.Equal(t, []string{"/usr/bin/journalctl"}, s.UserSpecs[3].Commands)
+		assert.Equal(t, []string{"PASSWD"}, s.UserSpecs[3].Tags)
+		assert.False(t, s.UserSpecs[3].NoPassword)
+
+		// multiple host specs
+		assert.Equal(t, []string{"DBSERVERS"}, s.UserSpecs[4].Hosts)
+		assert.Equal(t, []string{"postgres"}, s.UserSpecs[4].RunasUsers)
+		assert.Equal(t, []string{"/usr/bin/psql"}, s.UserSpecs[4].Commands)
+
+		assert.Equal(t, &UserSpec{
+			Users:       []string{"carol", "dave"},
+			Hosts:       []string{"ALL"},
+			RunasUsers:  []string{"www-data"},
+			RunasGroups: []string{"www-data"},
+			Commands:    []string{"/usr/bin/vim /var/www/*"},
+			Tags:        []string{"NOEXEC", "SETENV"},
+			File:        "testdata/sudoers",
+			LineNumber:  24,
+		}, s.UserSpecs[5])
+		// a new runas keeps the tags, command options are skipped
+		assert.Equal(t, []string{"root"}, s.UserSpecs[6].RunasUsers)
+		assert.Equal(t, []string{"NOEXEC", "SETENV"}, s.UserSpecs[6].Tags)
+		assert.Equal(t, []string{"/usr/bin/ls"}, s.UserSpecs[6].Commands)
+
+		// included files
+		assert.Equal(t, &UserSpec{
+			Users:       []string{"ci"},
+			Hosts:       []string{"ALL"},
+			RunasUsers:  []string{"ALL"},
+			RunasGroups: []string{},
+			Commands:    []string{"ALL"},
+			Tags:        []string{"NOPASSWD"},
+			NoPassword:  true,
+			File:        "testdata/sudoers.d/ci",
+			LineNumber:  2,
+		}, s.UserSpecs[7])
+		assert.Equal(t, []string{"eve"}, s.UserSpecs[8].Users)
+		assert.Equal(t, "testdata/sudoers.extra", s.UserSpecs[8].File)
+		assert.False(t, s.UserSpecs[8].NoPassword)
+	})
+}
+
+func TestParseWithoutAuthentication(t *testing.T) {
+	s, err := Parse("testdata/sudoers.noauth", osReader{})
+	require.NoError(t, err)
+	require.Len(t, s.UserSpecs, 2)
+	assert.True(t, s.UserSpecs[0].NoPassword)
+	assert.False(t, s.UserSpecs[1].NoPassword)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("testdata/sudoers.loop", osReader{})
+	assert.ErrorContains(t, err, "too many levels of includes")
+
+	_, err = Parse("testdata/missing", osReader{})
+	assert.Error(t, err)
+}
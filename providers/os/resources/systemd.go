@@ -0,0 +1,199 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"strings"
+
+	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/services"
+	"go.mondoo.com/cnquery/v11/types"
+)
+
+func (x *mqlSystemdTimers) id() (string, error) {
+	return "systemd.timers", nil
+}
+
+func (x *mqlSystemdTimers) list() ([]any, error) {
+	conn := x.MqlRuntime.Connection.(shared.Connection)
+	timers, err := services.SystemdTimers(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(timers))
+	for i, timer := range timers {
+		obj, err := CreateResource(x.MqlRuntime, "systemd.timer", map[string]*llx.RawData{
+			"name":        llx.StringData(timer.Name),
+			"description": llx.StringData(timer.Description),
+			"unit":        llx.StringData(timer.Unit),
+			"onCalendar":  llx.ArrayData(llx.TArr2Raw(timer.OnCalendar), types.String),
+			"monotonic":   llx.ArrayData(llx.TArr2Raw(timer.Monotonic), types.String),
+			"persistent":  llx.BoolData(timer.Persistent),
+			"installed":   llx.BoolData(timer.Installed),
+			"enabled":     llx.BoolData(timer.Enabled),
+			"masked":      llx.BoolData(timer.Masked),
+			"lastTrigger": llx.TimeDataPtr(timer.LastTrigger),
+			"nextTrigger": llx.TimeDataPtr(timer.NextTrigger),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func (x *mqlSystemdTimer) id() (string, error) {
+	return x.Name.Data, nil
+}
+
+func (x *mqlSystemdTimer) service() (*mqlService, error) {
+	name, ok := strings.CutSuffix(x.Unit.Data, ".service")
+	if !ok {
+		// timers may also activate other units, e.g. targets
+		x.Service.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	res, err := NewResource(x.MqlRuntime, "service", map[string]*llx.RawData{
+		"name": llx.StringData(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlService), nil
+}
+
+func (x *mqlSystemdUnits) id() (string, error) {
+	return "systemd.units", nil
+}
+
+func (x *mqlSystemdUnits) list() ([]any, error) {
+	conn := x.MqlRuntime.Connection.(shared.Connection)
+	s := &services.SystemdFSServiceManager{Fs: conn.FileSystem()}
+	names, err := s.UnitFiles("service")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, 0, len(names))
+	for _, name := range names {
+		unit, err := s.Unit(name)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := CreateResource(x.MqlRuntime, "systemd.unit", systemdUnitArgs(unit))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, obj)
+	}
+	return res, nil
+}
+
+func initSystemdUnit(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if len(args) != 1 {
+		return args, nil, nil
+	}
+	x, ok := args["name"]
+	if !ok {
+		return args, nil, nil
+	}
+	name, ok := x.Value.(string)
+	if !ok {
+		return nil, nil, errors.New("wrong type for 'name' in systemd.unit initialization, it must be a string")
+	}
+	// like systemctl, assume a service if the name has no type
+	if !strings.Contains(name, ".") {
+		name += ".service"
+	}
+
+	conn := runtime.Connection.(shared.Connection)
+	s := &services.SystemdFSServiceManager{Fs: conn.FileSystem()}
+	unit, err := s.Unit(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return systemdUnitArgs(unit), nil, nil
+}
+
+func systemdUnitArgs(unit *services.Unit) map[string]*llx.RawData {
+	options := make(map[string]any, len(unit.Options))
+	for section, opts := range unit.Options {
+		sectionOptions := make(map[string]any, len(opts))
+		for name, values := range opts {
+			sectionOptions[name] = llx.TArr2Raw(values)
+		}
+		options[section] = sectionOptions
+	}
+
+	settings := unit.ExecSettings()
+	args := map[string]*llx.RawData{
+		"name":                    llx.StringData(unit.Name),
+		"path":                    llx.StringData(unit.Path),
+		"dropIns":                 llx.ArrayData(llx.TArr2Raw(unit.DropIns), types.String),
+		"masked":                  llx.BoolData(unit.Masked),
+		"description":             llx.StringData(unit.Value("Unit", "Description")),
+		"options":                 llx.DictData(options),
+		"execStart":               llx.ArrayData(llx.TArr2Raw(settings.ExecStart), types.String),
+		"user":                    llx.StringData(settings.User),
+		"group":                   llx.StringData(settings.Group),
+		"dynamicUser":             llx.BoolData(settings.DynamicUser),
+		"supplementaryGroups":     llx.ArrayData(llx.TArr2Raw(settings.SupplementaryGroups), types.String),
+		"protectSystem":           llx.StringData(settings.ProtectSystem),
+		"protectHome":             llx.StringData(settings.ProtectHome),
+		"privateTmp":              llx.BoolData(settings.PrivateTmp),
+		"privateDevices":          llx.BoolData(settings.PrivateDevices),
+		"privateNetwork":          llx.BoolData(settings.PrivateNetwork),
+		"privateUsers":            llx.BoolData(settings.PrivateUsers),
+		"protectKernelTunables":   llx.BoolData(settings.ProtectKernelTunables),
+		"protectKernelModules":    llx.BoolData(settings.ProtectKernelModules),
+		"protectKernelLogs":       llx.BoolData(settings.ProtectKernelLogs),
+		"protectControlGroups":    llx.BoolData(settings.ProtectControlGroups),
+		"protectClock":            llx.BoolData(settings.ProtectClock),
+		"protectHostname":         llx.BoolData(settings.ProtectHostname),
+		"noNewPrivileges":         llx.BoolData(settings.NoNewPrivileges),
+		"restrictRealtime":        llx.BoolData(settings.RestrictRealtime),
+		"restrictSUIDSGID":        llx.BoolData(settings.RestrictSUIDSGID),
+		"lockPersonality":         llx.BoolData(settings.LockPersonality),
+		"memoryDenyWriteExecute":  llx.BoolData(settings.MemoryDenyWriteExecute),
+		"capabilityBoundingSet":   llx.ArrayData(llx.TArr2Raw(settings.CapabilityBoundingSet), types.String),
+		"ambientCapabilities":     llx.ArrayData(llx.TArr2Raw(settings.AmbientCapabilities), types.String),
+		"restrictAddressFamilies": llx.ArrayData(llx.TArr2Raw(settings.RestrictAddressFamilies), types.String),
+		"restrictNamespaces":      llx.ArrayData(llx.TArr2Raw(settings.RestrictNamespaces), types.String),
+		"systemCallFilter":        llx.ArrayData(llx.TArr2Raw(settings.SystemCallFilter), types.String),
+		"exposure":                llx.NilData,
+		"exposureLevel":           llx.NilData,
+		"securityChecks":          llx.ArrayData([]any{}, types.Dict),
+	}
+
+	// systemd only assesses the security of services that are not masked
+	if unit.Type() != "service" || unit.Masked {
+		return args
+	}
+
+	exposure := settings.Exposure()
+	checks := make([]any, len(exposure.Checks))
+	for i, check := range exposure.Checks {
+		checks[i] = map[string]any{
+			"name":        check.Name,
+			"description": check.Description,
+			"weight":      int64(check.Weight),
+			"badness":     int64(check.Badness),
+			"range":       int64(check.Range),
+		}
+	}
+	args["exposure"] = llx.FloatData(exposure.Score)
+	args["exposureLevel"] = llx.StringData(exposure.Level)
+	args["securityChecks"] = llx.ArrayData(checks, types.Dict)
+	return args
+}
+
+func (x *mqlSystemdUnit) id() (string, error) {
+	return x.Name.Data, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// cycloneDxDocument is the part of a CycloneDX BOM that holds VEX information
+// see https://cyclonedx.org/capabilities/vex/
+type cycloneDxDocument struct {
+	Components      []cycloneDxComponent `json:"components"`
+	Vulnerabilities []struct {
+		ID         string `json:"id"`
+		References []struct {
+			ID string `json:"id"`
+		} `json:"references"`
+		Analysis struct {
+			State         string `json:"state"`
+			Justification string `json:"justification"`
+			Detail        string `json:"detail"`
+		} `json:"analysis"`
+		Affects []struct {
+			Ref string `json:"ref"`
+		} `json:"affects"`
+	} `json:"vulnerabilities"`
+}
+
+type cycloneDxComponent struct {
+	BomRef     string               `json:"bom-ref"`
+	Purl       string               `json:"purl"`
+	Components []cycloneDxComponent `json:"components"`
+}
+
+// CycloneDX impact analysis states mapped to the OpenVEX status
+var cycloneDxStates = map[string]Status{
+	"not_affected":           StatusNotAffected,
+	"false_positive":         StatusNotAffected,
+	"resolved":               StatusFixed,
+	"resolved_with_pedigree": StatusFixed,
+	"exploitable":            StatusAffected,
+	"in_triage":              StatusUnderInvestigation,
+}
+
+func parseCycloneDx(data []byte) (*Document, error) {
+	doc := cycloneDxDocument{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	purls := map[string]string{}
+	var collect func(components []cycloneDxComponent)
+	collect = func(components []cycloneDxComponent) {
+		for _, c := range components {
+			if c.BomRef != "" && c.Purl != "" {
+				purls[c.BomRef] = c.Purl
+			}
+			collect(c.Components)
+		}
+	}
+	collect(doc.Components)
+
+	res := &Document{}
+	for _, v := range doc.Vulnerabilities {
+		status, ok := cycloneDxStates[v.Analysis.State]
+		if !ok || v.ID == "" {
+			continue
+		}
+
+		stmt := &Statement{
+			Vulnerability:   v.ID,
+			Status:          status,
+			Justification:   v.Analysis.Justification,
+			ImpactStatement: v.Analysis.Detail,
+		}
+		for _, ref := range v.References {
+			stmt.Aliases = appendUnique(stmt.Aliases, ref.ID)
+		}
+		for _, affects := range v.Affects {
+			// refs point to a component of the BOM or to a package url directly
+			purl, ok := purls[affects.Ref]
+			if !ok && strings.HasPrefix(affects.Ref, "pkg:") {
+				purl, ok = affects.Ref, true
+			}
+			if ok {
+				stmt.Products = append(stmt.Products, &Product{Purls: []string{purl}})
+			}
+		}
+		res.Statements = append(res.Statements, stmt)
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"encoding/json"
+)
+
+// openVexDocument is an OpenVEX document, older versions of the spec use plain
+// strings for vulnerabilities and products
+// see https://github.com/openvex/spec/blob/main/OPENVEX-SPEC.md
+type openVexDocument struct {
+	Statements []struct {
+		Vulnerability   json.RawMessage   `json:"vulnerability"`
+		Products        []json.RawMessage `json:"products"`
+		Status          string            `json:"status"`
+		Justification   string            `json:"justification"`
+		ImpactStatement string            `json:"impact_statement"`
+	} `json:"statements"`
+}
+
+type openVexVulnerability struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type openVexProduct struct {
+	ID          string `json:"@id"`
+	Identifiers struct {
+		Purl string `json:"purl"`
+	} `json:"identifiers"`
+	Subcomponents []openVexProduct `json:"subcomponents"`
+}
+
+func parseOpenVex(data []byte) (*Document, error) {
+	doc := openVexDocument{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	res := &Document{}
+	for _, s := range doc.Statements {
+		if len(s.Vulnerability) == 0 {
+			continue
+		}
+		vuln := openVexVulnerability{}
+		if err := json.Unmarshal(s.Vulnerability, &vuln.Name); err != nil {
+			if err := json.Unmarshal(s.Vulnerability, &vuln); err != nil {
+				return nil, err
+			}
+		}
+
+		stmt := &Statement{
+			Vulnerability:   vuln.Name,
+			Aliases:         vuln.Aliases,
+			Status:          Status(s.Status),
+			Justification:   s.Justification,
+			ImpactStatement: s.ImpactStatement,
+		}
+		for _, raw := range s.Products {
+			var id string
+			if err := json.Unmarshal(raw, &id); err == nil {
+				stmt.Products = append(stmt.Products, &Product{Purls: []string{id}})
+				continue
+			}
+			product := openVexProduct{}
+			if err := json.Unmarshal(raw, &product); err != nil {
+				return nil, err
+			}
+			res := &Product{Purls: product.purls()}
+			for _, sub := range product.Subcomponents {
+				res.Subcomponents = appendUnique(res.Subcomponents, sub.allPurls()...)
+			}
+			stmt.Products = append(stmt.Products, res)
+		}
+		res.Statements = append(res.Statements, stmt)
+	}
+	return res, nil
+}
+
+// purls that identify the product
+func (p openVexProduct) purls() []string {
+	return appendUnique(nil, p.ID, p.Identifiers.Purl)
+}
+
+// allPurls returns the purls of the product and all of its subcomponents
+func (p openVexProduct) allPurls() []string {
+	res := p.purls()
+	for _, sub := range p.Subcomponents {
+		res = appendUnique(res, sub.allPurls()...)
+	}
+	return res
+}
@@ -0,0 +1,256 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package vex reads Vulnerability Exploitability eXchange (VEX) documents in
+// the OpenVEX and CycloneDX format. They state if a product is affected by a
+// vulnerability, so that triaged vulnerabilities are not reported again.
+package vex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// Status of a vulnerability for a product, as defined by OpenVEX
+// see https://github.com/openvex/spec/blob/main/OPENVEX-SPEC.md#status-labels
+type Status string
+
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// Suppresses returns true if the vulnerability does not need to be reported
+func (s Status) Suppresses() bool {
+	return s == StatusNotAffected || s == StatusFixed
+}
+
+// Statement about the status of a vulnerability for the products
+type Statement struct {
+	Vulnerability string
+	Aliases       []string
+	// Products the statement applies to, it applies to all products if the
+	// list is empty
+	Products        []*Product
+	Status          Status
+	Justification   string
+	ImpactStatement string
+}
+
+// Product is a package or an artifact like a container image
+type Product struct {
+	// package urls that identify the product
+	Purls []string
+	// package urls of the packages of the product that the statement is
+	// about. They only apply if the product is the scanned asset.
+	Subcomponents []string
+}
+
+// Asset is the scanned asset that products are matched against
+type Asset struct {
+	// Digests of the container image, e.g. sha256:5b9c1a2e...
+	Digests []string
+}
+
+// Document holds the statements of one or more VEX documents
+type Document struct {
+	Statements []*Statement
+}
+
+// Load reads and merges the VEX documents. Later statements take precedence.
+func Load(paths ...string) (*Document, error) {
+	res := &Document{}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open VEX document: %w", err)
+		}
+		doc, err := Parse(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse VEX document %s: %w", path, err)
+		}
+		res.Statements = append(res.Statements, doc.Statements...)
+	}
+	return res, nil
+}
+
+// Parse reads an OpenVEX or CycloneDX VEX document in JSON format
+func Parse(r io.Reader) (*Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	format := struct {
+		Context   string `json:"@context"`
+		BomFormat string `json:"bomFormat"`
+	}{}
+	if err := json.Unmarshal(data, &format); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case strings.Contains(format.Context, "openvex"):
+		return parseOpenVex(data)
+	case format.BomFormat == "CycloneDX":
+		return parseCycloneDx(data)
+	default:
+		return nil, errors.New("unsupported VEX document, only OpenVEX and CycloneDX are supported")
+	}
+}
+
+// Statement returns the statement that applies to one of the vulnerability ids
+// and to all of the packages of the asset. The packages are the installed
+// packages that the vulnerability affects, a statement for other packages of
+// the asset does not apply. If multiple statements apply, the last one wins.
+func (d *Document) Statement(ids []string, asset *Asset, purls []string) *Statement {
+	if d == nil {
+		return nil
+	}
+
+	for i := len(d.Statements) - 1; i >= 0; i-- {
+		stmt := d.Statements[i]
+		if stmt.matchVulnerability(ids) && stmt.matchProducts(asset, purls) {
+			return stmt
+		}
+	}
+	return nil
+}
+
+func (s *Statement) matchVulnerability(ids []string) bool {
+	for _, id := range ids {
+		if strings.EqualFold(s.Vulnerability, id) {
+			return true
+		}
+		for _, alias := range s.Aliases {
+			if strings.EqualFold(alias, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchProducts returns true if the products of the statement include all
+// packages of the asset
+func (s *Statement) matchProducts(asset *Asset, purls []string) bool {
+	if len(s.Products) == 0 {
+		return true
+	}
+	for _, product := range s.Products {
+		// the statement is about the whole asset
+		if len(product.Subcomponents) == 0 && product.matchAsset(asset) {
+			return true
+		}
+	}
+	if len(purls) == 0 {
+		return false
+	}
+	for _, purl := range purls {
+		found := false
+		for _, product := range s.Products {
+			if product.matchPackage(asset, purl) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPackage returns true if the package is the product or one of its
+// subcomponents on the asset
+func (p *Product) matchPackage(asset *Asset, purl string) bool {
+	if len(p.Subcomponents) == 0 {
+		for _, product := range p.Purls {
+			if MatchPurl(product, purl) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !p.matchAsset(asset) {
+		return false
+	}
+	for _, sub := range p.Subcomponents {
+		if MatchPurl(sub, purl) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAsset returns true if the product is the asset, container images are
+// identified by the digest in their package url
+func (p *Product) matchAsset(asset *Asset) bool {
+	if asset == nil {
+		return false
+	}
+	for _, product := range p.Purls {
+		purl, err := packageurl.FromString(product)
+		if err != nil || (purl.Type != packageurl.TypeOCI && purl.Type != packageurl.TypeDocker) {
+			continue
+		}
+		for _, digest := range asset.Digests {
+			if purl.Version != "" && strings.EqualFold(purl.Version, digest) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchPurl checks if the package url of a product refers to the package. The
+// product may omit the version and qualifiers to refer to all versions.
+func MatchPurl(product string, pkg string) bool {
+	if product == pkg {
+		return true
+	}
+
+	p, err := packageurl.FromString(product)
+	if err != nil {
+		return false
+	}
+	k, err := packageurl.FromString(pkg)
+	if err != nil {
+		return false
+	}
+
+	if p.Type != k.Type || !strings.EqualFold(p.Namespace, k.Namespace) || p.Name != k.Name || p.Subpath != k.Subpath {
+		return false
+	}
+	if p.Version != "" && p.Version != k.Version {
+		return false
+	}
+
+	qualifiers := k.Qualifiers.Map()
+	for _, q := range p.Qualifiers {
+		if v, ok := qualifiers[q.Key]; !ok || v != q.Value {
+			return false
+		}
+	}
+	return true
+}
+
+func appendUnique(list []string, entries ...string) []string {
+	for _, entry := range entries {
+		if entry != "" && !slices.Contains(list, entry) {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
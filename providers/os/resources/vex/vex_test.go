@@ -0,0 +1,128 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenVex(t *testing.T) {
+	doc, err := Load("./testdata/openvex.json")
+	require.NoError(t, err)
+	require.Len(t, doc.Statements, 3)
+
+	assert.Equal(t, &Statement{
+		Vulnerability: "CVE-2023-5363",
+		Aliases:       []string{"GHSA-xw78-pcr6-wrg8"},
+		Products: []*Product{{
+			Purls:         []string{"pkg:oci/app@sha256:5b9c1a2e"},
+			Subcomponents: []string{"pkg:deb/debian/libssl3"},
+		}},
+		Status:          StatusNotAffected,
+		Justification:   "vulnerable_code_not_in_execute_path",
+		ImpactStatement: "The affected cipher is not used",
+	}, doc.Statements[0])
+	assert.Equal(t, "CVE-2022-3715", doc.Statements[2].Vulnerability)
+	assert.Equal(t, []*Product{{Purls: []string{"pkg:deb/debian/bash"}}}, doc.Statements[2].Products)
+
+	// the subcomponents only apply to the image of the product
+	app := &Asset{Digests: []string{"sha256:5b9c1a2e"}}
+	libssl := []string{"pkg:deb/debian/libssl3@3.0.11-1~deb12u1?arch=amd64&distro=debian-12.5"}
+	stmt := doc.Statement([]string{"CVE-2023-5363"}, app, libssl)
+	require.NotNil(t, stmt)
+	assert.True(t, stmt.Status.Suppresses())
+	assert.Equal(t, stmt, doc.Statement([]string{"GHSA-xw78-pcr6-wrg8"}, app, libssl))
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-5363"}, app, []string{"pkg:deb/debian/openssl@3.0.11-1~deb12u1"}))
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-5363"}, &Asset{Digests: []string{"sha256:0f3e6c7d"}}, libssl))
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-5363"}, nil, libssl))
+
+	// the statement only applies to the given version
+	zlib := doc.Statement([]string{"CVE-2023-45853"}, nil, []string{"pkg:deb/debian/zlib1g@1:1.2.13.dfsg-1?arch=amd64&distro=debian-12.5"})
+	require.NotNil(t, zlib)
+	assert.False(t, zlib.Status.Suppresses())
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-45853"}, nil, []string{"pkg:deb/debian/zlib1g@1:1.3.dfsg-3?arch=amd64"}))
+}
+
+func TestCycloneDxVex(t *testing.T) {
+	doc, err := Load("./testdata/cyclonedx.json")
+	require.NoError(t, err)
+	// statements without analysis are skipped
+	require.Len(t, doc.Statements, 3)
+
+	assert.Equal(t, &Statement{
+		Vulnerability:   "CVE-2023-42363",
+		Aliases:         []string{"GHSA-9x2p-6m5v-2hr8"},
+		Products:        []*Product{{Purls: []string{"pkg:apk/alpine/busybox"}}},
+		Status:          StatusNotAffected,
+		Justification:   "code_not_reachable",
+		ImpactStatement: "awk is not used in the image",
+	}, doc.Statements[0])
+	assert.Equal(t, StatusFixed, doc.Statements[1].Status)
+	assert.Equal(t, []*Product{{Purls: []string{"pkg:apk/alpine/libssl3@3.1.4-r6"}}}, doc.Statements[1].Products)
+	assert.Equal(t, StatusAffected, doc.Statements[2].Status)
+
+	// statements without products apply to all packages
+	stmt := doc.Statement([]string{"CVE-2024-0727"}, nil, nil)
+	require.NotNil(t, stmt)
+	assert.False(t, stmt.Status.Suppresses())
+}
+
+func TestLoadMultipleDocuments(t *testing.T) {
+	doc, err := Load("./testdata/openvex.json", "./testdata/cyclonedx.json")
+	require.NoError(t, err)
+	assert.Len(t, doc.Statements, 6)
+
+	_, err = Load("./testdata/missing.json")
+	assert.Error(t, err)
+
+	_, err = Parse(strings.NewReader(`{"spdxVersion": "SPDX-2.3"}`))
+	assert.Error(t, err)
+
+	var empty *Document
+	assert.Nil(t, empty.Statement([]string{"CVE-2023-5363"}, nil, nil))
+}
+
+func TestStatementAffectedPackages(t *testing.T) {
+	doc, err := Parse(strings.NewReader(`{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "statements": [
+    {
+      "vulnerability": {"name": "CVE-2023-38545"},
+      "products": [{"@id": "pkg:deb/debian/curl"}],
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_present"
+    }
+  ]
+}`))
+	require.NoError(t, err)
+
+	curl := "pkg:deb/debian/curl@7.88.1-10?arch=amd64"
+	libcurl := "pkg:deb/debian/libcurl4@7.88.1-10?arch=amd64"
+	stmt := doc.Statement([]string{"CVE-2023-38545"}, nil, []string{curl})
+	require.NotNil(t, stmt)
+	assert.True(t, stmt.Status.Suppresses())
+
+	// the statement does not cover the affected libcurl
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-38545"}, nil, []string{libcurl}))
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-38545"}, nil, []string{curl, libcurl}))
+	// without affected packages only statements without products apply
+	assert.Nil(t, doc.Statement([]string{"CVE-2023-38545"}, nil, nil))
+}
+
+func TestMatchPurl(t *testing.T) {
+	pkg := "pkg:apk/alpine/busybox@1.36.1-r5?arch=x86_64&distro=alpine-3.18.4"
+	assert.True(t, MatchPurl(pkg, pkg))
+	assert.True(t, MatchPurl("pkg:apk/alpine/busybox", pkg))
+	assert.True(t, MatchPurl("pkg:apk/alpine/busybox@1.36.1-r5", pkg))
+	assert.True(t, MatchPurl("pkg:apk/alpine/busybox?arch=x86_64", pkg))
+	assert.False(t, MatchPurl("pkg:apk/alpine/busybox@1.36.1-r6", pkg))
+	assert.False(t, MatchPurl("pkg:apk/alpine/busybox?arch=aarch64", pkg))
+	assert.False(t, MatchPurl("pkg:apk/alpine/musl", pkg))
+	assert.False(t, MatchPurl("pkg:deb/alpine/busybox", pkg))
+	assert.False(t, MatchPurl("not a purl", pkg))
+}
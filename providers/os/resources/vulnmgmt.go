@@ -5,14 +5,19 @@ package resources
 
 import (
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/v11/llx"
+	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/resources"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/upstream/gql"
 	"go.mondoo.com/cnquery/v11/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v11/providers/os/id/containerid"
+	"go.mondoo.com/cnquery/v11/providers/os/resources/vex"
 	mondoogql "go.mondoo.com/mondoo-go"
 )
 
@@ -87,6 +92,13 @@ func (v *mqlVulnmgmt) packages() ([]interface{}, error) {
 	return nil, v.populateData()
 }
 
+func (v *mqlVulnmgmt) suppressedCves() ([]interface{}, error) {
+	// see command resource for reference
+	// we ignore the return value because everything is set in populateData
+	// `plugin.StateIsSet` is used to indicate that the data is available
+	return nil, v.populateData()
+}
+
 func (v *mqlVulnmgmt) stats() (*mqlAuditCvss, error) {
 	// see command resource for reference
 	// we ignore the return value because everything is set in populateData
@@ -94,14 +106,161 @@ func (v *mqlVulnmgmt) stats() (*mqlAuditCvss, error) {
 	return nil, v.populateData()
 }
 
+// vexFilter matches the statements of the VEX documents that are configured
+// for the asset against the installed packages
+type vexFilter struct {
+	doc   *vex.Document
+	asset *vex.Asset
+	// package urls of the installed packages by name
+	purls map[string][]string
+}
+
+// newVexFilter loads the VEX documents configured for the asset, it returns
+// nil if there are none
+func newVexFilter(runtime *plugin.Runtime) (*vexFilter, error) {
+	conn := runtime.Connection.(shared.Connection)
+	paths := ""
+	for _, conf := range conn.Asset().Connections {
+		if conf.Options[shared.VexOption] != "" {
+			paths = conf.Options[shared.VexOption]
+			break
+		}
+	}
+	if paths == "" {
+		return nil, nil
+	}
+
+	doc, err := vex.Load(strings.Split(paths, ",")...)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgsRes, err := CreateResource(runtime, "packages", nil)
+	if err != nil {
+		return nil, err
+	}
+	pkgs := pkgsRes.(*mqlPackages).GetList()
+	if pkgs.Error != nil {
+		return nil, pkgs.Error
+	}
+	res := &vexFilter{doc: doc, asset: newVexAsset(conn.Asset()), purls: map[string][]string{}}
+	for _, p := range pkgs.Data {
+		pkg := p.(*mqlPackage)
+		if pkg.Purl.Data != "" {
+			res.purls[pkg.Name.Data] = append(res.purls[pkg.Name.Data], pkg.Purl.Data)
+		}
+	}
+	return res, nil
+}
+
+// newVexAsset identifies the asset for the products of VEX statements by the
+// digests of its container image
+func newVexAsset(asset *inventory.Asset) *vex.Asset {
+	res := &vex.Asset{}
+	add := func(digest string) {
+		if strings.HasPrefix(digest, "sha256:") && !slices.Contains(res.Digests, digest) {
+			res.Digests = append(res.Digests, digest)
+		}
+	}
+	for _, id := range asset.PlatformIds {
+		if digest, ok := strings.CutPrefix(id, containerid.MondooContainerImageID("")); ok {
+			add("sha256:" + digest)
+		}
+	}
+	for _, ref := range strings.Split(asset.Labels["docker.io/digests"], ",") {
+		_, digest, _ := strings.Cut(ref, "@")
+		add(digest)
+	}
+	add(asset.Labels["docker.io/digest"])
+	add(asset.Labels["mondoo.com/image-id"])
+	return res
+}
+
+// appendPurls appends the package urls of the installed packages with the
+// given name. Statements are matched against the packages that a
+// vulnerability affects, not against all packages of the asset.
+func (f *vexFilter) appendPurls(purls []string, name string) []string {
+	for _, purl := range f.purls[name] {
+		if !slices.Contains(purls, purl) {
+			purls = append(purls, purl)
+		}
+	}
+	return purls
+}
+
+// statement returns the statement for the vulnerability ids and the package
+// urls of the affected packages
+func (f *vexFilter) statement(ids []string, purls []string) *vex.Statement {
+	if f == nil {
+		return nil
+	}
+	return f.doc.Statement(ids, f.asset, purls)
+}
+
+// suppresses checks if an advisory is not affected or fixed, either directly
+// or because all of its CVEs are
+func (f *vexFilter) suppresses(id string, purls []string, cves []string, suppressedCves map[string]struct{}) bool {
+	if stmt := f.statement([]string{id}, purls); stmt != nil {
+		return stmt.Status.Suppresses()
+	}
+	if len(cves) == 0 {
+		return false
+	}
+	for _, c := range cves {
+		if _, ok := suppressedCves[c]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (v *mqlVulnmgmt) populateData() error {
 	vulnReport, err := v.getReport()
 	if err != nil {
 		return err
 	}
 
-	mqlVulAdvisories := make([]interface{}, len(vulnReport.Advisories))
+	filter, err := newVexFilter(v.MqlRuntime)
+	if err != nil {
+		return err
+	}
+	// package urls of the packages that each advisory and CVE affects
+	advisoryPurls := make([][]string, len(vulnReport.Advisories))
+	cvePurls := map[string][]string{}
+	if filter != nil {
+		for i, a := range vulnReport.Advisories {
+			for _, p := range a.AffectedPackages {
+				advisoryPurls[i] = filter.appendPurls(advisoryPurls[i], p.Name)
+			}
+			for _, c := range a.Cves {
+				for _, p := range a.AffectedPackages {
+					cvePurls[c.Id] = filter.appendPurls(cvePurls[c.Id], p.Name)
+				}
+			}
+		}
+	}
+	// CVEs that are not affected or fixed according to the VEX documents
+	suppressed := map[string]struct{}{}
+	for _, c := range vulnReport.Cves {
+		if stmt := filter.statement([]string{c.Id}, cvePurls[c.Id]); stmt != nil && stmt.Status.Suppresses() {
+			suppressed[c.Id] = struct{}{}
+		}
+	}
+
+	mqlVulAdvisories := []interface{}{}
+	var worstAdvisory *gql.Advisory
 	for i, a := range vulnReport.Advisories {
+		cves := make([]string, len(a.Cves))
+		for j := range a.Cves {
+			cves[j] = a.Cves[j].Id
+		}
+		if filter.suppresses(a.Id, advisoryPurls[i], cves, suppressed) {
+			log.Debug().Str("advisory", a.Id).Msg("advisory is suppressed by vex statement")
+			continue
+		}
+		if worstAdvisory == nil || a.CvssScore.Value > worstAdvisory.CvssScore.Value {
+			worstAdvisory = a
+		}
 		var parsedPublished *time.Time
 		var parsedModified *time.Time
 		var err error
@@ -135,11 +294,12 @@ func (v *mqlVulnmgmt) populateData() error {
 		if err != nil {
 			return err
 		}
-		mqlVulAdvisories[i] = mqlVulnAdvisory
+		mqlVulAdvisories = append(mqlVulAdvisories, mqlVulnAdvisory)
 	}
 
-	mqlVulnCves := make([]interface{}, len(vulnReport.Cves))
-	for i, c := range vulnReport.Cves {
+	mqlVulnCves := []interface{}{}
+	mqlSuppressedCves := []interface{}{}
+	for _, c := range vulnReport.Cves {
 		var parsedPublished *time.Time
 		var parsedModified *time.Time
 		var err error
@@ -162,18 +322,29 @@ func (v *mqlVulnmgmt) populateData() error {
 		if err != nil {
 			return err
 		}
+		vexStatement := filter.statement([]string{c.Id}, cvePurls[c.Id])
+		if vexStatement == nil {
+			vexStatement = &vex.Statement{}
+		}
 		mqlVulnCve, err := CreateResource(v.MqlRuntime, "vuln.cve", map[string]*llx.RawData{
-			"id":         llx.StringData(c.Id),
-			"worstScore": llx.ResourceData(cvssScore, "audit.cvss"),
-			"state":      llx.StringData(c.State),
-			"summary":    llx.StringData(c.Summary),
-			"published":  llx.TimeDataPtr(parsedPublished),
-			"modified":   llx.TimeDataPtr(parsedModified),
+			"id":                 llx.StringData(c.Id),
+			"worstScore":         llx.ResourceData(cvssScore, "audit.cvss"),
+			"state":              llx.StringData(c.State),
+			"summary":            llx.StringData(c.Summary),
+			"published":          llx.TimeDataPtr(parsedPublished),
+			"modified":           llx.TimeDataPtr(parsedModified),
+			"vexStatus":          llx.StringData(string(vexStatement.Status)),
+			"vexJustification":   llx.StringData(vexStatement.Justification),
+			"vexImpactStatement": llx.StringData(vexStatement.ImpactStatement),
 		})
 		if err != nil {
 			return err
 		}
-		mqlVulnCves[i] = mqlVulnCve
+		if _, ok := suppressed[c.Id]; ok {
+			mqlSuppressedCves = append(mqlSuppressedCves, mqlVulnCve)
+			continue
+		}
+		mqlVulnCves = append(mqlVulnCves, mqlVulnCve)
 	}
 
 	mqlVulnPackages := make([]interface{}, len(vulnReport.Packages))
@@ -190,9 +361,17 @@ func (v *mqlVulnmgmt) populateData() error {
 		mqlVulnPackages[i] = mqlVulnPackage
 	}
 
+	statsScore, statsVector := vulnReport.Stats.Score.Value, vulnReport.Stats.Score.Vector
+	// the worst score is determined by the advisories that are left
+	if len(mqlVulAdvisories) < len(vulnReport.Advisories) {
+		statsScore, statsVector = 0, ""
+		if worstAdvisory != nil {
+			statsScore, statsVector = worstAdvisory.CvssScore.Value, worstAdvisory.CvssScore.Vector
+		}
+	}
 	res, err := CreateResource(v.MqlRuntime, "audit.cvss", map[string]*llx.RawData{
-		"score":  llx.FloatData(float64(vulnReport.Stats.Score.Value) / 10),
-		"vector": llx.StringData(vulnReport.Stats.Score.Vector),
+		"score":  llx.FloatData(float64(statsScore) / 10),
+		"vector": llx.StringData(statsVector),
 	})
 	if err != nil {
 		return err
@@ -201,6 +380,7 @@ func (v *mqlVulnmgmt) populateData() error {
 
 	v.Advisories = plugin.TValue[[]interface{}]{Data: mqlVulAdvisories, State: plugin.StateIsSet}
 	v.Cves = plugin.TValue[[]interface{}]{Data: mqlVulnCves, State: plugin.StateIsSet}
+	v.SuppressedCves = plugin.TValue[[]interface{}]{Data: mqlSuppressedCves, State: plugin.StateIsSet}
 	v.Packages = plugin.TValue[[]interface{}]{Data: mqlVulnPackages, State: plugin.StateIsSet}
 	v.Stats = plugin.TValue[*mqlAuditCvss]{Data: statsCvssScore, State: plugin.StateIsSet}
 
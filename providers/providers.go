@@ -27,7 +27,7 @@ import (
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v11/providers-sdk/v1/resources"
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/semver"
+	"go.mondoo.com/cnquery/v11/utils/versions/semver"
 	"golang.org/x/exp/slices"
 )
 
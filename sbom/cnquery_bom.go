@@ -15,3 +15,11 @@ func (ccx *CnqueryBOM) Render(output io.Writer, bom *Sbom) error {
 	enc.SetIndent("", "  ")
 	return enc.Encode(bom)
 }
+
+func (ccx *CnqueryBOM) Parse(r io.Reader) (*Sbom, error) {
+	bom := &Sbom{}
+	if err := json.NewDecoder(r).Decode(bom); err != nil {
+		return nil, err
+	}
+	return bom, nil
+}
@@ -4,13 +4,21 @@
 package sbom
 
 import (
+	"errors"
 	"io"
+	"slices"
 	"time"
 
 	cyclonedx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/google/uuid"
 )
 
+// properties of a component for the container image layer that introduced it
+const (
+	cyclonedxPropertyLayerDigest    = "mondoo:image:layer:digest"
+	cyclonedxPropertyLayerCreatedBy = "mondoo:image:layer:createdBy"
+)
+
 type CycloneDX struct {
 	Format cyclonedx.BOMFileFormat
 }
@@ -39,6 +47,9 @@ func (ccx *CycloneDX) convert(bom *Sbom) (*cyclonedx.BOM, error) {
 	}
 
 	components := []cyclonedx.Component{}
+	// bom references of the packages by package url, used to resolve the
+	// dependencies between packages
+	refs := map[string]string{}
 
 	// add os as component
 	cpe := ""
@@ -95,6 +106,26 @@ func (ccx *CycloneDX) convert(bom *Sbom) (*cyclonedx.BOM, error) {
 			PackageURL: pkg.Purl,
 			CPE:        cpe,
 			Evidence:   evidence,
+			Licenses:   cyclonedxLicenses(pkg.Licenses),
+		}
+
+		if layer := pkg.layerEvidence(); layer != nil {
+			properties := []cyclonedx.Property{
+				{Name: cyclonedxPropertyLayerDigest, Value: layer.Value},
+			}
+			if layer.CreatedBy != "" {
+				properties = append(properties, cyclonedx.Property{Name: cyclonedxPropertyLayerCreatedBy, Value: layer.CreatedBy})
+			}
+			bomPkg.Properties = &properties
+		}
+
+		// references must be unique within the bom, the same package url may
+		// be reported for multiple packages
+		if _, ok := refs[pkg.Purl]; pkg.Purl != "" && !ok {
+			bomPkg.BOMRef = pkg.Purl
+			refs[pkg.Purl] = pkg.Purl
+		} else if hash, err := pkg.Hash(); err == nil {
+			bomPkg.BOMRef = "pkg-" + hash
 		}
 
 		components = append(components, bomPkg)
@@ -102,9 +133,61 @@ func (ccx *CycloneDX) convert(bom *Sbom) (*cyclonedx.BOM, error) {
 
 	sbom.Components = &components
 
+	dependencies := []cyclonedx.Dependency{}
+	resolved := map[string]struct{}{}
+	for i := range bom.Packages {
+		pkg := bom.Packages[i]
+		ref, ok := refs[pkg.Purl]
+		if !ok || len(pkg.Dependencies) == 0 {
+			continue
+		}
+		if _, ok := resolved[ref]; ok {
+			continue
+		}
+		resolved[ref] = struct{}{}
+
+		dependsOn := []string{}
+		for _, purl := range pkg.Dependencies {
+			// only reference packages that are part of the bom
+			if depRef, ok := refs[purl]; ok && !slices.Contains(dependsOn, depRef) {
+				dependsOn = append(dependsOn, depRef)
+			}
+		}
+		if len(dependsOn) == 0 {
+			continue
+		}
+		dependencies = append(dependencies, cyclonedx.Dependency{
+			Ref:          ref,
+			Dependencies: &dependsOn,
+		})
+	}
+	if len(dependencies) > 0 {
+		sbom.Dependencies = &dependencies
+	}
+
 	return sbom, nil
 }
 
+// cyclonedxLicenses converts the licenses of a package. License expressions
+// are kept as a single expression, all other licenses are added by name.
+func cyclonedxLicenses(licenses []string) *cyclonedx.Licenses {
+	if len(licenses) == 0 {
+		return nil
+	}
+
+	if expression, ok := licenseExpression(licenses); ok {
+		return &cyclonedx.Licenses{{Expression: expression}}
+	}
+
+	res := cyclonedx.Licenses{}
+	for i := range licenses {
+		res = append(res, cyclonedx.LicenseChoice{
+			License: &cyclonedx.License{Name: licenses[i]},
+		})
+	}
+	return &res
+}
+
 func (ccx *CycloneDX) Render(w io.Writer, bom *Sbom) error {
 	sbom, err := ccx.convert(bom)
 	if err != nil {
@@ -114,3 +197,136 @@ func (ccx *CycloneDX) Render(w io.Writer, bom *Sbom) error {
 	enc.SetPretty(true)
 	return enc.Encode(sbom)
 }
+
+func (ccx *CycloneDX) Parse(r io.Reader) (*Sbom, error) {
+	sbom := &cyclonedx.BOM{}
+	if err := cyclonedx.NewBOMDecoder(r, ccx.Format).Decode(sbom); err != nil {
+		return nil, errors.New("could not parse CycloneDX document: " + err.Error())
+	}
+	return ccx.parse(sbom), nil
+}
+
+func (ccx *CycloneDX) parse(sbom *cyclonedx.BOM) *Sbom {
+	bom := &Sbom{
+		Asset: &Asset{
+			Platform: &Platform{},
+		},
+	}
+
+	components := []cyclonedx.Component{}
+	if sbom.Metadata != nil {
+		bom.Timestamp = sbom.Metadata.Timestamp
+		if sbom.Metadata.Tools != nil {
+			if sbom.Metadata.Tools.Components != nil && len(*sbom.Metadata.Tools.Components) > 0 {
+				tool := (*sbom.Metadata.Tools.Components)[0]
+				bom.Generator = &Generator{Vendor: tool.Author, Name: tool.Name, Version: tool.Version}
+			} else if sbom.Metadata.Tools.Tools != nil && len(*sbom.Metadata.Tools.Tools) > 0 {
+				tool := (*sbom.Metadata.Tools.Tools)[0]
+				bom.Generator = &Generator{Vendor: tool.Vendor, Name: tool.Name, Version: tool.Version}
+			}
+		}
+		if sbom.Metadata.Component != nil {
+			bom.Asset.Name = sbom.Metadata.Component.Name
+			if sbom.Metadata.Component.Version != "" {
+				bom.Asset.Name += "@" + sbom.Metadata.Component.Version
+			}
+			// the described component itself is not part of the inventory
+			if sbom.Metadata.Component.Components != nil {
+				components = flattenComponents(components, *sbom.Metadata.Component.Components)
+			}
+		}
+	}
+	if sbom.Components != nil {
+		components = flattenComponents(components, *sbom.Components)
+	}
+
+	// package urls by bom reference, used to resolve the dependencies
+	purls := map[string]string{}
+	pkgs := map[string]*Package{}
+	for i := range components {
+		c := components[i]
+		if c.Type == cyclonedx.ComponentTypeOS {
+			if bom.Asset.Platform.Name == "" {
+				bom.Asset.Platform.Name = c.Name
+				bom.Asset.Platform.Version = c.Version
+				if c.CPE != "" {
+					bom.Asset.Platform.Cpes = []string{c.CPE}
+				}
+			}
+			continue
+		}
+
+		pkg := newPackageFromPurl(c.Name, c.Version, c.PackageURL)
+		pkg.Description = c.Description
+		if c.CPE != "" {
+			pkg.Cpes = []string{c.CPE}
+		}
+		if c.Licenses != nil {
+			for _, l := range *c.Licenses {
+				switch {
+				case l.Expression != "":
+					pkg.Licenses = append(pkg.Licenses, l.Expression)
+				case l.License != nil && l.License.ID != "":
+					pkg.Licenses = append(pkg.Licenses, l.License.ID)
+				case l.License != nil && l.License.Name != "":
+					pkg.Licenses = append(pkg.Licenses, l.License.Name)
+				}
+			}
+		}
+		if c.Evidence != nil && c.Evidence.Occurrences != nil {
+			for _, o := range *c.Evidence.Occurrences {
+				pkg.EvidenceList = append(pkg.EvidenceList, &Evidence{
+					Type:  EvidenceType_EVIDENCE_TYPE_FILE,
+					Value: o.Location,
+				})
+			}
+		}
+		if c.Properties != nil {
+			layer := &Evidence{Type: EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER}
+			for _, p := range *c.Properties {
+				switch p.Name {
+				case cyclonedxPropertyLayerDigest:
+					layer.Value = p.Value
+				case cyclonedxPropertyLayerCreatedBy:
+					layer.CreatedBy = p.Value
+				}
+			}
+			if layer.Value != "" {
+				pkg.EvidenceList = append(pkg.EvidenceList, layer)
+			}
+		}
+
+		if c.BOMRef != "" {
+			purls[c.BOMRef] = c.PackageURL
+			pkgs[c.BOMRef] = pkg
+		}
+		bom.Packages = append(bom.Packages, pkg)
+	}
+
+	if sbom.Dependencies != nil {
+		for _, dep := range *sbom.Dependencies {
+			pkg, ok := pkgs[dep.Ref]
+			if !ok || dep.Dependencies == nil {
+				continue
+			}
+			for _, ref := range *dep.Dependencies {
+				if purl := purls[ref]; purl != "" && !slices.Contains(pkg.Dependencies, purl) {
+					pkg.Dependencies = append(pkg.Dependencies, purl)
+				}
+			}
+		}
+	}
+
+	return bom
+}
+
+// flattenComponents appends all components and their nested components
+func flattenComponents(res []cyclonedx.Component, components []cyclonedx.Component) []cyclonedx.Component {
+	for i := range components {
+		res = append(res, components[i])
+		if components[i].Components != nil {
+			res = flattenComponents(res, *components[i].Components)
+		}
+	}
+	return res
+}
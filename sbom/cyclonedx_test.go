@@ -37,6 +37,9 @@ func TestCycloneDX(t *testing.T) {
 	assert.Contains(t, data, "cpe:2.3:a:alpine-baselayout:alpine-baselayout:1695795276:aarch64:*:*:*:*:*:*")
 	// check that package files are included
 	assert.Contains(t, data, "etc/profile.d/color_prompt.sh.disabled")
+	// check that the image layer is included
+	assert.Contains(t, data, "mondoo:image:layer:digest")
+	assert.Contains(t, data, "sha256:aedc3bda2944bb9bcb6c3d475bee8b460db9a9b0f3e0b33a6ed2fd1ae0f1d445")
 
 	// ensure python package is included
 	assert.Contains(t, data, "pip")
@@ -47,4 +50,53 @@ func TestCycloneDX(t *testing.T) {
 	assert.Contains(t, data, "npm")
 	assert.Contains(t, data, "cpe:2.3:a:npm:npm:10.2.4:*:*:*:*:*:*:*")
 	assert.Contains(t, data, "pkg:npm/npm@10.2.4")
+
+	// ensure go packages are included
+	assert.Contains(t, data, "pkg:golang/stdlib@1.22.5")
+	assert.Contains(t, data, "cpe:2.3:a:golang:go:1.22.5:*:*:*:*:*:*:*")
+
+	// ensure language packages are included
+	assert.Contains(t, data, "pkg:cargo/log@0.4.21")
+	assert.Contains(t, data, "pkg:gem/rack@2.2.8")
+	assert.Contains(t, data, "pkg:composer/guzzlehttp/guzzle@7.8.1")
+	assert.Contains(t, data, "pkg:nuget/Newtonsoft.Json@13.0.1")
+}
+
+func TestCycloneDXLicensesAndDependencies(t *testing.T) {
+	r := loadTestReport(t)
+	sboms, err := GenerateBom(r)
+	require.NoError(t, err)
+
+	exporter := &CycloneDX{
+		Format: cyclonedx.BOMFileFormatJSON,
+	}
+	bom, err := exporter.convert(sboms[0])
+	require.NoError(t, err)
+
+	var npm, baselayout *cyclonedx.Component
+	for i := range *bom.Components {
+		c := &(*bom.Components)[i]
+		switch c.Name {
+		case "npm":
+			npm = c
+		case "alpine-baselayout":
+			baselayout = c
+		}
+	}
+	require.NotNil(t, npm)
+	require.NotNil(t, baselayout)
+	assert.Equal(t, "pkg:npm/npm@10.2.4", npm.BOMRef)
+	assert.Equal(t, &cyclonedx.Licenses{{Expression: "Artistic-2.0"}}, npm.Licenses)
+	assert.Equal(t, &cyclonedx.Licenses{{Expression: "GPL-2.0-only"}}, baselayout.Licenses)
+
+	require.NotNil(t, bom.Dependencies)
+	assert.Equal(t, []cyclonedx.Dependency{
+		{Ref: "pkg:npm/npm@10.2.4", Dependencies: &[]string{"pkg:npm/semver@7.5.4"}},
+	}, *bom.Dependencies)
+
+	// licenses that are no license expression are added by name
+	assert.Equal(t, &cyclonedx.Licenses{
+		{License: &cyclonedx.License{Name: "GPLv2+ and BSD"}},
+	}, cyclonedxLicenses([]string{"GPLv2+ and BSD"}))
+	assert.Nil(t, cyclonedxLicenses(nil))
 }
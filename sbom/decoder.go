@@ -0,0 +1,171 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/package-url/packageurl-go"
+)
+
+type Decoder interface {
+	Parse(r io.Reader) (*Sbom, error)
+}
+
+func NewDecoder(format string) Decoder {
+	switch format {
+	case FormatJson:
+		return &CnqueryBOM{}
+	case FormatCycloneDxJSON:
+		return &CycloneDX{
+			Format: cyclonedx.BOMFileFormatJSON,
+		}
+	case FormatCycloneDxXML:
+		return &CycloneDX{
+			Format: cyclonedx.BOMFileFormatXML,
+		}
+	case FormatSpdxJSON:
+		return &Spdx{
+			Format: FormatSpdxJSON,
+		}
+	case FormatSpdxTagValue:
+		return &Spdx{
+			Format: FormatSpdxTagValue,
+		}
+	default:
+		return nil
+	}
+}
+
+// DetectFormat determines the format of an SBOM document
+func DetectFormat(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		header := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &header); err != nil {
+			return "", errors.New("could not parse SBOM: " + err.Error())
+		}
+		if _, ok := header["bomFormat"]; ok {
+			return FormatCycloneDxJSON, nil
+		}
+		if _, ok := header["spdxVersion"]; ok {
+			return FormatSpdxJSON, nil
+		}
+		if _, ok := header["packages"]; ok {
+			return FormatJson, nil
+		}
+		if _, ok := header["asset"]; ok {
+			return FormatJson, nil
+		}
+	case bytes.HasPrefix(data, []byte("<")):
+		if bytes.Contains(data, []byte("cyclonedx.org/schema/bom")) {
+			return FormatCycloneDxXML, nil
+		}
+	case bytes.HasPrefix(data, []byte("SPDXVersion:")):
+		return FormatSpdxTagValue, nil
+	}
+	return "", errors.New("unsupported SBOM format, supported formats are: " +
+		FormatJson + ", " + FormatCycloneDxJSON + ", " + FormatCycloneDxXML + ", " + FormatSpdxJSON + ", " + FormatSpdxTagValue)
+}
+
+// Parse reads an SBOM in any of the supported formats
+func Parse(data []byte) (*Sbom, error) {
+	format, err := DetectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+	bom, err := NewDecoder(format).Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bom.Asset == nil {
+		bom.Asset = &Asset{}
+	}
+	if bom.Asset.Platform == nil {
+		bom.Asset.Platform = &Platform{}
+	}
+	if bom.Asset.Platform.Name == "" {
+		bom.Asset.Platform.Name, bom.Asset.Platform.Version = platformFromPackages(bom.Packages)
+	}
+	return bom, nil
+}
+
+// ParseFile reads an SBOM file in any of the supported formats
+func ParseFile(path string) (*Sbom, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// newPackageFromPurl creates a package and fills in the details that are
+// only available in the package url
+func newPackageFromPurl(name string, version string, purl string) *Package {
+	pkg := &Package{
+		Name:    name,
+		Version: version,
+		Purl:    purl,
+	}
+
+	if purl == "" {
+		return pkg
+	}
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return pkg
+	}
+
+	pkg.Type = p.Type
+	// os packages use the package format as type
+	if p.Type == packageurl.TypeAlpm {
+		pkg.Type = "pacman"
+	}
+	pkg.Architecture = p.Qualifiers.Map()["arch"]
+	if pkg.Name == "" {
+		pkg.Name = p.Name
+		if p.Namespace != "" && (p.Type == packageurl.TypeNPM || p.Type == packageurl.TypeGolang) {
+			pkg.Name = p.Namespace + "/" + p.Name
+		}
+	}
+	if pkg.Version == "" {
+		pkg.Version = p.Version
+	}
+	return pkg
+}
+
+var distroQualifier = regexp.MustCompile(`^(.+?)-(\d.*)$`)
+
+// platformFromPackages determines the name and version of the operating
+// system from the distro qualifier of the package urls, e.g.
+// pkg:apk/alpine/musl@1.2.4-r2?distro=alpine-3.19.1
+func platformFromPackages(pkgs []*Package) (string, string) {
+	for i := range pkgs {
+		if pkgs[i].Purl == "" {
+			continue
+		}
+		p, err := packageurl.FromString(pkgs[i].Purl)
+		if err != nil {
+			continue
+		}
+		distro := p.Qualifiers.Map()["distro"]
+		if distro == "" {
+			continue
+		}
+		m := distroQualifier.FindStringSubmatch(distro)
+		if m == nil {
+			return distro, ""
+		}
+		return m[1], m[2]
+	}
+	return "", ""
+}
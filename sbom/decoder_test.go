@@ -0,0 +1,154 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		data   string
+		format string
+	}{
+		{data: `{"generator": {"name": "cnquery"}, "packages": []}`, format: FormatJson},
+		{data: `{"bomFormat": "CycloneDX", "specVersion": "1.5"}`, format: FormatCycloneDxJSON},
+		{data: `<?xml version="1.0" encoding="UTF-8"?><bom xmlns="http://cyclonedx.org/schema/bom/1.5"></bom>`, format: FormatCycloneDxXML},
+		{data: `{"spdxVersion": "SPDX-2.3"}`, format: FormatSpdxJSON},
+		{data: "SPDXVersion: SPDX-2.3\nDataLicense: CC0-1.0\n", format: FormatSpdxTagValue},
+	}
+	for _, test := range tests {
+		format, err := DetectFormat([]byte(test.data))
+		require.NoError(t, err)
+		assert.Equal(t, test.format, format)
+	}
+
+	_, err := DetectFormat([]byte(`{"name": "my-app"}`))
+	assert.Error(t, err)
+	_, err = DetectFormat([]byte("FROM alpine"))
+	assert.Error(t, err)
+}
+
+// packageIds returns type/name/version of all packages
+func packageIds(bom *Sbom) []string {
+	res := []string{}
+	for _, pkg := range bom.Packages {
+		res = append(res, pkg.Type+"/"+pkg.Name+"/"+pkg.Version)
+	}
+	return res
+}
+
+func findPackage(bom *Sbom, name string) *Package {
+	for _, pkg := range bom.Packages {
+		if pkg.Name == name {
+			return pkg
+		}
+	}
+	return nil
+}
+
+func TestParseRenderedBom(t *testing.T) {
+	r := loadTestReport(t)
+	sboms, err := GenerateBom(r)
+	require.NoError(t, err)
+	selectedBom := sboms[0]
+
+	for _, format := range []string{FormatJson, FormatCycloneDxJSON, FormatCycloneDxXML, FormatSpdxJSON, FormatSpdxTagValue} {
+		t.Run(format, func(t *testing.T) {
+			output := bytes.Buffer{}
+			err := NewExporter(format).Render(&output, selectedBom)
+			require.NoError(t, err)
+
+			detected, err := DetectFormat(output.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, format, detected)
+
+			bom, err := Parse(output.Bytes())
+			require.NoError(t, err)
+			assert.ElementsMatch(t, packageIds(selectedBom), packageIds(bom))
+
+			busybox := findPackage(bom, "busybox")
+			require.NotNil(t, busybox)
+			assert.Equal(t, "apk", busybox.Type)
+			assert.Equal(t, "GPL-2.0-only", busybox.Licenses[0])
+			assert.Contains(t, busybox.Purl, "pkg:apk/alpine/busybox@")
+
+			npm := findPackage(bom, "npm")
+			require.NotNil(t, npm)
+			assert.Equal(t, []string{"pkg:npm/semver@7.5.4"}, npm.Dependencies)
+
+			pip := findPackage(bom, "pip")
+			require.NotNil(t, pip)
+			assert.Equal(t, findPackage(selectedBom, "pip").layerEvidence(), pip.layerEvidence())
+		})
+	}
+}
+
+func TestParseCycloneDXPlatform(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "metadata": {
+    "component": {"type": "container", "name": "alpine:3.19"}
+  },
+  "components": [
+    {"type": "operating-system", "name": "alpine", "version": "3.19.1"},
+    {
+      "bom-ref": "app",
+      "type": "application",
+      "name": "app",
+      "components": [
+        {"bom-ref": "express", "type": "library", "name": "express", "version": "4.19.2", "purl": "pkg:npm/express@4.19.2"}
+      ]
+    },
+    {"type": "library", "name": "filesystem", "version": "3.18", "purl": "pkg:alpm/arch/filesystem@3.18?arch=any"}
+  ]
+}`
+	bom, err := Parse([]byte(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "alpine:3.19", bom.Asset.Name)
+	assert.Equal(t, "alpine", bom.Asset.Platform.Name)
+	assert.Equal(t, "3.19.1", bom.Asset.Platform.Version)
+	// nested components are part of the inventory
+	assert.Equal(t, []string{"/app/", "npm/express/4.19.2", "pacman/filesystem/3.18"}, packageIds(bom))
+	assert.Equal(t, "any", bom.Packages[2].Architecture)
+}
+
+func TestNewPackageFromPurl(t *testing.T) {
+	pkg := newPackageFromPurl("", "", "pkg:npm/%40babel/code-frame@7.24.2")
+	assert.Equal(t, "@babel/code-frame", pkg.Name)
+	assert.Equal(t, "7.24.2", pkg.Version)
+	assert.Equal(t, "npm", pkg.Type)
+
+	pkg = newPackageFromPurl("", "", "pkg:golang/github.com/spf13/afero@v1.11.0")
+	assert.Equal(t, "github.com/spf13/afero", pkg.Name)
+
+	// only npm and go packages include the namespace in the name
+	pkg = newPackageFromPurl("", "", "pkg:deb/debian/libc6@2.36-9?arch=amd64")
+	assert.Equal(t, "libc6", pkg.Name)
+	assert.Equal(t, "amd64", pkg.Architecture)
+}
+
+func TestPlatformFromPackages(t *testing.T) {
+	name, version := platformFromPackages([]*Package{
+		{Name: "requests", Purl: "pkg:pypi/requests@2.31.0"},
+		{Name: "libc6", Purl: "pkg:deb/debian/libc6@2.36-9?arch=amd64&distro=debian-12"},
+	})
+	assert.Equal(t, "debian", name)
+	assert.Equal(t, "12", version)
+
+	name, version = platformFromPackages([]*Package{
+		{Name: "bash", Purl: "pkg:rpm/opensuse/bash@5.2.15?distro=opensuse-leap-15.5"},
+	})
+	assert.Equal(t, "opensuse-leap", name)
+	assert.Equal(t, "15.5", version)
+
+	name, _ = platformFromPackages([]*Package{{Name: "requests"}})
+	assert.Equal(t, "", name)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"cmp"
+	"slices"
+)
+
+// PackageChange is a package that was added, removed or changed between two SBOMs
+type PackageChange struct {
+	Type         string `json:"type,omitempty"`
+	Name         string `json:"name"`
+	Architecture string `json:"architecture,omitempty"`
+	OldVersion   string `json:"old_version,omitempty"`
+	NewVersion   string `json:"new_version,omitempty"`
+}
+
+// Diff contains all package changes between two SBOMs
+type Diff struct {
+	Old     string           `json:"old,omitempty"`
+	New     string           `json:"new,omitempty"`
+	Added   []*PackageChange `json:"added"`
+	Removed []*PackageChange `json:"removed"`
+	Changed []*PackageChange `json:"changed"`
+}
+
+// Empty reports whether both SBOMs contain the same packages
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Types returns the sorted package types with changes
+func (d *Diff) Types() []string {
+	types := []string{}
+	for _, list := range [][]*PackageChange{d.Added, d.Removed, d.Changed} {
+		for i := range list {
+			if !slices.Contains(types, list[i].Type) {
+				types = append(types, list[i].Type)
+			}
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+// ByType returns all changes of a package type, sorted by name
+func (d *Diff) ByType(pkgType string) []*PackageChange {
+	res := []*PackageChange{}
+	for _, list := range [][]*PackageChange{d.Added, d.Removed, d.Changed} {
+		for i := range list {
+			if list[i].Type == pkgType {
+				res = append(res, list[i])
+			}
+		}
+	}
+	slices.SortStableFunc(res, comparePackageChanges)
+	return res
+}
+
+// packageKey identifies a package independent of its version
+type packageKey struct {
+	Type string
+	Name string
+	Arch string
+}
+
+// NewDiff compares the packages of two SBOMs. Packages are identified by
+// type, name and architecture. A package that is installed in multiple
+// versions is only reported as changed if exactly one version was replaced.
+func NewDiff(old *Sbom, new *Sbom) *Diff {
+	diff := &Diff{
+		Old:     old.GetAsset().GetName(),
+		New:     new.GetAsset().GetName(),
+		Added:   []*PackageChange{},
+		Removed: []*PackageChange{},
+		Changed: []*PackageChange{},
+	}
+
+	oldVersions := packageVersions(old)
+	newVersions := packageVersions(new)
+
+	for key, versions := range oldVersions {
+		removed := []string{}
+		for _, v := range versions {
+			if !slices.Contains(newVersions[key], v) {
+				removed = append(removed, v)
+			}
+		}
+		added := []string{}
+		for _, v := range newVersions[key] {
+			if !slices.Contains(versions, v) {
+				added = append(added, v)
+			}
+		}
+
+		if len(removed) == 1 && len(added) == 1 {
+			diff.Changed = append(diff.Changed, newPackageChange(key, removed[0], added[0]))
+			continue
+		}
+		for _, v := range removed {
+			diff.Removed = append(diff.Removed, newPackageChange(key, v, ""))
+		}
+		for _, v := range added {
+			diff.Added = append(diff.Added, newPackageChange(key, "", v))
+		}
+	}
+
+	for key, versions := range newVersions {
+		if _, ok := oldVersions[key]; ok {
+			continue
+		}
+		for _, v := range versions {
+			diff.Added = append(diff.Added, newPackageChange(key, "", v))
+		}
+	}
+
+	slices.SortFunc(diff.Added, comparePackageChanges)
+	slices.SortFunc(diff.Removed, comparePackageChanges)
+	slices.SortFunc(diff.Changed, comparePackageChanges)
+	return diff
+}
+
+// packageVersions collects the distinct versions of all packages
+func packageVersions(bom *Sbom) map[packageKey][]string {
+	res := map[packageKey][]string{}
+	for _, pkg := range bom.GetPackages() {
+		key := packageKey{Type: pkg.Type, Name: pkg.Name, Arch: pkg.Architecture}
+		if !slices.Contains(res[key], pkg.Version) {
+			res[key] = append(res[key], pkg.Version)
+		}
+	}
+	return res
+}
+
+func newPackageChange(key packageKey, oldVersion string, newVersion string) *PackageChange {
+	return &PackageChange{
+		Type:         key.Type,
+		Name:         key.Name,
+		Architecture: key.Arch,
+		OldVersion:   oldVersion,
+		NewVersion:   newVersion,
+	}
+}
+
+func comparePackageChanges(a, b *PackageChange) int {
+	return cmp.Or(
+		cmp.Compare(a.Type, b.Type),
+		cmp.Compare(a.Name, b.Name),
+		cmp.Compare(a.Architecture, b.Architecture),
+		cmp.Compare(a.OldVersion, b.OldVersion),
+		cmp.Compare(a.NewVersion, b.NewVersion),
+	)
+}
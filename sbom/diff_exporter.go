@@ -0,0 +1,128 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+type DiffExporter interface {
+	Render(w io.Writer, diff *Diff) error
+}
+
+func AllDiffFormats() string {
+	formats := []string{
+		FormatList, FormatJson, FormatMarkdown,
+	}
+
+	return strings.Join(formats, ", ")
+}
+
+func NewDiffExporter(format string) DiffExporter {
+	switch format {
+	case FormatJson:
+		return &DiffJSON{}
+	case FormatMarkdown:
+		return &DiffMarkdown{}
+	case FormatList:
+		fallthrough
+	default:
+		return &DiffTable{}
+	}
+}
+
+func (c *PackageChange) change() string {
+	switch {
+	case c.OldVersion == "":
+		return changeAdded
+	case c.NewVersion == "":
+		return changeRemoved
+	default:
+		return changeChanged
+	}
+}
+
+func (d *Diff) summary() string {
+	return fmt.Sprintf("%d added, %d removed, %d changed", len(d.Added), len(d.Removed), len(d.Changed))
+}
+
+type DiffJSON struct{}
+
+func (e *DiffJSON) Render(w io.Writer, diff *Diff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diff)
+}
+
+type DiffTable struct{}
+
+func (e *DiffTable) Render(w io.Writer, diff *Diff) error {
+	if diff.Empty() {
+		_, err := fmt.Fprintln(w, "No package changes")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tNAME\tOLD VERSION\tNEW VERSION\tCHANGE")
+	for _, pkgType := range diff.Types() {
+		for _, c := range diff.ByType(pkgType) {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.Type, c.Name, c.OldVersion, c.NewVersion, c.change())
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintln(w, "\n"+diff.summary())
+	return err
+}
+
+type DiffMarkdown struct{}
+
+var markdownEscaper = strings.NewReplacer("|", "\\|")
+
+func (e *DiffMarkdown) Render(w io.Writer, diff *Diff) error {
+	sb := strings.Builder{}
+	sb.WriteString("## SBOM diff")
+	if diff.Old != "" || diff.New != "" {
+		sb.WriteString(": `" + diff.Old + "` → `" + diff.New + "`")
+	}
+	sb.WriteString("\n\n")
+
+	if diff.Empty() {
+		sb.WriteString("No package changes\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	sb.WriteString(diff.summary() + "\n")
+	for _, pkgType := range diff.Types() {
+		title := pkgType
+		if title == "" {
+			title = "other"
+		}
+		sb.WriteString("\n### " + title + "\n\n")
+		sb.WriteString("| Package | Old version | New version | Change |\n")
+		sb.WriteString("| --- | --- | --- | --- |\n")
+		for _, c := range diff.ByType(pkgType) {
+			sb.WriteString("| " + markdownEscaper.Replace(c.Name) +
+				" | " + markdownEscaper.Replace(c.OldVersion) +
+				" | " + markdownEscaper.Replace(c.NewVersion) +
+				" | " + c.change() + " |\n")
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
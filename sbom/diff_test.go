@@ -0,0 +1,121 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDiffBoms() (*Sbom, *Sbom) {
+	old := &Sbom{
+		Asset: &Asset{Name: "alpine:3.18"},
+		Packages: []*Package{
+			{Type: "apk", Name: "busybox", Version: "1.36.1-r5", Architecture: "x86_64"},
+			{Type: "apk", Name: "musl", Version: "1.2.4-r2", Architecture: "x86_64"},
+			{Type: "apk", Name: "libcrypto3", Version: "3.1.4-r1", Architecture: "x86_64"},
+			{Type: "npm", Name: "semver", Version: "6.3.1"},
+			{Type: "npm", Name: "semver", Version: "7.5.4"},
+			{Type: "pypi", Name: "pip", Version: "23.0.1"},
+		},
+	}
+	new := &Sbom{
+		Asset: &Asset{Name: "alpine:3.19"},
+		Packages: []*Package{
+			{Type: "apk", Name: "busybox", Version: "1.36.1-r15", Architecture: "x86_64"},
+			{Type: "apk", Name: "musl", Version: "1.2.4-r2", Architecture: "x86_64"},
+			{Type: "apk", Name: "ca-certificates", Version: "20240226-r0", Architecture: "x86_64"},
+			{Type: "npm", Name: "semver", Version: "6.3.1"},
+			{Type: "npm", Name: "semver", Version: "7.6.0"},
+			{Type: "npm", Name: "semver", Version: "7.6.2"},
+			{Type: "pypi", Name: "pip", Version: "23.0.1"},
+		},
+	}
+	return old, new
+}
+
+func TestNewDiff(t *testing.T) {
+	old, new := testDiffBoms()
+	diff := NewDiff(old, new)
+
+	assert.Equal(t, "alpine:3.18", diff.Old)
+	assert.Equal(t, "alpine:3.19", diff.New)
+	assert.False(t, diff.Empty())
+
+	assert.Equal(t, []*PackageChange{
+		{Type: "apk", Name: "ca-certificates", Architecture: "x86_64", NewVersion: "20240226-r0"},
+		{Type: "npm", Name: "semver", NewVersion: "7.6.0"},
+		{Type: "npm", Name: "semver", NewVersion: "7.6.2"},
+	}, diff.Added)
+	// semver 7.5.4 cannot be matched to one of the new versions
+	assert.Equal(t, []*PackageChange{
+		{Type: "apk", Name: "libcrypto3", Architecture: "x86_64", OldVersion: "3.1.4-r1"},
+		{Type: "npm", Name: "semver", OldVersion: "7.5.4"},
+	}, diff.Removed)
+	assert.Equal(t, []*PackageChange{
+		{Type: "apk", Name: "busybox", Architecture: "x86_64", OldVersion: "1.36.1-r5", NewVersion: "1.36.1-r15"},
+	}, diff.Changed)
+
+	assert.Equal(t, []string{"apk", "npm"}, diff.Types())
+	assert.Len(t, diff.ByType("apk"), 3)
+
+	assert.True(t, NewDiff(old, old).Empty())
+}
+
+func TestDiffExporter(t *testing.T) {
+	old, new := testDiffBoms()
+	diff := NewDiff(old, new)
+
+	t.Run("table", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, NewDiffExporter(FormatList).Render(&output, diff))
+		assert.Equal(t, `TYPE  NAME             OLD VERSION  NEW VERSION  CHANGE
+apk   busybox          1.36.1-r5    1.36.1-r15   changed
+apk   ca-certificates               20240226-r0  added
+apk   libcrypto3       3.1.4-r1                  removed
+npm   semver                        7.6.0        added
+npm   semver                        7.6.2        added
+npm   semver           7.5.4                     removed
+
+3 added, 2 removed, 1 changed
+`, output.String())
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, NewDiffExporter(FormatMarkdown).Render(&output, diff))
+		assert.Equal(t, "## SBOM diff: `alpine:3.18` → `alpine:3.19`\n\n"+
+			"3 added, 2 removed, 1 changed\n\n"+
+			"### apk\n\n"+
+			"| Package | Old version | New version | Change |\n"+
+			"| --- | --- | --- | --- |\n"+
+			"| busybox | 1.36.1-r5 | 1.36.1-r15 | changed |\n"+
+			"| ca-certificates |  | 20240226-r0 | added |\n"+
+			"| libcrypto3 | 3.1.4-r1 |  | removed |\n\n"+
+			"### npm\n\n"+
+			"| Package | Old version | New version | Change |\n"+
+			"| --- | --- | --- | --- |\n"+
+			"| semver |  | 7.6.0 | added |\n"+
+			"| semver |  | 7.6.2 | added |\n"+
+			"| semver | 7.5.4 |  | removed |\n", output.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, NewDiffExporter(FormatJson).Render(&output, diff))
+		res := &Diff{}
+		require.NoError(t, json.Unmarshal(output.Bytes(), res))
+		assert.Equal(t, diff, res)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		output := bytes.Buffer{}
+		require.NoError(t, NewDiffExporter(FormatList).Render(&output, NewDiff(old, old)))
+		assert.Equal(t, "No package changes\n", output.String())
+	})
+}
@@ -15,6 +15,7 @@ const (
 	FormatSpdxJSON      string = "spdx-json"
 	FormatSpdxTagValue  string = "spdx-tag-value"
 	FormatList          string = "table"
+	FormatMarkdown      string = "markdown"
 )
 
 func AllFormats() string {
@@ -0,0 +1,75 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"regexp"
+	"strings"
+)
+
+var licenseIdRegex = regexp.MustCompile(`^(LicenseRef-)?[A-Za-z0-9.\-]+\+?$`)
+
+// isLicenseExpression reports whether a license is a valid SPDX license
+// expression, e.g. "MIT" or "(MIT OR Apache-2.0) AND BSD-3-Clause". Package
+// managers often report free-form license names like "GPLv2+ and BSD" instead.
+// see https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+func isLicenseExpression(license string) bool {
+	fields := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(license))
+	depth := 0
+	// whether we expect a license or an opening parenthesis next
+	operand := true
+	for _, field := range fields {
+		switch {
+		case field == "(":
+			if !operand {
+				return false
+			}
+			depth++
+		case field == ")":
+			if operand || depth == 0 {
+				return false
+			}
+			depth--
+		case field == "AND" || field == "OR" || field == "WITH":
+			if operand {
+				return false
+			}
+			operand = true
+		case licenseIdRegex.MatchString(field):
+			if !operand {
+				return false
+			}
+			operand = false
+		default:
+			return false
+		}
+	}
+	return len(fields) > 0 && !operand && depth == 0
+}
+
+// licenseExpression combines the licenses of a package into a single SPDX
+// license expression. It returns false if any of the licenses is not a valid
+// expression.
+func licenseExpression(licenses []string) (string, bool) {
+	if len(licenses) == 0 {
+		return "", false
+	}
+	for i := range licenses {
+		if !isLicenseExpression(licenses[i]) {
+			return "", false
+		}
+	}
+	if len(licenses) == 1 {
+		return licenses[0], true
+	}
+
+	parts := make([]string, len(licenses))
+	for i := range licenses {
+		parts[i] = licenses[i]
+		if strings.Contains(parts[i], " ") {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " AND "), true
+}
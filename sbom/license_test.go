@@ -0,0 +1,49 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLicenseExpression(t *testing.T) {
+	tests := []struct {
+		license  string
+		expected bool
+	}{
+		{"MIT", true},
+		{"GPL-2.0+", true},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", true},
+		{"(MIT OR Apache-2.0) AND BSD-3-Clause", true},
+		{"LicenseRef-custom", true},
+		{"", false},
+		{"Public Domain", false},
+		{"GPLv2+ and BSD", false},
+		{"MIT OR", false},
+		{"(MIT", false},
+		{"MIT)", false},
+	}
+
+	for i := range tests {
+		assert.Equal(t, tests[i].expected, isLicenseExpression(tests[i].license), tests[i].license)
+	}
+}
+
+func TestLicenseExpression(t *testing.T) {
+	expr, ok := licenseExpression([]string{"MIT"})
+	assert.True(t, ok)
+	assert.Equal(t, "MIT", expr)
+
+	expr, ok = licenseExpression([]string{"MIT OR Apache-2.0", "BSD-3-Clause"})
+	assert.True(t, ok)
+	assert.Equal(t, "(MIT OR Apache-2.0) AND BSD-3-Clause", expr)
+
+	_, ok = licenseExpression([]string{"MIT", "Public Domain"})
+	assert.False(t, ok)
+
+	_, ok = licenseExpression(nil)
+	assert.False(t, ok)
+}
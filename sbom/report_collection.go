@@ -26,11 +26,21 @@ type BomPackage struct {
 	Format  string   `json:"format,omitempty"`
 	Purl    string   `json:"purl,omitempty"`
 	CPEs    []string `json:"cpes.map,omitempty"`
+	License string   `json:"license,omitempty"`
+	// package urls of the dependencies, used by python and npm packages
+	Dependencies []string `json:"dependencies.map,omitempty"`
 	// used by python packages
 	// deprecated: remove once python.packages uses files
 	FilePath string `json:"file.path,omitempty"`
 	// used by os packages
 	FilePaths []string `json:"files.map,omitempty"`
+	// container image layer that introduced the package
+	Layer *BomLayer `json:"layer,omitempty"`
+}
+
+type BomLayer struct {
+	Digest    string `json:"digest,omitempty"`
+	CreatedBy string `json:"createdBy,omitempty"`
 }
 
 type KernelInstalled struct {
@@ -44,6 +54,12 @@ type BomFields struct {
 	Packages        []BomPackage      `json:"packages.list,omitempty"`
 	PythonPackages  []BomPackage      `json:"python.packages,omitempty"`
 	NpmPackages     []BomPackage      `json:"npm.packages.list,omitempty"`
+	JavaPackages    []BomPackage      `json:"java.packages.list,omitempty"`
+	GolangPackages  []BomPackage      `json:"golang.binaries.packages,omitempty"`
+	RustPackages    []BomPackage      `json:"rust.packages.list,omitempty"`
+	RubyPackages    []BomPackage      `json:"ruby.packages.list,omitempty"`
+	PhpPackages     []BomPackage      `json:"php.packages.list,omitempty"`
+	DotnetPackages  []BomPackage      `json:"dotnet.packages.list,omitempty"`
 	KernelInstalled []KernelInstalled `json:"kernel.installed,omitempty"`
 }
 
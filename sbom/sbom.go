@@ -9,6 +9,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -123,6 +124,7 @@ func GenerateBom(r *reporter.Report) ([]*Sbom, error) {
 						Purl:         pkg.Purl,
 						Cpes:         pkg.CPEs,
 						Type:         pkg.Format,
+						Licenses:     newLicenses(pkg.License),
 					}
 
 					for _, filepath := range pkg.FilePaths {
@@ -131,6 +133,9 @@ func GenerateBom(r *reporter.Report) ([]*Sbom, error) {
 							Value: filepath,
 						})
 					}
+					if pkg.Layer != nil {
+						bomPkg.EvidenceList = append(bomPkg.EvidenceList, newLayerEvidence(pkg.Layer))
+					}
 
 					bom.Packages = append(bom.Packages, bomPkg)
 				}
@@ -138,11 +143,13 @@ func GenerateBom(r *reporter.Report) ([]*Sbom, error) {
 
 			for _, pkg := range rb.PythonPackages {
 				bomPkg := &Package{
-					Name:    pkg.Name,
-					Version: pkg.Version,
-					Purl:    pkg.Purl,
-					Cpes:    pkg.CPEs,
-					Type:    "pypi",
+					Name:         pkg.Name,
+					Version:      pkg.Version,
+					Purl:         pkg.Purl,
+					Cpes:         pkg.CPEs,
+					Type:         "pypi",
+					Licenses:     newLicenses(pkg.License),
+					Dependencies: pkg.Dependencies,
 				}
 
 				// deprecated path, all files are now in the FilePaths field
@@ -160,17 +167,22 @@ func GenerateBom(r *reporter.Report) ([]*Sbom, error) {
 						Value: filepath,
 					})
 				}
+				if pkg.Layer != nil {
+					bomPkg.EvidenceList = append(bomPkg.EvidenceList, newLayerEvidence(pkg.Layer))
+				}
 
 				bom.Packages = append(bom.Packages, bomPkg)
 			}
 
 			for _, pkg := range rb.NpmPackages {
 				bomPkg := &Package{
-					Name:    pkg.Name,
-					Version: pkg.Version,
-					Purl:    pkg.Purl,
-					Cpes:    pkg.CPEs,
-					Type:    "npm",
+					Name:         pkg.Name,
+					Version:      pkg.Version,
+					Purl:         pkg.Purl,
+					Cpes:         pkg.CPEs,
+					Type:         "npm",
+					Licenses:     newLicenses(pkg.License),
+					Dependencies: pkg.Dependencies,
 				}
 
 				for _, filepath := range pkg.FilePaths {
@@ -179,15 +191,108 @@ func GenerateBom(r *reporter.Report) ([]*Sbom, error) {
 						Value: filepath,
 					})
 				}
+				if pkg.Layer != nil {
+					bomPkg.EvidenceList = append(bomPkg.EvidenceList, newLayerEvidence(pkg.Layer))
+				}
 
 				bom.Packages = append(bom.Packages, bomPkg)
 			}
+
+			bom.Packages = append(bom.Packages, newBomPackages(rb.JavaPackages, "maven")...)
+			bom.Packages = append(bom.Packages, newBomPackages(rb.GolangPackages, "golang")...)
+			bom.Packages = append(bom.Packages, newBomPackages(rb.RustPackages, "cargo")...)
+			bom.Packages = append(bom.Packages, newBomPackages(rb.RubyPackages, "gem")...)
+			bom.Packages = append(bom.Packages, newBomPackages(rb.PhpPackages, "composer")...)
+			bom.Packages = append(bom.Packages, newBomPackages(rb.DotnetPackages, "nuget")...)
 		}
 		boms = append(boms, bom)
 	}
 	return boms, nil
 }
 
+// newBomPackages converts the language packages of a report into bom
+// packages of the given type, with the files they were found in as evidence
+func newBomPackages(pkgs []BomPackage, pkgType string) []*Package {
+	res := make([]*Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		bomPkg := &Package{
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			Purl:    pkg.Purl,
+			Cpes:    pkg.CPEs,
+			Type:    pkgType,
+		}
+
+		for _, filepath := range pkg.FilePaths {
+			bomPkg.EvidenceList = append(bomPkg.EvidenceList, &Evidence{
+				Type:  EvidenceType_EVIDENCE_TYPE_FILE,
+				Value: filepath,
+			})
+		}
+
+		res = append(res, bomPkg)
+	}
+	return res
+}
+
+// newLayerEvidence returns the evidence for the container image layer that
+// introduced a package
+func newLayerEvidence(layer *BomLayer) *Evidence {
+	return &Evidence{
+		Type:      EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER,
+		Value:     layer.Digest,
+		CreatedBy: layer.CreatedBy,
+	}
+}
+
+// layerEvidence returns the container image layer that introduced the
+// package or nil if it is unknown
+func (b *Package) layerEvidence() *Evidence {
+	for i := range b.EvidenceList {
+		if b.EvidenceList[i].Type == EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER {
+			return b.EvidenceList[i]
+		}
+	}
+	return nil
+}
+
+// FileLocations returns the paths of the files that the package was found in
+func (b *Package) FileLocations() []string {
+	res := []string{}
+	// pkg.Location is deprecated, use pkg.Evidences instead
+	if b.Location != "" {
+		res = append(res, b.Location)
+	}
+	for i := range b.EvidenceList {
+		e := b.EvidenceList[i]
+		if e.Type == EvidenceType_EVIDENCE_TYPE_FILE && e.Value != "" && !slices.Contains(res, e.Value) {
+			res = append(res, e.Value)
+		}
+	}
+	return res
+}
+
+// PackagesByType returns all packages with one of the given types
+func (b *Sbom) PackagesByType(types ...string) []*Package {
+	res := []*Package{}
+	for i := range b.Packages {
+		if slices.Contains(types, b.Packages[i].Type) {
+			res = append(res, b.Packages[i])
+		}
+	}
+	return res
+}
+
+// newLicenses returns the licenses of a package, packages without license
+// information have none
+func newLicenses(license string) []string {
+	license = strings.TrimSpace(license)
+	if license == "" {
+		return nil
+	}
+	return []string{license}
+}
+
 func (b *Package) Hash() (string, error) {
 	hash, err := hashstructure.Hash(b, hashstructure.FormatV2, nil)
 	if err != nil {
@@ -40,26 +40,78 @@ func TestSbomParsing(t *testing.T) {
 	// search os package
 	pkg := findProtoPkg(selectedBom.Packages, "alpine-baselayout")
 	assert.Equal(t, "alpine-baselayout", pkg.Name)
+	assert.Equal(t, []string{"GPL-2.0-only"}, pkg.Licenses)
 	assert.Contains(t, pkg.EvidenceList, &Evidence{
 		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
 		Value: "etc/profile.d/color_prompt.sh.disabled",
 	})
+	assert.Contains(t, pkg.EvidenceList, &Evidence{
+		Type:      EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER,
+		Value:     "sha256:aedc3bda2944bb9bcb6c3d475bee8b460db9a9b0f3e0b33a6ed2fd1ae0f1d445",
+		CreatedBy: "/bin/sh -c #(nop) ADD file:9a4f77dfaba7fd2aa78186e4ef0e7486ad55101cefc1fabbc1b385601bb38920 in /",
+	})
 
 	// search python package
 	pkg = findProtoPkg(selectedBom.Packages, "pip")
 	assert.Equal(t, "pip", pkg.Name)
+	assert.Equal(t, []string{"MIT"}, pkg.Licenses)
+	assert.Empty(t, pkg.Dependencies)
 	assert.Contains(t, pkg.EvidenceList, &Evidence{
 		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
 		Value: "/opt/lib/python3.9/site-packages/pip-21.2.4.dist-info/METADATA",
 	})
+	assert.Contains(t, pkg.EvidenceList, &Evidence{
+		Type:      EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER,
+		Value:     "sha256:4d1ba0b5fbc5c24a4eea1aeb2ea0d1bb6b5e4c2bb6c0bcd4b1e03ac8fc3d0a8c",
+		CreatedBy: "RUN /bin/sh -c apk add --no-cache python3 py3-pip # buildkit",
+	})
 
 	// search npm package
 	pkg = findProtoPkg(selectedBom.Packages, "npm")
 	assert.Equal(t, "npm", pkg.Name)
+	assert.Equal(t, []string{"Artistic-2.0"}, pkg.Licenses)
+	assert.Equal(t, []string{"pkg:npm/semver@7.5.4"}, pkg.Dependencies)
 	assert.Contains(t, pkg.EvidenceList, &Evidence{
 		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
 		Value: "/opt/lib/node_modules/npm/package.json",
 	})
+
+	// search java package
+	pkg = findProtoPkg(selectedBom.Packages, "log4j-core")
+	assert.Equal(t, "maven", pkg.Type)
+	assert.Empty(t, pkg.Licenses)
+	assert.Equal(t, "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", pkg.Purl)
+	assert.Contains(t, pkg.EvidenceList, &Evidence{
+		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
+		Value: "/opt/app/shop-1.0.war!/WEB-INF/lib/log4j-core-2.14.1.jar",
+	})
+
+	// search go package
+	pkg = findProtoPkg(selectedBom.Packages, "stdlib")
+	assert.Equal(t, "golang", pkg.Type)
+	assert.Equal(t, "pkg:golang/stdlib@1.22.5", pkg.Purl)
+	assert.Contains(t, pkg.EvidenceList, &Evidence{
+		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
+		Value: "/usr/local/bin/app",
+	})
+
+	// search language packages
+	pkg = findProtoPkg(selectedBom.Packages, "log")
+	assert.Equal(t, "cargo", pkg.Type)
+	assert.Equal(t, "pkg:cargo/log@0.4.21", pkg.Purl)
+	pkg = findProtoPkg(selectedBom.Packages, "rack")
+	assert.Equal(t, "gem", pkg.Type)
+	assert.Equal(t, "pkg:gem/rack@2.2.8", pkg.Purl)
+	pkg = findProtoPkg(selectedBom.Packages, "guzzlehttp/guzzle")
+	assert.Equal(t, "composer", pkg.Type)
+	assert.Equal(t, "pkg:composer/guzzlehttp/guzzle@7.8.1", pkg.Purl)
+	pkg = findProtoPkg(selectedBom.Packages, "Newtonsoft.Json")
+	assert.Equal(t, "nuget", pkg.Type)
+	assert.Equal(t, "pkg:nuget/Newtonsoft.Json@13.0.1", pkg.Purl)
+	assert.Contains(t, pkg.EvidenceList, &Evidence{
+		Type:  EvidenceType_EVIDENCE_TYPE_FILE,
+		Value: "/app/app.deps.json",
+	})
 }
 
 func findProtoPkg(pkgs []*Package, name string) *Package {
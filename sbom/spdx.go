@@ -5,8 +5,10 @@ package sbom
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/spdx/tools-golang/convert"
+	spdxjson "github.com/spdx/tools-golang/json"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/v2/v2_1"
 	"github.com/spdx/tools-golang/spdx/v2/v2_2"
@@ -14,6 +16,8 @@ import (
 	"github.com/spdx/tools-golang/tagvalue"
 	"io"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -40,6 +44,10 @@ func (s *Spdx) convert(bom *Sbom) *spdx.Document {
 		},
 	}
 
+	// package ids by package url, used to resolve the dependencies between packages
+	ids := map[string]spdx.ElementID{}
+	otherLicenses := map[string]struct{}{}
+
 	for i := range bom.Packages {
 		pkg := bom.Packages[i]
 
@@ -64,20 +72,93 @@ func (s *Spdx) convert(bom *Sbom) *spdx.Document {
 			})
 		}
 
+		license := "NOASSERTION"
+		if expression, ok := licenseExpression(pkg.Licenses); ok {
+			license = expression
+		} else if len(pkg.Licenses) > 0 {
+			// licenses that are not on the SPDX license list are referenced with
+			// their extracted text
+			name := strings.Join(pkg.Licenses, ", ")
+			license = NewSPDXLicenseRef(name)
+			if _, ok := otherLicenses[license]; !ok {
+				otherLicenses[license] = struct{}{}
+				doc.OtherLicenses = append(doc.OtherLicenses, &spdx.OtherLicense{
+					LicenseIdentifier: license,
+					LicenseName:       name,
+					ExtractedText:     name,
+				})
+			}
+		}
+
+		id := NewSPDXPackageID(pkg)
+		if _, ok := ids[pkg.Purl]; pkg.Purl != "" && !ok {
+			ids[pkg.Purl] = id
+		}
+
 		doc.Packages = append(doc.Packages, &spdx.Package{
-			PackageSPDXIdentifier:     NewSPDXPackageID(pkg),
+			PackageSPDXIdentifier:     id,
 			PackageName:               pkg.Name,
 			PackageVersion:            pkg.Version,
-			PackageLicenseDeclared:    pkg.Version,
+			PackageLicenseConcluded:   license,
+			PackageLicenseDeclared:    license,
 			PackageDescription:        pkg.Description,
 			PackageExternalReferences: refs,
 			PackageFileName:           pkg.Location,
+			PackageSourceInfo:         spdxLayerSourceInfo(pkg.layerEvidence()),
 		})
 	}
 
+	for i := range bom.Packages {
+		pkg := bom.Packages[i]
+		id, ok := ids[pkg.Purl]
+		if !ok || doc.Packages[i].PackageSPDXIdentifier != id {
+			continue
+		}
+
+		for _, purl := range pkg.Dependencies {
+			// only reference packages that are part of the document
+			depId, ok := ids[purl]
+			if !ok {
+				continue
+			}
+			doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+				RefA:         spdx.DocElementID{ElementRefID: id},
+				RefB:         spdx.DocElementID{ElementRefID: depId},
+				Relationship: spdx.RelationshipDependsOn,
+			})
+		}
+	}
+
 	return doc
 }
 
+// the source info of a package records the container image layer that
+// introduced it, SPDX 2.x has no dedicated field for it
+const spdxLayerSourceInfoPrefix = "introduced by image layer "
+
+func spdxLayerSourceInfo(layer *Evidence) string {
+	if layer == nil {
+		return ""
+	}
+	if layer.CreatedBy == "" {
+		return spdxLayerSourceInfoPrefix + layer.Value
+	}
+	return spdxLayerSourceInfoPrefix + layer.Value + ": " + layer.CreatedBy
+}
+
+func parseSpdxLayerSourceInfo(sourceInfo string) *Evidence {
+	info, ok := strings.CutPrefix(sourceInfo, spdxLayerSourceInfoPrefix)
+	if !ok {
+		return nil
+	}
+	digest, createdBy, _ := strings.Cut(info, ": ")
+	return &Evidence{
+		Type:      EvidenceType_EVIDENCE_TYPE_IMAGE_LAYER,
+		Value:     digest,
+		CreatedBy: createdBy,
+	}
+}
+
 var expr = regexp.MustCompile("[^a-zA-Z0-9.-]")
 
 // NewSPDXPackageID creates a new SPDX ID for a package
@@ -86,10 +167,17 @@ func NewSPDXPackageID(pkg *Package) spdx.ElementID {
 	hash, _ := pkg.Hash()
 
 	id := fmt.Sprintf("Package-%s-%s-%s", pkg.Type, pkg.Name, hash)
-	expr.ReplaceAllString(id, "-")
+	id = expr.ReplaceAllString(id, "-")
 	return spdx.ElementID(id)
 }
 
+// NewSPDXLicenseRef creates a license reference for a license that is not on
+// the SPDX license list
+// see https://spdx.github.io/spdx-spec/v2.3/other-licensing-information-detected/
+func NewSPDXLicenseRef(name string) string {
+	return "LicenseRef-" + strings.Trim(expr.ReplaceAllString(name, "-"), "-")
+}
+
 func (s *Spdx) Render(w io.Writer, bom *Sbom) error {
 	spdxLatestBom := s.convert(bom)
 
@@ -134,3 +222,111 @@ func (s *Spdx) Render(w io.Writer, bom *Sbom) error {
 		return enc.Encode(spdxBom)
 	}
 }
+
+func (s *Spdx) Parse(r io.Reader) (*Sbom, error) {
+	var doc *spdx.Document
+	var err error
+	if s.Format == FormatSpdxTagValue {
+		doc, err = tagvalue.Read(r)
+	} else {
+		doc, err = spdxjson.Read(r)
+	}
+	if err != nil {
+		return nil, errors.New("could not parse SPDX document: " + err.Error())
+	}
+	return s.parse(doc), nil
+}
+
+func (s *Spdx) parse(doc *spdx.Document) *Sbom {
+	bom := &Sbom{
+		Asset: &Asset{
+			Name:     doc.DocumentName,
+			Platform: &Platform{},
+		},
+	}
+
+	if doc.CreationInfo != nil {
+		bom.Timestamp = doc.CreationInfo.Created
+		for _, creator := range doc.CreationInfo.Creators {
+			if creator.CreatorType == "Tool" {
+				bom.Generator = &Generator{Name: creator.Creator}
+				break
+			}
+		}
+	}
+
+	// names of the licenses that are not on the SPDX license list
+	otherLicenses := map[string]string{}
+	for _, l := range doc.OtherLicenses {
+		if l.LicenseName != "" && l.LicenseName != "NOASSERTION" {
+			otherLicenses[l.LicenseIdentifier] = l.LicenseName
+		}
+	}
+
+	pkgs := map[spdx.ElementID]*Package{}
+	for _, p := range doc.Packages {
+		purl := ""
+		cpes := []string{}
+		for _, ref := range p.PackageExternalReferences {
+			switch ref.RefType {
+			case spdx.PackageManagerPURL:
+				if purl == "" {
+					purl = ref.Locator
+				}
+			case spdx.SecurityCPE23Type, spdx.SecurityCPE22Type:
+				cpes = append(cpes, ref.Locator)
+			}
+		}
+
+		// see https://spdx.github.io/spdx-spec/v2.3/package-information/#724-primary-package-purpose-field
+		if p.PrimaryPackagePurpose == "OPERATING-SYSTEM" {
+			if bom.Asset.Platform.Name == "" {
+				bom.Asset.Platform.Name = p.PackageName
+				bom.Asset.Platform.Version = p.PackageVersion
+				bom.Asset.Platform.Cpes = cpes
+			}
+			continue
+		}
+
+		pkg := newPackageFromPurl(p.PackageName, p.PackageVersion, purl)
+		pkg.Description = p.PackageDescription
+		pkg.Location = p.PackageFileName
+		if layer := parseSpdxLayerSourceInfo(p.PackageSourceInfo); layer != nil {
+			pkg.EvidenceList = append(pkg.EvidenceList, layer)
+		}
+		if len(cpes) > 0 {
+			pkg.Cpes = cpes
+		}
+		for _, license := range []string{p.PackageLicenseConcluded, p.PackageLicenseDeclared} {
+			if license == "" || license == "NOASSERTION" || license == "NONE" {
+				continue
+			}
+			if name, ok := otherLicenses[license]; ok {
+				license = name
+			}
+			pkg.Licenses = []string{license}
+			break
+		}
+
+		pkgs[p.PackageSPDXIdentifier] = pkg
+		bom.Packages = append(bom.Packages, pkg)
+	}
+
+	for _, r := range doc.Relationships {
+		var from, to *Package
+		switch r.Relationship {
+		case spdx.RelationshipDependsOn:
+			from, to = pkgs[r.RefA.ElementRefID], pkgs[r.RefB.ElementRefID]
+		case spdx.RelationshipDependencyOf:
+			from, to = pkgs[r.RefB.ElementRefID], pkgs[r.RefA.ElementRefID]
+		default:
+			continue
+		}
+		if from == nil || to == nil || to.Purl == "" || slices.Contains(from.Dependencies, to.Purl) {
+			continue
+		}
+		from.Dependencies = append(from.Dependencies, to.Purl)
+	}
+
+	return bom
+}
@@ -39,9 +39,66 @@ func TestSpdx(t *testing.T) {
 	assert.Contains(t, data, "pip")
 	assert.Contains(t, data, "cpe:2.3:a:pip_project:pip:21.2.4:*:*:*:*:*:*:*")
 	assert.Contains(t, data, "pkg:pypi/pip@21.2.4")
+	assert.Contains(t, data, "introduced by image layer sha256:4d1ba0b5fbc5c24a4eea1aeb2ea0d1bb6b5e4c2bb6c0bcd4b1e03ac8fc3d0a8c: RUN /bin/sh -c apk add --no-cache python3 py3-pip # buildkit")
 
 	// ensure npm package is included
 	assert.Contains(t, data, "npm")
 	assert.Contains(t, data, "cpe:2.3:a:npm:npm:10.2.4:*:*:*:*:*:*:*")
 	assert.Contains(t, data, "pkg:npm/npm@10.2.4")
 }
+
+func TestSpdxLicensesAndDependencies(t *testing.T) {
+	bom := &Sbom{
+		Generator: &Generator{Name: "cnquery"},
+		Packages: []*Package{
+			{
+				Name:         "npm",
+				Version:      "10.2.4",
+				Purl:         "pkg:npm/npm@10.2.4",
+				Type:         "npm",
+				Licenses:     []string{"Artistic-2.0"},
+				Dependencies: []string{"pkg:npm/semver@7.5.4", "pkg:npm/missing@1.0.0"},
+			},
+			{
+				Name:     "semver",
+				Version:  "7.5.4",
+				Purl:     "pkg:npm/semver@7.5.4",
+				Type:     "npm",
+				Licenses: []string{"ISC"},
+			},
+			{
+				Name:     "zlib",
+				Version:  "1.2.7-17.el7",
+				Purl:     "pkg:rpm/rhel/zlib@1.2.7-17.el7",
+				Type:     "rpm",
+				Licenses: []string{"zlib and Boost"},
+			},
+			{
+				Name:    "bash",
+				Version: "4.2.46-30.el7",
+				Type:    "rpm",
+			},
+		},
+	}
+
+	doc := (&Spdx{}).convert(bom)
+	require.Len(t, doc.Packages, 4)
+	assert.Equal(t, "Artistic-2.0", doc.Packages[0].PackageLicenseConcluded)
+	assert.Equal(t, "Artistic-2.0", doc.Packages[0].PackageLicenseDeclared)
+	assert.Equal(t, "ISC", doc.Packages[1].PackageLicenseConcluded)
+	assert.Equal(t, "LicenseRef-zlib-and-Boost", doc.Packages[2].PackageLicenseConcluded)
+	assert.Equal(t, "NOASSERTION", doc.Packages[3].PackageLicenseConcluded)
+
+	require.Len(t, doc.OtherLicenses, 1)
+	assert.Equal(t, "LicenseRef-zlib-and-Boost", doc.OtherLicenses[0].LicenseIdentifier)
+	assert.Equal(t, "zlib and Boost", doc.OtherLicenses[0].ExtractedText)
+
+	// only dependencies that are part of the document are referenced
+	require.Len(t, doc.Relationships, 1)
+	assert.Equal(t, doc.Packages[0].PackageSPDXIdentifier, doc.Relationships[0].RefA.ElementRefID)
+	assert.Equal(t, doc.Packages[1].PackageSPDXIdentifier, doc.Relationships[0].RefB.ElementRefID)
+	assert.Equal(t, "DEPENDS_ON", doc.Relationships[0].Relationship)
+
+	// ids must only contain letters, numbers, dots and hyphens
+	assert.Regexp(t, `^Package-npm-npm-[0-9a-f]+$`, string(doc.Packages[0].PackageSPDXIdentifier))
+}
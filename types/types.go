@@ -54,6 +54,8 @@ const (
 	byteFunction
 	byteStringSlice
 	byteRange
+	byteIP
+	byteVersion
 )
 
 // NoType type is one whose type information is not available at all
@@ -113,6 +115,12 @@ const (
 	// or lines and columns combined. We use a special type for a very
 	// efficient storage and transmission structure.
 	Range = Type(rune(byteRange))
+
+	// IP represents an IP address, optionally with a prefix length (CIDR)
+	IP = Type(rune(byteIP))
+
+	// VersionLike is the underlying type of all versions
+	VersionLike = Type(rune(byteVersion))
 )
 
 // NotSet returns true if the type has no information
@@ -148,6 +156,29 @@ func Resource(name string) Type {
 	return ResourceLike + Type(name)
 }
 
+// Version for version strings that are compared with the rules of a
+// versioning scheme, e.g. deb, rpm, apk or semver
+func Version(scheme string) Type {
+	return VersionLike + Type(scheme)
+}
+
+// IsVersion checks if this type is a version
+func (typ Type) IsVersion() bool {
+	if typ.NotSet() {
+		return false
+	}
+	return typ[0] == byteVersion
+}
+
+// VersionScheme returns the versioning scheme of a version type,
+// which may be empty if it is only known at runtime
+func (typ Type) VersionScheme() string {
+	if typ[0] == byteVersion {
+		return string(typ[1:])
+	}
+	panic("cannot determine version scheme of " + typ.Label())
+}
+
 // IsResource checks if this type is a map
 func (typ Type) IsResource() bool {
 	if typ.NotSet() {
@@ -261,6 +292,7 @@ var labels = map[byte]string{
 	byteSemver:      "semver",
 	byteStringSlice: "stringslice",
 	byteRange:       "range",
+	byteIP:          "ip",
 }
 
 var labelfun map[byte]func(Type) string
@@ -271,6 +303,12 @@ func init() {
 		byteMap:      func(s Type) string { return "map[" + Type(s[0]).Label() + "]" + s[1:].Label() },
 		byteResource: func(s Type) string { return string(s) },
 		byteFunction: func(f Type) string { return "function(..??..)" },
+		byteVersion: func(s Type) string {
+			if s == "" {
+				return "version"
+			}
+			return "version(" + string(s) + ")"
+		},
 	}
 }
 
@@ -323,3 +361,28 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 		return left.(int32) == right.(int32)
 	},
 }
+
+// Less provides a set of functions for a range of types to test if the left
+// value of that type sorts before the right value
+var Less = map[Type]func(interface{}, interface{}) bool{
+	Bool: func(left, right interface{}) bool {
+		return !left.(bool) && right.(bool)
+	},
+	Int: func(left, right interface{}) bool {
+		return left.(int64) < right.(int64)
+	},
+	Float: func(left, right interface{}) bool {
+		return left.(float64) < right.(float64)
+	},
+	String: func(left, right interface{}) bool {
+		return left.(string) < right.(string)
+	},
+	Time: func(left, right interface{}) bool {
+		l := left.(*time.Time)
+		r := right.(*time.Time)
+		if l == nil || r == nil {
+			return l == nil && r != nil
+		}
+		return l.Before(*r)
+	},
+}
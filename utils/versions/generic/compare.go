@@ -7,10 +7,10 @@ import (
 	"errors"
 	"strings"
 
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/apk"
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/deb"
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/rpm"
-	"go.mondoo.com/cnquery/v11/providers/core/resources/versions/semver"
+	"go.mondoo.com/cnquery/v11/utils/versions/apk"
+	"go.mondoo.com/cnquery/v11/utils/versions/deb"
+	"go.mondoo.com/cnquery/v11/utils/versions/rpm"
+	"go.mondoo.com/cnquery/v11/utils/versions/semver"
 )
 
 func Compare(format, a, b string) (int, error) {
@@ -30,7 +30,7 @@ func Compare(format, a, b string) (int, error) {
 		var parser apk.Parser
 		// for apk versions, we need to remove the epoch, since it is the build version for alpine
 		cmp, err = parser.Compare(VersionWithoutEpoch(a), VersionWithoutEpoch(b))
-	case "npm":
+	case "npm", "semver":
 		var parser semver.Parser
 		cmp, err = parser.Compare(a, b)
 	default: